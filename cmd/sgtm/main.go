@@ -62,6 +62,8 @@ func app(args []string) error {
 	rootFlags.StringVar(&svcOpts.BearerToken, "bearer-token", svcOpts.BearerToken, "Bearer.sh token")
 	rootFlags.StringVar(&svcOpts.IPFSAPI, "ipfs-api", svcOpts.IPFSAPI, "IPFS API multiaddress, if not provided or empry, will use the ipfs cli without an '--api' arg")
 	rootFlags.BoolVar(&svcOpts.EnableProcessingWorker, "enable-processing-worker", svcOpts.EnableProcessingWorker, "enable processing worker")
+	rootFlags.IntVar(&svcOpts.ProcessingWorkerConcurrency, "processing-worker-concurrency", svcOpts.ProcessingWorkerConcurrency, "number of processing jobs run in parallel")
+	rootFlags.StringVar(&svcOpts.BPMAnalyzer, "bpm-analyzer", svcOpts.BPMAnalyzer, "BPM analyzer: auto, sonic-annotator or go (ffmpeg is required to decode the files that are not WAV)")
 
 	root := &ffcli.Command{
 		FlagSet: rootFlags,
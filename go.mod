@@ -65,5 +65,4 @@ require (
 	moul.io/srand v1.6.1
 	moul.io/zapconfig v1.2.0
 	moul.io/zapgorm2 v1.0.1
-	ultre.me/calcbiz v1.0.0
 )
@@ -0,0 +1,263 @@
+package sgtm
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// activitySubscriberBuffer is the number of activities kept for a slow subscriber before dropping new ones.
+const activitySubscriberBuffer = 32
+
+// activityBus is an in-process pub/sub used to broadcast new activities to the live streams.
+type activityBus struct {
+	mu          sync.RWMutex
+	subscribers map[*activitySubscriber]struct{}
+}
+
+type activitySubscriber struct {
+	ch     chan *sgtmpb.Post
+	filter *sgtmpb.ActivityStream_Request
+}
+
+func newActivityBus() *activityBus {
+	return &activityBus{subscribers: map[*activitySubscriber]struct{}{}}
+}
+
+// subscribe returns a channel receiving the activities matching the filter, and a func to unsubscribe.
+func (b *activityBus) subscribe(filter *sgtmpb.ActivityStream_Request) (<-chan *sgtmpb.Post, func()) {
+	sub := &activitySubscriber{
+		ch:     make(chan *sgtmpb.Post, activitySubscriberBuffer),
+		filter: filter,
+	}
+	b.mu.Lock()
+	b.subscribers[sub] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, sub)
+			b.mu.Unlock()
+		})
+	}
+}
+
+func (b *activityBus) hasSubscribers() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subscribers) > 0
+}
+
+// publish sends the activity to the matching subscribers without blocking;
+// the activity is shared between subscribers and must not be modified.
+func (b *activityBus) publish(activity *sgtmpb.Post) (dropped int) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for sub := range b.subscribers {
+		if !activityMatchesFilter(activity, sub.filter) {
+			continue
+		}
+		select {
+		case sub.ch <- activity:
+		default:
+			dropped++
+		}
+	}
+	return dropped
+}
+
+func activityMatchesFilter(activity *sgtmpb.Post, filter *sgtmpb.ActivityStream_Request) bool {
+	if filter == nil {
+		return true
+	}
+	if kinds := filter.GetKinds(); len(kinds) > 0 {
+		found := false
+		for _, kind := range kinds {
+			if kind == activity.Kind {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if filter.GetAuthorID() != 0 && filter.GetAuthorID() != activity.AuthorID {
+		return false
+	}
+	if filter.GetTargetPostID() != 0 && filter.GetTargetPostID() != activity.TargetPostID {
+		return false
+	}
+	return true
+}
+
+// isPublicActivity returns false for the activities that must not be streamed:
+// anonymous ones, admin-only ones and the ones related to a draft.
+// The Author and TargetPost relations must be loaded.
+func isPublicActivity(activity *sgtmpb.Post) bool {
+	if activity.AuthorID == 0 || activity.Author == nil {
+		return false
+	}
+	switch activity.Kind {
+	case sgtmpb.Post_LinkDiscordAccountKind:
+		return false
+	case sgtmpb.Post_ViewHomeKind, sgtmpb.Post_ViewOpenKind:
+		// admin recurring actions, hidden on /open too
+		if activity.AuthorID == moulID || isAdmin(activity.Author) {
+			return false
+		}
+	}
+	if activity.Visibility == sgtmpb.Visibility_Draft || activity.IsDeleted() {
+		return false
+	}
+	if activity.TargetPost != nil && activity.TargetPost.Visibility == sgtmpb.Visibility_Draft {
+		return false
+	}
+	return true
+}
+
+// createActivity saves a tracking event and publishes it to the live streams.
+func (svc *Service) createActivity(event *sgtmpb.Post) error {
+	if err := svc.rwdb().Create(event).Error; err != nil {
+		return err
+	}
+	svc.publishActivity(event)
+	return nil
+}
+
+// publishActivity broadcasts an already saved post (tracking event, track or comment) to the live streams.
+func (svc *Service) publishActivity(event *sgtmpb.Post) {
+	if svc.activities == nil || !svc.activities.hasSubscribers() {
+		return
+	}
+
+	activity := proto.Clone(event).(*sgtmpb.Post)
+	activity.Replies = nil
+	if activity.AuthorID != 0 {
+		var author sgtmpb.User
+		if err := svc.rodb().First(&author, activity.AuthorID).Error; err == nil {
+			activity.Author = &author
+		}
+	}
+	if activity.TargetPostID != 0 {
+		var target sgtmpb.Post
+		if err := svc.rodb().First(&target, activity.TargetPostID).Error; err == nil {
+			activity.TargetPost = &target
+		}
+	}
+	if activity.TargetUserID != 0 {
+		var target sgtmpb.User
+		if err := svc.rodb().First(&target, activity.TargetUserID).Error; err == nil {
+			activity.TargetUser = &target
+		}
+	}
+	if !isPublicActivity(activity) {
+		return
+	}
+
+	activity.Filter()
+	activity.Author.Filter()
+	if activity.TargetPost != nil {
+		activity.TargetPost.Filter()
+	}
+	if activity.TargetUser != nil {
+		activity.TargetUser.Filter()
+	}
+	if dropped := svc.activities.publish(activity); dropped > 0 {
+		svc.logger.Debug("activity dropped for slow subscribers", zap.Int64("id", activity.ID), zap.Int("subscribers", dropped))
+	}
+}
+
+// activitySSEHeartbeat is the interval between keep-alive comments on the SSE stream.
+const activitySSEHeartbeat = 30 * time.Second
+
+// activityStreamSSE streams the new activities as Server-Sent Events.
+// It accepts the same filters as the ActivityStream RPC, i.e., ?kinds=CommentKind&author_id=42.
+func (svc *Service) activityStreamSSE(w http.ResponseWriter, r *http.Request) {
+	filter, err := activityFilterFromQuery(r.URL.Query())
+	if err != nil {
+		svc.errRender(w, r, err, http.StatusBadRequest)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		svc.errRender(w, r, fmt.Errorf("streaming unsupported"), http.StatusInternalServerError)
+		return
+	}
+
+	activities, unsubscribe := svc.activities.subscribe(filter)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	marshaler := protojson.MarshalOptions{UseProtoNames: true}
+	heartbeat := time.NewTicker(activitySSEHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-svc.ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		case activity := <-activities:
+			data, err := marshaler.Marshal(activity)
+			if err != nil {
+				svc.logger.Warn("marshal activity", zap.Error(err))
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: activity\ndata: %s\n\n", activity.ID, data); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+func activityFilterFromQuery(query url.Values) (*sgtmpb.ActivityStream_Request, error) {
+	var filter sgtmpb.ActivityStream_Request
+	for _, input := range query["kinds"] {
+		for _, name := range strings.Split(input, ",") {
+			value, found := sgtmpb.Post_Kind_value[name]
+			if !found {
+				number, err := strconv.ParseInt(name, 10, 32)
+				if err != nil {
+					return nil, fmt.Errorf("invalid kind: %q", name)
+				}
+				value = int32(number)
+			}
+			filter.Kinds = append(filter.Kinds, sgtmpb.Post_Kind(value))
+		}
+	}
+	var err error
+	if input := query.Get("author_id"); input != "" {
+		if filter.AuthorID, err = strconv.ParseInt(input, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid author_id: %w", err)
+		}
+	}
+	if input := query.Get("target_post_id"); input != "" {
+		if filter.TargetPostID, err = strconv.ParseInt(input, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid target_post_id: %w", err)
+		}
+	}
+	return &filter, nil
+}
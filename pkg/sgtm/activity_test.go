@@ -0,0 +1,81 @@
+package sgtm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+func TestActivityStream(t *testing.T) {
+	svc := TestingService(t)
+
+	user := sgtmpb.User{Email: "user@example.com", Slug: "user"}
+	require.NoError(t, svc.rwdb().Create(&user).Error)
+	admin := sgtmpb.User{Email: "admin@example.com", Slug: "admin", Role: "admin"}
+	require.NoError(t, svc.rwdb().Create(&admin).Error)
+	track := sgtmpb.Post{AuthorID: user.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Public}
+	require.NoError(t, svc.rwdb().Create(&track).Error)
+	draft := sgtmpb.Post{AuthorID: user.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Draft}
+	require.NoError(t, svc.rwdb().Create(&draft).Error)
+
+	all, unsubscribeAll := svc.activities.subscribe(&sgtmpb.ActivityStream_Request{})
+	defer unsubscribeAll()
+	comments, unsubscribeComments := svc.activities.subscribe(&sgtmpb.ActivityStream_Request{
+		Kinds:        []sgtmpb.Post_Kind{sgtmpb.Post_CommentKind},
+		TargetPostID: track.ID,
+	})
+	defer unsubscribeComments()
+
+	// hidden activities
+	require.NoError(t, svc.createActivity(&sgtmpb.Post{Kind: sgtmpb.Post_ViewHomeKind}))                                            // anonymous
+	require.NoError(t, svc.createActivity(&sgtmpb.Post{AuthorID: admin.ID, Kind: sgtmpb.Post_ViewOpenKind}))                        // admin-only
+	require.NoError(t, svc.createActivity(&sgtmpb.Post{AuthorID: user.ID, Kind: sgtmpb.Post_LinkDiscordAccountKind}))               // admin-only
+	require.NoError(t, svc.createActivity(&sgtmpb.Post{AuthorID: user.ID, Kind: sgtmpb.Post_ViewPostKind, TargetPostID: draft.ID})) // draft
+	_, err := svc.createComment(&user, &draft, 0, "on a draft")
+	require.NoError(t, err)
+
+	// public activities
+	view := sgtmpb.Post{AuthorID: user.ID, Kind: sgtmpb.Post_ViewPostKind, TargetPostID: track.ID}
+	require.NoError(t, svc.createActivity(&view))
+	comment, err := svc.createComment(&admin, &track, 0, "nice")
+	require.NoError(t, err)
+
+	next := func(ch <-chan *sgtmpb.Post) *sgtmpb.Post {
+		select {
+		case activity := <-ch:
+			return activity
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+			return nil
+		}
+	}
+	activity := next(all)
+	require.Equal(t, view.ID, activity.ID)
+	require.Equal(t, "user", activity.Author.Slug)
+	require.Empty(t, activity.Author.Email)
+	require.Equal(t, track.ID, activity.TargetPost.ID)
+	require.Equal(t, comment.ID, next(all).ID)
+	require.Equal(t, comment.ID, next(comments).ID)
+	require.Empty(t, all)
+	require.Empty(t, comments)
+
+	// unsubscribed streams do not receive anything
+	unsubscribeAll()
+	require.NoError(t, svc.createActivity(&sgtmpb.Post{AuthorID: user.ID, Kind: sgtmpb.Post_ViewPostKind, TargetPostID: track.ID}))
+	require.Empty(t, all)
+}
+
+func TestActivityFilterFromQuery(t *testing.T) {
+	filter, err := activityFilterFromQuery(map[string][]string{
+		"kinds":     {"CommentKind,1"},
+		"author_id": {"42"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []sgtmpb.Post_Kind{sgtmpb.Post_CommentKind, sgtmpb.Post_TrackKind}, filter.Kinds)
+	require.Equal(t, int64(42), filter.AuthorID)
+
+	_, err = activityFilterFromQuery(map[string][]string{"kinds": {"FooKind"}})
+	require.Error(t, err)
+}
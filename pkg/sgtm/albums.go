@@ -0,0 +1,180 @@
+package sgtm
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/yanatan16/golang-soundcloud/soundcloud"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// importSoundCloudPlaylist fills an unsaved album from a resolved SoundCloud set, with its tracks in order in AlbumTracks.
+// The tracks already imported by the author without album are moved to the new album, the ones imported by the other
+// users or in another album are skipped.
+func (svc *Service) importSoundCloudPlaylist(album *sgtmpb.Post, u *url.URL) error {
+	id, err := soundcloudPlaylistIDFromAPIURL(u)
+	if err != nil {
+		return postInputError(fmt.Sprintf("Parse set ID: %s.", err.Error()))
+	}
+
+	// check if album already exists
+	{
+		var alreadyExists sgtmpb.Post
+		err := svc.rodb().
+			Model(&sgtmpb.Post{}).
+			Where(sgtmpb.Post{SoundCloudKind: sgtmpb.Post_SoundCloudPlaylist, SoundCloudID: id}).
+			First(&alreadyExists).
+			Error
+		if err == nil && alreadyExists.ID != 0 {
+			return errPostAlreadyExists{Post: &alreadyExists}
+		}
+	}
+
+	params := url.Values{}
+	if token := u.Query().Get("secret_token"); token != "" {
+		params.Set("secret_token", token)
+	}
+	playlist, err := svc.soundcloud.Playlist(id, params)
+	if err != nil {
+		return postInputError(fmt.Sprintf("Fetch set info from SoundCloud: %s.", err.Error()))
+	}
+	if len(playlist.Tracks) == 0 {
+		return postInputError("This SoundCloud set is empty.")
+	}
+	album.Kind = sgtmpb.Post_AlbumKind
+	album.SoundCloudKind = sgtmpb.Post_SoundCloudPlaylist
+	album.SoundCloudID = id
+	album.SoundCloudSecretToken = params.Get("secret_token")
+	applySoundCloudPlaylist(album, playlist)
+
+	existing := map[uint64]*sgtmpb.Post{}
+	var missing []uint64
+	for _, track := range playlist.Tracks {
+		var post sgtmpb.Post
+		err := svc.rodb().
+			Where(sgtmpb.Post{SoundCloudKind: sgtmpb.Post_SoundCloudTrack, SoundCloudID: track.Id}).
+			First(&post).
+			Error
+		switch {
+		case err == nil:
+			existing[track.Id] = &post
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return err
+		case track.Title == "": // the sets only include the metadata of their first tracks
+			missing = append(missing, track.Id)
+		}
+	}
+	fetched, err := svc.fetchSoundCloudTracks(missing, params)
+	if err != nil {
+		return postInputError(fmt.Sprintf("Fetch track info from SoundCloud: %s.", err.Error()))
+	}
+
+	for _, track := range playlist.Tracks {
+		if post, found := existing[track.Id]; found {
+			if post.AuthorID == album.AuthorID && post.AlbumID == 0 {
+				album.AlbumTracks = append(album.AlbumTracks, post)
+			}
+			continue
+		}
+		if track.Title == "" {
+			track = fetched[track.Id]
+		}
+		// the secret tokens of the private sets do not give access to the widget of their tracks
+		post := sgtmpb.Post{
+			Kind:           sgtmpb.Post_TrackKind,
+			AuthorID:       album.AuthorID,
+			SortDate:       time.Now().UnixNano(),
+			SoundCloudKind: sgtmpb.Post_SoundCloudTrack,
+			SoundCloudID:   track.Id,
+		}
+		applySoundCloudTrack(&post, track)
+		if post.ProviderCreatedAt != 0 {
+			post.SortDate = post.ProviderCreatedAt
+		}
+		album.AlbumTracks = append(album.AlbumTracks, &post)
+	}
+	if len(album.AlbumTracks) == 0 {
+		return postInputError("All the tracks of this set were already imported by other users or in another album.")
+	}
+	return nil
+}
+
+// fetchSoundCloudTracks fetches the metadata of tracks by batches; it fails if one of them is not available.
+func (svc *Service) fetchSoundCloudTracks(ids []uint64, params url.Values) (map[uint64]*soundcloud.Track, error) {
+	tracks := make(map[uint64]*soundcloud.Track, len(ids))
+	for start := 0; start < len(ids); start += soundcloudTracksBatchSize {
+		end := start + soundcloudTracksBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batch, err := svc.soundcloud.Tracks(ids[start:end], params)
+		if err != nil {
+			return nil, err
+		}
+		for _, track := range batch {
+			tracks[track.Id] = track
+		}
+	}
+	for _, id := range ids {
+		if _, found := tracks[id]; !found {
+			return nil, fmt.Errorf("track %d is not available", id)
+		}
+	}
+	return tracks, nil
+}
+
+// createAlbum saves a new album with the tracks of AlbumTracks in their order, and schedules the processing
+// of the new tracks; the new tracks have the visibility of the album.
+func (svc *Service) createAlbum(album *sgtmpb.Post) error {
+	var created []*sgtmpb.Post
+	err := svc.rwdb().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(album).Error; err != nil {
+			return err
+		}
+		for i, track := range album.AlbumTracks {
+			track.AlbumID = album.ID
+			track.AlbumPosition = int64(i + 1)
+			if track.ID != 0 {
+				err := tx.
+					Model(track).
+					Updates(map[string]interface{}{"album_id": track.AlbumID, "album_position": track.AlbumPosition}).
+					Error
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			track.Visibility = album.Visibility
+			if err := tx.Create(track).Error; err != nil {
+				return err
+			}
+			created = append(created, track)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	svc.logger.Debug("new album", zap.Int64("id", album.ID), zap.Int("tracks", len(album.AlbumTracks)), zap.Int("created", len(created)))
+	for _, track := range created {
+		svc.publishActivity(track)
+		svc.enqueueTrackProcessing(track)
+	}
+	return nil
+}
+
+// albumTracks returns the tracks of an album, in order.
+func (svc *Service) albumTracks(albumID int64) ([]*sgtmpb.Post, error) {
+	var tracks []*sgtmpb.Post
+	err := svc.rodb().
+		Preload("Author").
+		Where(sgtmpb.Post{Kind: sgtmpb.Post_TrackKind, AlbumID: albumID}).
+		Order("album_position").
+		Find(&tracks).
+		Error
+	return tracks, err
+}
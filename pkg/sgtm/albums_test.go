@@ -0,0 +1,141 @@
+package sgtm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yanatan16/golang-soundcloud/soundcloud"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+func TestSoundCloudPlaylistImport(t *testing.T) {
+	svc := TestingService(t)
+	client := testingClient(t, &svc)
+
+	playlist := SoundCloudPlaylist{
+		ID:           7,
+		Title:        "EP",
+		Description:  "first EP",
+		PermalinkURL: "https://soundcloud.com/author/sets/ep",
+		ArtworkURL:   "https://i1.sndcdn.com/artworks-7-large.jpg",
+		CreatedAt:    "2020/10/10 10:10:10 +0000",
+		Duration:     360000,
+		Tracks: []*soundcloud.Track{
+			{Id: 11, Title: "intro", Duration: 60000},
+			{Id: 12}, // the long sets only include the IDs of their last tracks
+			{Id: 13, Title: "already imported"},
+			{Id: 14, Title: "imported by another user"},
+		},
+	}
+	batches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/resolve.json":
+			switch r.URL.Query().Get("url") {
+			case "https://soundcloud.com/author/sets/ep":
+				http.Redirect(w, r, "https://api.soundcloud.com/playlists/7.json", http.StatusFound)
+			case "https://soundcloud.com/author/sets/empty":
+				http.Redirect(w, r, "https://api.soundcloud.com/playlists/8.json", http.StatusFound)
+			default:
+				http.NotFound(w, r)
+			}
+		case "/playlists/7.json":
+			_ = json.NewEncoder(w).Encode(playlist)
+		case "/playlists/8.json":
+			_ = json.NewEncoder(w).Encode(SoundCloudPlaylist{ID: 8, Title: "empty"})
+		case "/tracks":
+			batches++
+			tracks := []*soundcloud.Track{}
+			for _, id := range strings.Split(r.URL.Query().Get("ids"), ",") {
+				switch id {
+				case "12":
+					tracks = append(tracks, &soundcloud.Track{Id: 12, Title: "outro", Duration: 300000})
+				case "404":
+				default:
+					parsed, _ := strconv.ParseUint(id, 10, 64)
+					tracks = append(tracks, &soundcloud.Track{Id: parsed, Title: id})
+				}
+			}
+			_ = json.NewEncoder(w).Encode(tracks)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	svc.soundcloud = NewSoundCloudClient("test", server.URL)
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	other := sgtmpb.User{Email: "other@example.com", Slug: "other"}
+	require.NoError(t, svc.rwdb().Create(&other).Error)
+	authorCtx := testingAuthContext(t, &svc, author.ID)
+	existing := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Provider: sgtmpb.Provider_SoundCloud, SoundCloudKind: sgtmpb.Post_SoundCloudTrack, SoundCloudID: 13}
+	require.NoError(t, svc.rwdb().Create(&existing).Error)
+	others := sgtmpb.Post{AuthorID: other.ID, Kind: sgtmpb.Post_TrackKind, Provider: sgtmpb.Provider_SoundCloud, SoundCloudKind: sgtmpb.Post_SoundCloudTrack, SoundCloudID: 14}
+	require.NoError(t, svc.rwdb().Create(&others).Error)
+
+	_, err := client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: "https://soundcloud.com/author/sets/empty"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	created, err := client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: "https://soundcloud.com/author/sets/ep", Draft: true})
+	require.NoError(t, err)
+	album := created.Post
+	require.Equal(t, sgtmpb.Post_AlbumKind, album.Kind)
+	require.Equal(t, sgtmpb.Post_SoundCloudPlaylist, album.SoundCloudKind)
+	require.Equal(t, uint64(7), album.SoundCloudID)
+	require.Equal(t, "EP", album.ProviderTitle)
+	require.Equal(t, "https://i1.sndcdn.com/artworks-7-t500x500.jpg", album.ArtworkURL)
+	require.Equal(t, album.ProviderCreatedAt, album.SortDate)
+	require.Equal(t, fmt.Sprintf("/album/%d", album.ID), album.CanonicalURL())
+	require.Contains(t, string(embedTrack(album)), "api.soundcloud.com/playlists/7")
+
+	// the tracks are in the order of the set, the track of the other user is skipped
+	tracks, err := svc.albumTracks(album.ID)
+	require.NoError(t, err)
+	titles := []string{}
+	for idx, track := range tracks {
+		require.Equal(t, int64(idx+1), track.AlbumPosition)
+		titles = append(titles, track.SafeTitle())
+	}
+	require.Equal(t, []string{"intro", "outro", "noname"}, titles)
+	require.Equal(t, 1, batches)
+	require.Equal(t, existing.ID, tracks[2].ID)
+	require.Equal(t, sgtmpb.Visibility_Draft, tracks[0].Visibility)
+	require.Equal(t, uint64(300000), tracks[1].Duration)
+	var skipped sgtmpb.Post
+	require.NoError(t, svc.rodb().First(&skipped, others.ID).Error)
+	require.Zero(t, skipped.AlbumID)
+
+	// only the new tracks are processed
+	var jobs []*sgtmpb.Job
+	require.NoError(t, svc.rodb().Where(sgtmpb.Job{Kind: sgtmpb.Job_ProcessTrackKind}).Order("post_id").Find(&jobs).Error)
+	require.Len(t, jobs, 2)
+	require.Equal(t, tracks[0].ID, jobs[0].PostID)
+	require.Equal(t, tracks[1].ID, jobs[1].PostID)
+
+	// dedupe on the set
+	_, err = client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: "https://soundcloud.com/author/sets/ep"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// the missing metadata are fetched by batches
+	batches = 0
+	ids := []uint64{}
+	for id := uint64(1000); id < 1000+soundcloudTracksBatchSize+10; id++ {
+		ids = append(ids, id)
+	}
+	fetched, err := svc.fetchSoundCloudTracks(ids, nil)
+	require.NoError(t, err)
+	require.Len(t, fetched, len(ids))
+	require.Equal(t, "1059", fetched[1059].Title)
+	require.Equal(t, 2, batches)
+	_, err = svc.fetchSoundCloudTracks([]uint64{12, 404}, nil)
+	require.EqualError(t, err, "track 404 is not available")
+}
@@ -0,0 +1,542 @@
+package sgtm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/cmplx"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+const (
+	// analysisVersion should be incremented when the algorithms change,
+	// the tracks with an older analysis are then processed again.
+	analysisVersion = 1
+	analysisTimeout = 5 * time.Minute
+
+	// replayGainReference is the loudness targeted by ReplayGain 2.0, in LUFS.
+	replayGainReference = -18
+	// loudnessFloor is the absolute gate of EBU R128, the quieter blocks are ignored.
+	loudnessFloor = -70
+
+	// keyAnalysisRate is the approximate sample rate used to detect the key.
+	keyAnalysisRate      = 11025
+	keyAnalysisFrameSize = 4096
+	keyAnalysisMinFreq   = 65   // C2
+	keyAnalysisMaxFreq   = 2100 // C7
+)
+
+// audioAnalysis contains the features computed from the decoded audio.
+type audioAnalysis struct {
+	Loudness      float64 // integrated loudness, in LUFS
+	TruePeak      float64 // in dBTP
+	LoudnessRange float64 // in LU
+	ReplayGain    float64 // in dB
+	Key           string  // i.e., "A minor"
+}
+
+// analysisMigrationName changes with the version, so the existing tracks are processed again.
+func analysisMigrationName() string { return fmt.Sprintf("analysis-v%d", analysisVersion) }
+
+// analysisMigration measures the loudness and detects the key of the track.
+func (svc *Service) analysisMigration(post *sgtmpb.Post, _ *gorm.DB) (func(*gorm.DB) error, error) {
+	if post.Kind != sgtmpb.Post_TrackKind || !hasProvider(post) {
+		return nil, nil
+	}
+
+	path, cleanup, err := svc.localTrackFile(post)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the audio file: %w", err)
+	}
+	defer cleanup()
+	ctx, cancel := context.WithTimeout(svc.ctx, analysisTimeout)
+	defer cancel()
+	analysis, err := analyzeAudioFile(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return func(tx *gorm.DB) error {
+		return tx.Model(post).Updates(map[string]interface{}{
+			"analysis_version": analysisVersion,
+			"loudness":         analysis.Loudness,
+			"true_peak":        analysis.TruePeak,
+			"loudness_range":   analysis.LoudnessRange,
+			"replay_gain":      analysis.ReplayGain,
+			"detected_key":     analysis.Key,
+		}).Error
+	}, nil
+}
+
+// analyzeAudioFile decodes a whole audio file and analyzes it.
+// WAV files are decoded natively, other formats are decoded with ffmpeg.
+func analyzeAudioFile(ctx context.Context, path string) (*audioAnalysis, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	reader := bufio.NewReader(f)
+	if header, err := reader.Peek(12); err == nil && string(header[0:4]) == "RIFF" && string(header[8:12]) == "WAVE" {
+		wav, err := newWAVReader(reader)
+		if err != nil {
+			return nil, err
+		}
+		return analyzePCM(wav)
+	}
+
+	// the audio is streamed, so long tracks are not loaded in memory
+	cmd := exec.CommandContext(
+		ctx,
+		"ffmpeg",
+		"-v", "error",
+		"-i", path,
+		"-af", "aformat=channel_layouts=mono|stereo",
+		"-f", "wav", "-acodec", "pcm_f32le",
+		"-",
+	)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("ffmpeg: %w", err)
+	}
+	var analysis *audioAnalysis
+	wav, err := newWAVReader(bufio.NewReader(stdout))
+	if err == nil {
+		analysis, err = analyzePCM(wav)
+	}
+	_, _ = io.Copy(ioutil.Discard, stdout)
+	if waitErr := cmd.Wait(); waitErr != nil {
+		return nil, fmt.Errorf("ffmpeg: %w: %s", waitErr, strings.TrimSpace(stderr.String()))
+	}
+	return analysis, err
+}
+
+// analyzePCM reads all the frames and computes the loudness, the true peak and the key.
+func analyzePCM(wav *wavReader) (*audioAnalysis, error) {
+	var (
+		loudness = newLoudnessMeter(wav.Rate, wav.Channels)
+		peak     = newTruePeakMeter(wav.Rate, wav.Channels)
+		key      = newKeyDetector(wav.Rate)
+		buf      = make([]float64, 4096*wav.Channels)
+		mono     = make([]float64, 4096)
+	)
+	for {
+		n, err := wav.ReadFrames(buf)
+		frames := buf[:n]
+		loudness.write(frames)
+		peak.write(frames)
+		mono = mono[:0]
+		for i := 0; i < n; i += wav.Channels {
+			var sum float64
+			for _, v := range frames[i : i+wav.Channels] {
+				sum += v
+			}
+			mono = append(mono, sum/float64(wav.Channels))
+		}
+		key.write(mono)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	integrated, ok := loudness.integrated()
+	if !ok {
+		return nil, fmt.Errorf("audio too short or too quiet to measure the loudness")
+	}
+	analysis := audioAnalysis{
+		Loudness:      round2(integrated),
+		TruePeak:      round2(peak.dBTP()),
+		LoudnessRange: round2(loudness.loudnessRange()),
+		ReplayGain:    round2(replayGainReference - integrated),
+		Key:           key.key(),
+	}
+	return &analysis, nil
+}
+
+func round2(value float64) float64 { return math.Round(value*100) / 100 }
+
+// energyToLUFS converts a mean square to a loudness, as defined by ITU-R BS.1770.
+func energyToLUFS(energy float64) float64 { return -0.691 + 10*math.Log10(energy) }
+
+// biquad is a second order IIR filter, in direct form I.
+type biquad struct {
+	b0, b1, b2, a1, a2 float64
+	x1, x2, y1, y2     float64
+}
+
+func (f *biquad) process(x float64) float64 {
+	y := f.b0*x + f.b1*f.x1 + f.b2*f.x2 - f.a1*f.y1 - f.a2*f.y2
+	f.x2, f.x1 = f.x1, x
+	f.y2, f.y1 = f.y1, y
+	return y
+}
+
+// kWeightingFilters returns the pre-filter and the RLB filter of ITU-R BS.1770,
+// with coefficients computed for the sample rate.
+func kWeightingFilters(rate int) (biquad, biquad) {
+	fs := float64(rate)
+
+	// high shelf, modeling the acoustic effects of the head
+	f0, gain, q := 1681.974450955533, 3.999843853973347, 0.7071752369554196
+	k := math.Tan(math.Pi * f0 / fs)
+	vh := math.Pow(10, gain/20)
+	vb := math.Pow(vh, 0.4996667741545416)
+	a0 := 1 + k/q + k*k
+	shelf := biquad{
+		b0: (vh + vb*k/q + k*k) / a0,
+		b1: 2 * (k*k - vh) / a0,
+		b2: (vh - vb*k/q + k*k) / a0,
+		a1: 2 * (k*k - 1) / a0,
+		a2: (1 - k/q + k*k) / a0,
+	}
+
+	// high pass
+	f0, q = 38.13547087602444, 0.5003270373238773
+	k = math.Tan(math.Pi * f0 / fs)
+	a0 = 1 + k/q + k*k
+	highPass := biquad{
+		b0: 1,
+		b1: -2,
+		b2: 1,
+		a1: 2 * (k*k - 1) / a0,
+		a2: (1 - k/q + k*k) / a0,
+	}
+	return shelf, highPass
+}
+
+// loudnessMeter measures the integrated loudness (EBU R128) and the loudness range (EBU Tech 3342).
+// The K-weighted energy is accumulated by 100ms sub-blocks, the gating blocks overlap by steps of 100ms.
+type loudnessMeter struct {
+	channels     int
+	filters      [][2]biquad
+	subblockSize int
+	current      float64
+	count        int
+	subblocks    []float64 // mean square of each sub-block, summed over the channels
+}
+
+func newLoudnessMeter(rate int, channels int) *loudnessMeter {
+	meter := loudnessMeter{
+		channels:     channels,
+		filters:      make([][2]biquad, channels),
+		subblockSize: rate / 10,
+	}
+	for c := range meter.filters {
+		shelf, highPass := kWeightingFilters(rate)
+		meter.filters[c] = [2]biquad{shelf, highPass}
+	}
+	return &meter
+}
+
+func (m *loudnessMeter) write(frames []float64) {
+	for i := 0; i+m.channels <= len(frames); i += m.channels {
+		for c := 0; c < m.channels; c++ {
+			filters := &m.filters[c]
+			v := filters[1].process(filters[0].process(frames[i+c]))
+			m.current += v * v
+		}
+		m.count++
+		if m.count == m.subblockSize {
+			m.subblocks = append(m.subblocks, m.current/float64(m.subblockSize))
+			m.current, m.count = 0, 0
+		}
+	}
+}
+
+// blocks returns the energies of the blocks of the given number of sub-blocks, with a 100ms step.
+func (m *loudnessMeter) blocks(size int) []float64 {
+	if len(m.subblocks) < size {
+		return nil
+	}
+	blocks := make([]float64, 0, len(m.subblocks)-size+1)
+	var sum float64
+	for i, energy := range m.subblocks {
+		sum += energy
+		if i >= size {
+			sum -= m.subblocks[i-size]
+		}
+		if i >= size-1 {
+			blocks = append(blocks, sum/float64(size))
+		}
+	}
+	return blocks
+}
+
+// gate returns the blocks above the absolute gate, and above the relative gate computed from those blocks.
+func gate(blocks []float64, relative float64) []float64 {
+	absolute := math.Pow(10, (loudnessFloor+0.691)/10)
+	gated := []float64{}
+	var sum float64
+	for _, energy := range blocks {
+		if energy > absolute {
+			gated = append(gated, energy)
+			sum += energy
+		}
+	}
+	if len(gated) == 0 {
+		return nil
+	}
+	threshold := sum / float64(len(gated)) * math.Pow(10, relative/10)
+	result := gated[:0]
+	for _, energy := range gated {
+		if energy > threshold {
+			result = append(result, energy)
+		}
+	}
+	return result
+}
+
+// integrated returns the loudness of the 400ms blocks, gated at -10 LU.
+func (m *loudnessMeter) integrated() (float64, bool) {
+	blocks := gate(m.blocks(4), -10)
+	if len(blocks) == 0 {
+		return 0, false
+	}
+	var sum float64
+	for _, energy := range blocks {
+		sum += energy
+	}
+	return energyToLUFS(sum / float64(len(blocks))), true
+}
+
+// loudnessRange returns the spread of the 3s blocks gated at -20 LU, between the 10th and the 95th percentiles.
+func (m *loudnessMeter) loudnessRange() float64 {
+	blocks := gate(m.blocks(30), -20)
+	if len(blocks) == 0 {
+		return 0
+	}
+	sort.Float64s(blocks)
+	percentile := func(p float64) float64 {
+		return energyToLUFS(blocks[int(math.Round(p*float64(len(blocks)-1)))])
+	}
+	return percentile(0.95) - percentile(0.10)
+}
+
+// truePeakMeter estimates the peaks between the samples by oversampling (ITU-R BS.1770 annex 2),
+// with a windowed sinc interpolation.
+type truePeakMeter struct {
+	channels int
+	phases   [][]float64 // interpolation filter of each intermediate position
+	history  [][]float64 // last samples of each channel, the most recent first
+	peak     float64
+}
+
+const truePeakTaps = 12 // per phase
+
+func newTruePeakMeter(rate int, channels int) *truePeakMeter {
+	factor := 4
+	switch {
+	case rate >= 192000:
+		factor = 1
+	case rate >= 96000:
+		factor = 2
+	}
+	meter := truePeakMeter{channels: channels, history: make([][]float64, channels)}
+	for c := range meter.history {
+		meter.history[c] = make([]float64, truePeakTaps)
+	}
+	for phase := 1; phase < factor; phase++ {
+		// the interpolated point is between history[half-1] and history[half]
+		offset := float64(phase) / float64(factor)
+		taps := make([]float64, truePeakTaps)
+		for j := range taps {
+			x := float64(truePeakTaps/2-j) - offset
+			window := 0.5 + 0.5*math.Cos(math.Pi*x/(truePeakTaps/2+1)) // Hann
+			taps[j] = window
+			if x != 0 {
+				taps[j] *= math.Sin(math.Pi*x) / (math.Pi * x)
+			}
+		}
+		meter.phases = append(meter.phases, taps)
+	}
+	return &meter
+}
+
+func (m *truePeakMeter) write(frames []float64) {
+	for i := 0; i+m.channels <= len(frames); i += m.channels {
+		for c := 0; c < m.channels; c++ {
+			history := m.history[c]
+			copy(history[1:], history[:len(history)-1])
+			history[0] = frames[i+c]
+			if v := math.Abs(history[0]); v > m.peak {
+				m.peak = v
+			}
+			for _, taps := range m.phases {
+				var v float64
+				for j, tap := range taps {
+					v += tap * history[j]
+				}
+				if v = math.Abs(v); v > m.peak {
+					m.peak = v
+				}
+			}
+		}
+	}
+}
+
+func (m *truePeakMeter) dBTP() float64 {
+	if m.peak == 0 {
+		return loudnessFloor
+	}
+	return 20 * math.Log10(m.peak)
+}
+
+var (
+	keyNames = []string{"C", "C#", "D", "D#", "E", "F", "F#", "G", "G#", "A", "A#", "B"}
+	// Krumhansl-Kessler key profiles, starting from the tonic
+	majorKeyProfile = []float64{6.35, 2.23, 3.48, 2.33, 4.38, 4.09, 2.52, 5.19, 2.39, 3.66, 2.29, 2.88}
+	minorKeyProfile = []float64{6.33, 2.68, 3.52, 5.38, 2.60, 3.53, 2.54, 4.75, 3.98, 2.69, 3.34, 3.17}
+)
+
+// keyDetector accumulates a chromagram of the decimated audio,
+// and correlates it with the profiles of the 24 major and minor keys.
+type keyDetector struct {
+	decimation int
+	rate       float64
+	sum        float64
+	count      int
+	frame      []float64
+	window     []float64
+	chroma     [12]float64
+}
+
+func newKeyDetector(rate int) *keyDetector {
+	decimation := int(math.Round(float64(rate) / keyAnalysisRate))
+	if decimation < 1 {
+		decimation = 1
+	}
+	window := make([]float64, keyAnalysisFrameSize)
+	for i := range window {
+		window[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/keyAnalysisFrameSize)
+	}
+	return &keyDetector{
+		decimation: decimation,
+		rate:       float64(rate) / float64(decimation),
+		window:     window,
+	}
+}
+
+func (d *keyDetector) write(samples []float64) {
+	for _, sample := range samples {
+		d.sum += sample
+		d.count++
+		if d.count < d.decimation {
+			continue
+		}
+		d.frame = append(d.frame, d.sum/float64(d.decimation))
+		d.sum, d.count = 0, 0
+		if len(d.frame) == keyAnalysisFrameSize {
+			d.analyzeFrame()
+			d.frame = d.frame[:0]
+		}
+	}
+}
+
+func (d *keyDetector) analyzeFrame() {
+	spectrum := make([]complex128, keyAnalysisFrameSize)
+	for i, v := range d.frame {
+		spectrum[i] = complex(v*d.window[i], 0)
+	}
+	fft(spectrum)
+	for bin := 1; bin < keyAnalysisFrameSize/2; bin++ {
+		freq := float64(bin) * d.rate / keyAnalysisFrameSize
+		if freq < keyAnalysisMinFreq || freq > keyAnalysisMaxFreq {
+			continue
+		}
+		midi := int(math.Round(69 + 12*math.Log2(freq/440)))
+		d.chroma[midi%12] += cmplx.Abs(spectrum[bin])
+	}
+}
+
+// key returns the best matching key, or an empty string for silent or too short audio.
+func (d *keyDetector) key() string {
+	var total float64
+	for _, v := range d.chroma {
+		total += v
+	}
+	if total == 0 {
+		return ""
+	}
+	best, bestScore := "", math.Inf(-1)
+	for tonic := 0; tonic < 12; tonic++ {
+		for _, mode := range []struct {
+			name    string
+			profile []float64
+		}{{"major", majorKeyProfile}, {"minor", minorKeyProfile}} {
+			rotated := make([]float64, 12)
+			for i := range rotated {
+				rotated[i] = d.chroma[(tonic+i)%12]
+			}
+			if score := pearson(rotated, mode.profile); score > bestScore {
+				best, bestScore = keyNames[tonic]+" "+mode.name, score
+			}
+		}
+	}
+	return best
+}
+
+// pearson returns the correlation coefficient of two series of the same length.
+func pearson(a, b []float64) float64 {
+	var meanA, meanB float64
+	for i := range a {
+		meanA += a[i]
+		meanB += b[i]
+	}
+	meanA /= float64(len(a))
+	meanB /= float64(len(b))
+	var cov, varA, varB float64
+	for i := range a {
+		cov += (a[i] - meanA) * (b[i] - meanB)
+		varA += (a[i] - meanA) * (a[i] - meanA)
+		varB += (b[i] - meanB) * (b[i] - meanB)
+	}
+	if varA == 0 || varB == 0 {
+		return 0
+	}
+	return cov / math.Sqrt(varA*varB)
+}
+
+// fft is an in-place radix-2 Cooley-Tukey transform; the length must be a power of two.
+func fft(x []complex128) {
+	n := len(x)
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+	for size := 2; size <= n; size <<= 1 {
+		step := cmplx.Exp(complex(0, -2*math.Pi/float64(size)))
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				even, odd := x[start+k], w*x[start+k+size/2]
+				x[start+k], x[start+k+size/2] = even+odd, even-odd
+				w *= step
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package sgtm
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// testingFloatWAV returns a float WAV file; if streamed, the data size is unknown, as when written to a pipe.
+func testingFloatWAV(t *testing.T, rate int, channels int, seconds float64, streamed bool, sample func(i int, c int) float64) string {
+	t.Helper()
+	var data bytes.Buffer
+	for i := 0; i < int(seconds*float64(rate)); i++ {
+		for c := 0; c < channels; c++ {
+			_ = binary.Write(&data, binary.LittleEndian, float32(sample(i, c)))
+		}
+	}
+	dataSize := uint32(data.Len())
+	if streamed {
+		dataSize = math.MaxUint32
+	}
+
+	var wav bytes.Buffer
+	blockAlign := channels * 4
+	wav.WriteString("RIFF")
+	_ = binary.Write(&wav, binary.LittleEndian, uint32(4+8+16+8+data.Len()))
+	wav.WriteString("WAVE")
+	wav.WriteString("fmt ")
+	for _, field := range []interface{}{
+		uint32(16), uint16(3), uint16(channels), uint32(rate), uint32(rate * blockAlign), uint16(blockAlign), uint16(32),
+	} {
+		_ = binary.Write(&wav, binary.LittleEndian, field)
+	}
+	wav.WriteString("data")
+	_ = binary.Write(&wav, binary.LittleEndian, dataSize)
+	wav.Write(data.Bytes())
+
+	path := filepath.Join(t.TempDir(), "track.wav")
+	require.NoError(t, ioutil.WriteFile(path, wav.Bytes(), 0o600))
+	return path
+}
+
+func testingSine(rate int, freq float64, dBFS float64, phase float64) func(int, int) float64 {
+	amplitude := math.Pow(10, dBFS/20)
+	return func(i int, _ int) float64 {
+		return amplitude * math.Sin(2*math.Pi*freq*float64(i)/float64(rate)+phase)
+	}
+}
+
+func TestAnalyzeAudioFile(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("loudness", func(t *testing.T) {
+		// EBU Tech 3341, test case 1: a stereo sine at -23 dBFS measures -23 LUFS
+		for _, streamed := range []bool{false, true} {
+			path := testingFloatWAV(t, 48000, 2, 20, streamed, testingSine(48000, 1000, -23, 0))
+			analysis, err := analyzeAudioFile(ctx, path)
+			require.NoError(t, err)
+			require.InDelta(t, -23, analysis.Loudness, 0.1)
+			require.InDelta(t, 5, analysis.ReplayGain, 0.1)
+			require.InDelta(t, -23, analysis.TruePeak, 0.1)
+			require.InDelta(t, 0, analysis.LoudnessRange, 0.1)
+		}
+	})
+
+	t.Run("loudness-range", func(t *testing.T) {
+		// EBU Tech 3342, test case 1: 20s at -20 dBFS then 20s at -30 dBFS
+		loud, quiet := testingSine(44100, 1000, -20, 0), testingSine(44100, 1000, -30, 0)
+		path := testingFloatWAV(t, 44100, 2, 40, false, func(i int, c int) float64 {
+			if i < 20*44100 {
+				return loud(i, c)
+			}
+			return quiet(i, c)
+		})
+		analysis, err := analyzeAudioFile(ctx, path)
+		require.NoError(t, err)
+		require.InDelta(t, 10, analysis.LoudnessRange, 1)
+	})
+
+	t.Run("true-peak", func(t *testing.T) {
+		// the samples of a quarter-rate sine shifted by 45° are 3 dB below its peaks
+		path := testingFloatWAV(t, 48000, 1, 5, false, testingSine(48000, 12000, -6, math.Pi/4))
+		analysis, err := analyzeAudioFile(ctx, path)
+		require.NoError(t, err)
+		require.InDelta(t, -6, analysis.TruePeak, 0.5)
+	})
+
+	t.Run("key", func(t *testing.T) {
+		tests := []struct {
+			notes    []float64
+			expected string
+		}{
+			{[]float64{261.63, 329.63, 392.00}, "C major"},  // C4 E4 G4
+			{[]float64{220.00, 261.63, 329.63}, "A minor"},  // A3 C4 E4
+			{[]float64{185.00, 233.08, 277.18}, "F# major"}, // F#3 A#3 C#4
+		}
+		for _, tt := range tests {
+			path := testingFloatWAV(t, 44100, 1, 10, false, func(i int, c int) float64 {
+				var sum float64
+				for _, freq := range tt.notes {
+					sum += testingSine(44100, freq, -20, 0)(i, c)
+				}
+				return sum
+			})
+			analysis, err := analyzeAudioFile(ctx, path)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, analysis.Key)
+		}
+	})
+
+	t.Run("silence", func(t *testing.T) {
+		path := testingFloatWAV(t, 44100, 2, 5, false, func(int, int) float64 { return 0 })
+		_, err := analyzeAudioFile(ctx, path)
+		require.Error(t, err)
+	})
+}
+
+func TestPostListAnalysisFilters(t *testing.T) {
+	svc := TestingService(t)
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	posts := []*sgtmpb.Post{
+		{Title: "loud", AnalysisVersion: 1, Loudness: -8, LoudnessRange: 3, DetectedKey: "A minor"},
+		{Title: "quiet", AnalysisVersion: 1, Loudness: -18, LoudnessRange: 12, DetectedKey: "C major", KeySignature: "Am"},
+		{Title: "not-analyzed"},
+	}
+	for _, post := range posts {
+		post.AuthorID = author.ID
+		post.Kind = sgtmpb.Post_TrackKind
+		post.Visibility = sgtmpb.Visibility_Public
+		require.NoError(t, svc.rwdb().Create(post).Error)
+	}
+
+	titles := func(req *sgtmpb.PostList_Request) []string {
+		posts, _, err := svc.postList(req)
+		require.NoError(t, err)
+		titles := []string{}
+		for _, post := range posts {
+			titles = append(titles, post.Title)
+		}
+		return titles
+	}
+	require.Equal(t, []string{"loud"}, titles(&sgtmpb.PostList_Request{LoudnessMin: -10}))
+	require.Equal(t, []string{"quiet"}, titles(&sgtmpb.PostList_Request{LoudnessMax: -10}))
+	require.Equal(t, []string{"quiet"}, titles(&sgtmpb.PostList_Request{LoudnessRangeMin: 6}))
+	require.Equal(t, []string{"quiet", "loud"}, titles(&sgtmpb.PostList_Request{LoudnessRangeMax: 20}))
+	require.Equal(t, []string{"loud"}, titles(&sgtmpb.PostList_Request{KeySignature: "a minor"}))
+	require.Equal(t, []string{"quiet"}, titles(&sgtmpb.PostList_Request{KeySignature: "Am"}))
+}
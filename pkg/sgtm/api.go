@@ -1,95 +1,537 @@
 package sgtm
 
 import (
+	"bytes"
 	"context"
-	"fmt"
-	"os"
-	"time"
+	"errors"
+	"strings"
 
-	"google.golang.org/grpc/metadata"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"moul.io/sgtm/pkg/sgtmpb"
 )
 
-func (svc *Service) claimsFromContext(ctx context.Context) (*jwtClaims, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, fmt.Errorf("cannot get metadata from context")
+// editablePostFromContext returns a track if the user of the request is its author or an admin.
+func (svc *Service) editablePostFromContext(ctx context.Context, postID int64) (*sgtmpb.Post, error) {
+	user, err := authUserFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	oauthToken, ok := md["oauth-token"]
-	if !ok || len(oauthToken) == 0 {
-		return nil, fmt.Errorf("no such oauth-token")
+	var post sgtmpb.Post
+	err = svc.rodb().
+		Where(sgtmpb.Post{ID: postID, Kind: sgtmpb.Post_TrackKind}).
+		First(&post).
+		Error
+	if err != nil {
+		return nil, postErrorToStatus(err)
 	}
-
-	return svc.parseJWTToken(oauthToken[0])
+	if !canEditPost(user, &post) {
+		return nil, postErrorToStatus(errPermissionDenied)
+	}
+	return &post, nil
 }
 
 func (svc *Service) Me(ctx context.Context, req *sgtmpb.Me_Request) (*sgtmpb.Me_Response, error) {
-	claims, err := svc.claimsFromContext(ctx)
+	user, err := authUserFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	ret := sgtmpb.Me_Response{User: user}
 
-	var user sgtmpb.User
-	err = svc.rodb().
-		Where("id = ?", claims.Session.UserID).
-		First(&user).
-		Error
+	return &ret, nil
+}
+
+func (svc *Service) Ping(context.Context, *sgtmpb.Ping_Request) (*sgtmpb.Ping_Response, error) {
+	return &sgtmpb.Ping_Response{}, nil
+}
+
+func (svc *Service) Status(ctx context.Context, _ *sgtmpb.Status_Request) (*sgtmpb.Status_Response, error) {
+	health, err := svc.cachedHealth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user, _ := authUserFromContext(ctx); isAdmin(user) {
+		return health, nil
+	}
+	return publicHealth(health), nil
+}
+
+func (svc *Service) UserList(_ context.Context, req *sgtmpb.UserList_Request) (*sgtmpb.UserList_Response, error) {
+	users, nextCursor, err := svc.userList(req)
+	if errors.Is(err, errInvalidCursor) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
-	ret := sgtmpb.Me_Response{User: &user}
 
-	return &ret, nil
+	for _, user := range users {
+		user.Filter()
+	}
+	return &sgtmpb.UserList_Response{Users: users, NextCursor: nextCursor}, nil
 }
 
-func (svc *Service) Ping(context.Context, *sgtmpb.Ping_Request) (*sgtmpb.Ping_Response, error) {
-	return &sgtmpb.Ping_Response{}, nil
+func (svc *Service) PostList(_ context.Context, req *sgtmpb.PostList_Request) (*sgtmpb.PostList_Response, error) {
+	posts, nextCursor, err := svc.postList(req)
+	if errors.Is(err, errInvalidCursor) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, post := range posts {
+		post.Filter()
+		if post.Author != nil {
+			post.Author.Filter()
+		}
+	}
+
+	return &sgtmpb.PostList_Response{Posts: posts, NextCursor: nextCursor}, nil
 }
 
-func (svc *Service) Status(context.Context, *sgtmpb.Status_Request) (*sgtmpb.Status_Response, error) {
-	hostname, _ := os.Hostname()
-	return &sgtmpb.Status_Response{
-		Uptime:         int32(time.Since(svc.StartedAt).Seconds()),
-		Hostname:       hostname,
-		EverythingIsOk: true,
+func (svc *Service) PostGet(ctx context.Context, req *sgtmpb.PostGet_Request) (*sgtmpb.PostGet_Response, error) {
+	query := svc.rodb().Preload("Author")
+	switch {
+	case req.GetID() != 0:
+		query = query.Where(sgtmpb.Post{ID: req.GetID(), Kind: sgtmpb.Post_TrackKind})
+	case req.GetSlug() != "":
+		query = whereTrackSlug(query, req.GetSlug())
+	default:
+		return nil, status.Error(codes.InvalidArgument, "missing post ID or slug")
+	}
+	var post sgtmpb.Post
+	if err := query.First(&post).Error; err != nil {
+		return nil, postErrorToStatus(err)
+	}
+
+	// drafts are only visible by their author and by admins
+	if post.Visibility != sgtmpb.Visibility_Public {
+		user, _ := authUserFromContext(ctx)
+		if !canViewPost(user, &post) {
+			return nil, status.Error(codes.NotFound, "post not found")
+		}
+	}
+
+	post.Filter()
+	if post.Author != nil {
+		post.Author.Filter()
+	}
+	return &sgtmpb.PostGet_Response{Post: &post}, nil
+}
+
+func (svc *Service) PostCreate(ctx context.Context, req *sgtmpb.PostCreate_Request) (*sgtmpb.PostCreate_Response, error) {
+	user, err := authUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var post *sgtmpb.Post
+	switch {
+	case req.GetURL() != "":
+		post, err = svc.newTrackFromURL(user.ID, req.GetURL())
+	case len(req.GetUpload()) > 0:
+		post, err = svc.newTrackFromUpload(
+			user.ID,
+			bytes.NewReader(req.GetUpload()),
+			req.GetUploadFilename(),
+			req.GetUploadMIMEType(),
+			int64(len(req.GetUpload())),
+		)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "missing url or upload")
+	}
+	if err != nil {
+		return nil, postErrorToStatus(err)
+	}
+	if req.GetDraft() {
+		post.Visibility = sgtmpb.Visibility_Draft
+	}
+	if err := svc.createPost(post); err != nil {
+		return nil, err
+	}
+
+	post.Filter()
+	return &sgtmpb.PostCreate_Response{Post: post}, nil
+}
+
+func (svc *Service) PostUpdate(ctx context.Context, req *sgtmpb.PostUpdate_Request) (*sgtmpb.PostUpdate_Response, error) {
+	if req.GetID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing post ID")
+	}
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty update_mask")
+	}
+	post, err := svc.editablePostFromContext(ctx, req.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]interface{}{}
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		switch path {
+		case "title":
+			fields[path] = strings.TrimSpace(req.GetPost().GetTitle())
+		case "body":
+			fields[path] = strings.TrimSpace(req.GetPost().GetBody())
+		case "lyrics":
+			fields[path] = strings.TrimSpace(req.GetPost().GetLyrics())
+		case "visibility":
+			fields[path] = req.GetPost().GetVisibility()
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update_mask path: %q", path)
+		}
+	}
+	if err := svc.updatePost(post, fields); err != nil {
+		return nil, postErrorToStatus(err)
+	}
+
+	var updated sgtmpb.Post
+	if err := svc.rodb().Preload("Author").First(&updated, post.ID).Error; err != nil {
+		return nil, err
+	}
+	updated.Filter()
+	if updated.Author != nil {
+		updated.Author.Filter()
+	}
+	return &sgtmpb.PostUpdate_Response{Post: &updated}, nil
+}
+
+func (svc *Service) PostDelete(ctx context.Context, req *sgtmpb.PostDelete_Request) (*sgtmpb.PostDelete_Response, error) {
+	if req.GetID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing post ID")
+	}
+	post, err := svc.editablePostFromContext(ctx, req.GetID())
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.deletePost(post); err != nil {
+		return nil, err
+	}
+	return &sgtmpb.PostDelete_Response{}, nil
+}
+
+func (svc *Service) PostSync(ctx context.Context, req *sgtmpb.PostSync_Request) (*sgtmpb.PostSync_Response, error) {
+	if req.GetID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing post ID")
+	}
+	post, err := svc.editablePostFromContext(ctx, req.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := svc.syncPost(svc.rwdb(), post)
+	switch {
+	case errors.Is(err, errProviderUnsupported): // i.e., the uploads have nothing to sync
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, err
+	}
+	svc.logger.Debug("post synced", zap.Int64("id", post.ID), zap.Any("changes", changes))
+
+	post.Filter()
+	return &sgtmpb.PostSync_Response{
+		Post:          post,
+		UpdatedFields: sortedKeys(changes),
 	}, nil
 }
 
-func (svc *Service) UserList(context.Context, *sgtmpb.UserList_Request) (*sgtmpb.UserList_Response, error) {
-	ret := &sgtmpb.UserList_Response{}
+func (svc *Service) CommentList(ctx context.Context, req *sgtmpb.CommentList_Request) (*sgtmpb.CommentList_Response, error) {
+	if req.GetPostID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing post ID")
+	}
+	user, _ := authUserFromContext(ctx)
+	var track sgtmpb.Post
 	err := svc.rodb().
-		Order("created_at desc").
-		Find(&ret.Users).
+		Where(sgtmpb.Post{ID: req.GetPostID(), Kind: sgtmpb.Post_TrackKind}).
+		First(&track).
 		Error
+	if err != nil || !canViewPost(user, &track) {
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+
+	comments, err := svc.loadCommentTree(track.ID)
 	if err != nil {
 		return nil, err
 	}
+	filterCommentTree(comments)
+	return &sgtmpb.CommentList_Response{Comments: comments}, nil
+}
 
-	for _, user := range ret.Users {
-		user.Filter()
+func (svc *Service) CommentCreate(ctx context.Context, req *sgtmpb.CommentCreate_Request) (*sgtmpb.CommentCreate_Response, error) {
+	if req.GetPostID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing post ID")
+	}
+	user, err := authUserFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return ret, nil
+	var track sgtmpb.Post
+	err = svc.rodb().
+		Where(sgtmpb.Post{ID: req.GetPostID(), Kind: sgtmpb.Post_TrackKind}).
+		First(&track).
+		Error
+	if err != nil || !canViewPost(user, &track) {
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+
+	comment, err := svc.createComment(user, &track, req.GetReplyToID(), req.GetBody())
+	if err != nil {
+		return nil, postErrorToStatus(err)
+	}
+	comment.Filter()
+	return &sgtmpb.CommentCreate_Response{Comment: comment}, nil
+}
+
+func (svc *Service) CommentUpdate(ctx context.Context, req *sgtmpb.CommentUpdate_Request) (*sgtmpb.CommentUpdate_Response, error) {
+	if req.GetID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing comment ID")
+	}
+	user, err := authUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := svc.commentByID(req.GetID())
+	if err != nil {
+		return nil, postErrorToStatus(err)
+	}
+	if err := svc.updateComment(user, comment, req.GetBody()); err != nil {
+		return nil, postErrorToStatus(err)
+	}
+	comment.Filter()
+	return &sgtmpb.CommentUpdate_Response{Comment: comment}, nil
+}
+
+func (svc *Service) CommentDelete(ctx context.Context, req *sgtmpb.CommentDelete_Request) (*sgtmpb.CommentDelete_Response, error) {
+	if req.GetID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing comment ID")
+	}
+	user, err := authUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := svc.commentByID(req.GetID())
+	if err != nil {
+		return nil, postErrorToStatus(err)
+	}
+	if err := svc.deleteComment(user, comment); err != nil {
+		return nil, postErrorToStatus(err)
+	}
+	return &sgtmpb.CommentDelete_Response{}, nil
 }
 
-func (svc *Service) PostList(context.Context, *sgtmpb.PostList_Request) (*sgtmpb.PostList_Response, error) {
-	ret := &sgtmpb.PostList_Response{}
+func filterCommentTree(comments []*sgtmpb.Post) {
+	for _, comment := range comments {
+		comment.Filter()
+		if comment.IsDeleted() {
+			comment.Author = nil
+		} else if comment.Author != nil {
+			comment.Author.Filter()
+		}
+		filterCommentTree(comment.Replies)
+	}
+}
+
+func (svc *Service) ActivityStream(req *sgtmpb.ActivityStream_Request, stream sgtmpb.WebAPI_ActivityStreamServer) error {
+	activities, unsubscribe := svc.activities.subscribe(req)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-svc.ctx.Done():
+			return nil
+		case activity := <-activities:
+			if err := stream.Send(&sgtmpb.ActivityStream_Response{Activity: activity}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (svc *Service) RelationshipList(ctx context.Context, req *sgtmpb.RelationshipList_Request) (*sgtmpb.RelationshipList_Response, error) {
+	viewer, _ := authUserFromContext(ctx)
+	relationships, err := svc.listRelationships(req)
+	if err != nil {
+		return nil, postErrorToStatus(err)
+	}
+
+	// skip the relationships with a track the viewer cannot see, and the suggestions of the other users
+	ret := []*sgtmpb.Relationship{}
+	for _, relationship := range relationships {
+		if relationship.SourcePost == nil || !canViewPost(viewer, relationship.SourcePost) {
+			continue
+		}
+		if relationship.TargetPost != nil && !canViewPost(viewer, relationship.TargetPost) {
+			continue
+		}
+		if relationship.Suggested && !canEditPost(viewer, relationship.SourcePost) && (relationship.TargetPost == nil || !canEditPost(viewer, relationship.TargetPost)) {
+			continue // only the authors see the suggestions
+		}
+		relationship.SourcePost.Filter()
+		if relationship.TargetPost != nil {
+			relationship.TargetPost.Filter()
+		}
+		if relationship.TargetUser != nil {
+			relationship.TargetUser.Filter()
+		}
+		ret = append(ret, relationship)
+	}
+	return &sgtmpb.RelationshipList_Response{Relationships: ret}, nil
+}
+
+func (svc *Service) RelationshipCreate(ctx context.Context, req *sgtmpb.RelationshipCreate_Request) (*sgtmpb.RelationshipCreate_Response, error) {
+	user, err := authUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	relationship, err := svc.createRelationship(user, req)
+	if err != nil {
+		return nil, postErrorToStatus(err)
+	}
+	return &sgtmpb.RelationshipCreate_Response{Relationship: relationship}, nil
+}
+
+func (svc *Service) RelationshipDelete(ctx context.Context, req *sgtmpb.RelationshipDelete_Request) (*sgtmpb.RelationshipDelete_Response, error) {
+	if req.GetID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing relationship ID")
+	}
+	if err := svc.deleteRelationship(req.GetID()); err != nil {
+		return nil, err
+	}
+	return &sgtmpb.RelationshipDelete_Response{}, nil
+}
+
+func (svc *Service) RelationshipGraph(ctx context.Context, req *sgtmpb.RelationshipGraph_Request) (*sgtmpb.RelationshipGraph_Response, error) {
+	viewer, _ := authUserFromContext(ctx)
+	var (
+		graph *relationshipGraph
+		err   error
+	)
+	switch {
+	case req.GetPostID() != 0:
+		var post sgtmpb.Post
+		err = svc.rodb().Where(sgtmpb.Post{ID: req.GetPostID(), Kind: sgtmpb.Post_TrackKind}).First(&post).Error
+		if err != nil || !canViewPost(viewer, &post) {
+			return nil, status.Error(codes.NotFound, "post not found")
+		}
+		graph, err = svc.postLineage(viewer, post.ID, graphDepth(req.GetMaxDepth(), defaultLineageDepth))
+	case req.GetUserID() != 0:
+		var user sgtmpb.User
+		if err := svc.rodb().First(&user, req.GetUserID()).Error; err != nil {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		graph, err = svc.userCollaborators(viewer, user.ID, graphDepth(req.GetMaxDepth(), defaultCollaboratorsDepth))
+	default:
+		return nil, status.Error(codes.InvalidArgument, "missing post ID or user ID")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return graph.toProto(), nil
+}
+
+func (svc *Service) MigrationList(_ context.Context, req *sgtmpb.MigrationList_Request) (*sgtmpb.MigrationList_Response, error) {
+	if req.GetPostID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing post ID")
+	}
+	var runs []*sgtmpb.MigrationRun
 	err := svc.rodb().
-		Order("sort_date desc").
-		Where(sgtmpb.Post{
-			Visibility: sgtmpb.Visibility_Public,
-		}).
-		Where("kind in (?)", sgtmpb.Post_TrackKind).
-		Limit(100).
-		Find(&ret.Posts).
+		Where(sgtmpb.MigrationRun{PostID: req.GetPostID()}).
+		Order("ran_at").
+		Find(&runs).
 		Error
 	if err != nil {
 		return nil, err
 	}
+	return &sgtmpb.MigrationList_Response{Names: svc.trackMigrationNames(), Runs: runs}, nil
+}
 
-	for _, post := range ret.Posts {
-		post.Filter()
+func (svc *Service) MigrationReplay(_ context.Context, req *sgtmpb.MigrationReplay_Request) (*sgtmpb.MigrationReplay_Response, error) {
+	queued, err := svc.replayTrackMigration(req)
+	if err != nil {
+		return nil, postErrorToStatus(err)
+	}
+	return &sgtmpb.MigrationReplay_Response{QueuedTracks: queued}, nil
+}
+
+func (svc *Service) ProcessingFailureList(_ context.Context, _ *sgtmpb.ProcessingFailureList_Request) (*sgtmpb.ProcessingFailureList_Response, error) {
+	failures, err := svc.processingFailures()
+	if err != nil {
+		return nil, err
+	}
+	return &sgtmpb.ProcessingFailureList_Response{Failures: failures}, nil
+}
+
+func (svc *Service) ProcessingFailureRetry(_ context.Context, req *sgtmpb.ProcessingFailureRetry_Request) (*sgtmpb.ProcessingFailureRetry_Response, error) {
+	queued, err := svc.retryProcessingFailures(processingFailureFilter{
+		Migration: req.GetMigration(),
+		Error:     req.GetError(),
+		PostID:    req.GetPostID(),
+		UserID:    req.GetUserID(),
+		All:       req.GetAll(),
+	})
+	if err != nil {
+		return nil, postErrorToStatus(err)
 	}
+	return &sgtmpb.ProcessingFailureRetry_Response{QueuedTracks: queued}, nil
+}
 
-	return ret, nil
+func (svc *Service) ProcessingFailureClear(_ context.Context, req *sgtmpb.ProcessingFailureClear_Request) (*sgtmpb.ProcessingFailureClear_Response, error) {
+	tracks, users, err := svc.clearProcessingFailures(processingFailureFilter{
+		Migration: req.GetMigration(),
+		Error:     req.GetError(),
+		PostID:    req.GetPostID(),
+		UserID:    req.GetUserID(),
+		All:       req.GetAll(),
+	})
+	if err != nil {
+		return nil, postErrorToStatus(err)
+	}
+	return &sgtmpb.ProcessingFailureClear_Response{ClearedTracks: tracks, ClearedUsers: users}, nil
+}
+
+func (svc *Service) APITokenList(ctx context.Context, _ *sgtmpb.APITokenList_Request) (*sgtmpb.APITokenList_Response, error) {
+	user, err := authUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := svc.listAPITokens(user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, token := range tokens {
+		token.Filter()
+	}
+	return &sgtmpb.APITokenList_Response{Tokens: tokens}, nil
+}
+
+func (svc *Service) APITokenCreate(ctx context.Context, req *sgtmpb.APITokenCreate_Request) (*sgtmpb.APITokenCreate_Response, error) {
+	user, err := authUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, secret, err := svc.createAPIToken(user, req.GetName(), req.GetScopes())
+	if err != nil {
+		return nil, postErrorToStatus(err)
+	}
+	token.Filter()
+	return &sgtmpb.APITokenCreate_Response{Token: token, Secret: secret}, nil
+}
+
+func (svc *Service) APITokenRevoke(ctx context.Context, req *sgtmpb.APITokenRevoke_Request) (*sgtmpb.APITokenRevoke_Response, error) {
+	if req.GetID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing token ID")
+	}
+	user, err := authUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.revokeAPIToken(user.ID, req.GetID()); err != nil {
+		return nil, err
+	}
+	return &sgtmpb.APITokenRevoke_Response{}, nil
 }
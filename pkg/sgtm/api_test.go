@@ -1,5 +1,24 @@
 package sgtm
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yanatan16/golang-soundcloud/soundcloud"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
 /*func TestServiceRegister(t *testing.T) {
 	svc := TestingService(t)
 	logger := TestingLogger(t)
@@ -48,3 +67,432 @@ package sgtm
 		})
 	}
 }*/
+
+func TestServicePostSync(t *testing.T) {
+	svc := TestingService(t)
+	client := testingClient(t, &svc)
+
+	track := soundcloud.Track{
+		Id:          42,
+		Title:       "new title",
+		Description: "new description",
+		Genre:       "Electronic",
+		TagList:     "chill",
+		Duration:    120000,
+		Bpm:         128,
+		ArtworkUrl:  "https://i1.sndcdn.com/artworks-42-large.jpg",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tracks/42.json" || r.URL.Query().Get("secret_token") != "s-secret" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(track)
+	}))
+	defer server.Close()
+	svc.soundcloud = NewSoundCloudClient("test", server.URL)
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	other := sgtmpb.User{Email: "other@example.com", Slug: "other"}
+	require.NoError(t, svc.rwdb().Create(&other).Error)
+	post := sgtmpb.Post{
+		AuthorID:              author.ID,
+		Kind:                  sgtmpb.Post_TrackKind,
+		Provider:              sgtmpb.Provider_SoundCloud,
+		ProviderTitle:         "old title",
+		Duration:              120000,
+		SoundCloudID:          42,
+		SoundCloudSecretToken: "s-secret",
+	}
+	require.NoError(t, svc.rwdb().Create(&post).Error)
+
+	_, err := client.PostSync(testingAuthContext(t, &svc, author.ID), &sgtmpb.PostSync_Request{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// only the author or an admin can sync a post
+	_, err = client.PostSync(testingAuthContext(t, &svc, other.ID), &sgtmpb.PostSync_Request{ID: post.ID})
+	require.Error(t, err)
+
+	ret, err := client.PostSync(testingAuthContext(t, &svc, author.ID), &sgtmpb.PostSync_Request{ID: post.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"artwork_url",
+		"bpm",
+		"provider_description",
+		"provider_metadata",
+		"provider_title",
+		"provider_updated_at",
+		"tags",
+	}, ret.UpdatedFields)
+	require.Equal(t, "new title", ret.Post.ProviderTitle)
+	require.Equal(t, "https://i1.sndcdn.com/artworks-42-t500x500.jpg", ret.Post.ArtworkURL)
+	require.Empty(t, ret.Post.ProviderMetadata) // filtered
+
+	var saved sgtmpb.Post
+	require.NoError(t, svc.rodb().First(&saved, post.ID).Error)
+	require.Equal(t, "new title", saved.ProviderTitle)
+	require.Equal(t, "Electronic, chill", saved.Tags)
+	require.Equal(t, float64(128), saved.BPM)
+	require.NotZero(t, saved.ProviderUpdatedAt)
+
+	// nothing changed since the last sync
+	ret, err = client.PostSync(testingAuthContext(t, &svc, author.ID), &sgtmpb.PostSync_Request{ID: post.ID})
+	require.NoError(t, err)
+	require.Empty(t, ret.UpdatedFields)
+
+	// the uploads cannot be synced
+	upload := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Provider: sgtmpb.Provider_IPFS, IPFSCID: "QmUpload"}
+	require.NoError(t, svc.rwdb().Create(&upload).Error)
+	_, err = client.PostSync(testingAuthContext(t, &svc, author.ID), &sgtmpb.PostSync_Request{ID: upload.ID})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestServicePostList(t *testing.T) {
+	svc := TestingService(t)
+	client := testingClient(t, &svc)
+	ctx := context.Background()
+
+	alice := sgtmpb.User{Email: "alice@example.com", Slug: "alice"}
+	require.NoError(t, svc.rwdb().Create(&alice).Error)
+	bob := sgtmpb.User{Email: "bob@example.com", Slug: "bob"}
+	require.NoError(t, svc.rwdb().Create(&bob).Error)
+	for i, author := range []*sgtmpb.User{&alice, &bob, &alice, &bob, &alice} {
+		post := sgtmpb.Post{
+			AuthorID:   author.ID,
+			Kind:       sgtmpb.Post_TrackKind,
+			Visibility: sgtmpb.Visibility_Public,
+			Title:      fmt.Sprintf("track %d", i),
+			SortDate:   int64(i + 1),
+			Tags:       "Electronic, chill",
+			BPM:        float64(100 + i*10),
+		}
+		if i%2 == 1 {
+			post.Tags = "Rock"
+		}
+		require.NoError(t, svc.rwdb().Create(&post).Error)
+	}
+	draft := sgtmpb.Post{AuthorID: alice.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Draft, SortDate: 10}
+	require.NoError(t, svc.rwdb().Create(&draft).Error)
+
+	titles := func(posts []*sgtmpb.Post) []string {
+		ret := []string{}
+		for _, post := range posts {
+			ret = append(ret, post.Title)
+		}
+		return ret
+	}
+
+	// pagination
+	ret, err := client.PostList(ctx, &sgtmpb.PostList_Request{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"track 4", "track 3"}, titles(ret.Posts))
+	require.NotEmpty(t, ret.NextCursor)
+	ret, err = client.PostList(ctx, &sgtmpb.PostList_Request{Limit: 2, Cursor: ret.NextCursor})
+	require.NoError(t, err)
+	require.Equal(t, []string{"track 2", "track 1"}, titles(ret.Posts))
+	ret, err = client.PostList(ctx, &sgtmpb.PostList_Request{Limit: 2, Cursor: ret.NextCursor})
+	require.NoError(t, err)
+	require.Equal(t, []string{"track 0"}, titles(ret.Posts))
+	require.Empty(t, ret.NextCursor)
+
+	_, err = client.PostList(ctx, &sgtmpb.PostList_Request{Cursor: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// filters
+	ret, err = client.PostList(ctx, &sgtmpb.PostList_Request{AuthorSlug: "bob"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"track 3", "track 1"}, titles(ret.Posts))
+	ret, err = client.PostList(ctx, &sgtmpb.PostList_Request{Tags: []string{"chill"}, BPMMin: 110})
+	require.NoError(t, err)
+	require.Equal(t, []string{"track 4", "track 2"}, titles(ret.Posts))
+	for _, tag := range []string{"%", "ch_ll", "chi%"} { // the wildcards are matched literally
+		ret, err = client.PostList(ctx, &sgtmpb.PostList_Request{Tags: []string{tag}})
+		require.NoError(t, err)
+		require.Empty(t, ret.Posts, tag)
+	}
+
+	// users
+	users, err := client.UserList(ctx, &sgtmpb.UserList_Request{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, users.Users, 1)
+	require.Equal(t, "bob", users.Users[0].Slug)
+	users, err = client.UserList(ctx, &sgtmpb.UserList_Request{Limit: 1, Cursor: users.NextCursor})
+	require.NoError(t, err)
+	require.Equal(t, "alice", users.Users[0].Slug)
+	require.Empty(t, users.NextCursor)
+}
+
+func TestServicePostCRUD(t *testing.T) {
+	svc := TestingService(t)
+	client := testingClient(t, &svc)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/resolve.json":
+			if r.URL.Query().Get("url") != "https://soundcloud.com/author/track" {
+				http.NotFound(w, r)
+				return
+			}
+			http.Redirect(w, r, "https://api.soundcloud.com/tracks/42.json", http.StatusFound)
+		case "/tracks/42.json":
+			_ = json.NewEncoder(w).Encode(soundcloud.Track{Id: 42, Title: "track", CreatedAt: "2020/10/10 10:10:10 +0000"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	svc.soundcloud = NewSoundCloudClient("test", server.URL)
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	other := sgtmpb.User{Email: "other@example.com", Slug: "other"}
+	require.NoError(t, svc.rwdb().Create(&other).Error)
+	authorCtx := testingAuthContext(t, &svc, author.ID)
+	otherCtx := testingAuthContext(t, &svc, other.ID)
+
+	// create
+	_, err := client.PostCreate(context.Background(), &sgtmpb.PostCreate_Request{URL: "https://soundcloud.com/author/track"})
+	require.Error(t, err)
+	_, err = client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: "https://soundcloud.com/author/missing"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: "https://example.com/track"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	created, err := client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: "https://soundcloud.com/author/track", Draft: true})
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), created.Post.SoundCloudID)
+	require.Equal(t, "track", created.Post.ProviderTitle)
+	require.Equal(t, created.Post.ProviderCreatedAt, created.Post.SortDate)
+	require.Equal(t, sgtmpb.Visibility_Draft, created.Post.Visibility)
+	_, err = client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: "https://soundcloud.com/author/track"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	id := created.Post.ID
+
+	// get: drafts are only visible by their author
+	_, err = client.PostGet(otherCtx, &sgtmpb.PostGet_Request{ID: id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	got, err := client.PostGet(authorCtx, &sgtmpb.PostGet_Request{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, "author", got.Post.Author.Slug)
+
+	// update
+	update := &sgtmpb.PostUpdate_Request{
+		ID:         id,
+		Post:       &sgtmpb.Post{Title: " new title ", Body: "ignored", Visibility: sgtmpb.Visibility_Public},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "visibility"}},
+	}
+	_, err = client.PostUpdate(otherCtx, update)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	updated, err := client.PostUpdate(authorCtx, update)
+	require.NoError(t, err)
+	require.Equal(t, "new title", updated.Post.Title)
+	require.Empty(t, updated.Post.Body)
+	require.Equal(t, sgtmpb.Visibility_Public, updated.Post.Visibility)
+	_, err = client.PostUpdate(authorCtx, &sgtmpb.PostUpdate_Request{ID: id, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"author_id"}}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	got, err = client.PostGet(context.Background(), &sgtmpb.PostGet_Request{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, "new title", got.Post.Title)
+
+	// delete
+	_, err = client.PostDelete(otherCtx, &sgtmpb.PostDelete_Request{ID: id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.PostDelete(authorCtx, &sgtmpb.PostDelete_Request{ID: id})
+	require.NoError(t, err)
+	_, err = client.PostGet(authorCtx, &sgtmpb.PostGet_Request{ID: id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestDeletePost(t *testing.T) {
+	svc := TestingService(t)
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	album := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_AlbumKind, Visibility: sgtmpb.Visibility_Public}
+	require.NoError(t, svc.rwdb().Create(&album).Error)
+	var tracks []*sgtmpb.Post
+	for i := 1; i <= 2; i++ {
+		track := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Public, AlbumID: album.ID, AlbumPosition: int64(i)}
+		require.NoError(t, svc.rwdb().Create(&track).Error)
+		tracks = append(tracks, &track)
+	}
+	track, other := tracks[0], tracks[1]
+	for _, post := range tracks {
+		for _, record := range []interface{}{
+			&sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_CommentKind, TargetPostID: post.ID},
+			&sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_ViewPostKind, TargetPostID: post.ID},
+			&sgtmpb.Rendition{PostID: post.ID, Codec: "mp3"},
+			&sgtmpb.Waveform{PostID: post.ID},
+			&sgtmpb.Fingerprint{PostID: post.ID},
+			&sgtmpb.FingerprintHash{PostID: post.ID, Hash: 42},
+			&sgtmpb.MigrationRun{PostID: post.ID, Name: "bpm"},
+			&sgtmpb.Job{PostID: post.ID, Kind: sgtmpb.Job_ProcessTrackKind},
+		} {
+			require.NoError(t, svc.rwdb().Create(record).Error)
+		}
+	}
+	require.NoError(t, svc.rwdb().Create(&sgtmpb.Relationship{Kind: sgtmpb.Relationship_RemixOfTrackKind, SourcePostID: other.ID, TargetPostID: track.ID}).Error)
+
+	count := func(model interface{}, query string, args ...interface{}) int64 {
+		var count int64
+		require.NoError(t, svc.rodb().Model(model).Where(query, args...).Count(&count).Error)
+		return count
+	}
+	records := func(id int64) []int64 {
+		return []int64{
+			count(&sgtmpb.Post{}, "target_post_id = ?", id),
+			count(&sgtmpb.Rendition{}, "post_id = ?", id),
+			count(&sgtmpb.Waveform{}, "post_id = ?", id),
+			count(&sgtmpb.Fingerprint{}, "post_id = ?", id),
+			count(&sgtmpb.FingerprintHash{}, "post_id = ?", id),
+			count(&sgtmpb.MigrationRun{}, "post_id = ?", id),
+			count(&sgtmpb.Job{}, "post_id = ?", id),
+			count(&sgtmpb.Relationship{}, "source_post_id = ? OR target_post_id = ?", id, id),
+		}
+	}
+	require.Equal(t, []int64{2, 1, 1, 1, 1, 1, 1, 1}, records(track.ID))
+
+	// the records of the track are deleted, the ones of the other tracks are kept
+	require.NoError(t, svc.deletePost(track))
+	require.Equal(t, []int64{0, 0, 0, 0, 0, 0, 0, 0}, records(track.ID))
+	require.Equal(t, []int64{2, 1, 1, 1, 1, 1, 1, 0}, records(other.ID))
+
+	// the tracks of a deleted album are kept
+	require.NoError(t, svc.deletePost(&album))
+	var kept sgtmpb.Post
+	require.NoError(t, svc.rodb().First(&kept, other.ID).Error)
+	require.Zero(t, kept.AlbumID)
+	require.Zero(t, kept.AlbumPosition)
+}
+
+func TestServiceComments(t *testing.T) {
+	svc := TestingService(t)
+	client := testingClient(t, &svc)
+
+	owner := sgtmpb.User{Email: "owner@example.com", Slug: "owner"}
+	require.NoError(t, svc.rwdb().Create(&owner).Error)
+	alice := sgtmpb.User{Email: "alice@example.com", Slug: "alice"}
+	require.NoError(t, svc.rwdb().Create(&alice).Error)
+	bob := sgtmpb.User{Email: "bob@example.com", Slug: "bob"}
+	require.NoError(t, svc.rwdb().Create(&bob).Error)
+	track := sgtmpb.Post{AuthorID: owner.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Public}
+	require.NoError(t, svc.rwdb().Create(&track).Error)
+	ownerCtx := testingAuthContext(t, &svc, owner.ID)
+	aliceCtx := testingAuthContext(t, &svc, alice.ID)
+	bobCtx := testingAuthContext(t, &svc, bob.ID)
+
+	// create a thread
+	_, err := client.CommentCreate(aliceCtx, &sgtmpb.CommentCreate_Request{PostID: track.ID, Body: "  "})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	root, err := client.CommentCreate(aliceCtx, &sgtmpb.CommentCreate_Request{PostID: track.ID, Body: "first"})
+	require.NoError(t, err)
+	reply, err := client.CommentCreate(bobCtx, &sgtmpb.CommentCreate_Request{PostID: track.ID, ReplyToID: root.Comment.ID, Body: "reply"})
+	require.NoError(t, err)
+	require.Equal(t, root.Comment.ID, reply.Comment.ReplyToID)
+	require.Equal(t, root.Comment.ID, reply.Comment.ThreadPostID)
+	nested, err := client.CommentCreate(aliceCtx, &sgtmpb.CommentCreate_Request{PostID: track.ID, ReplyToID: reply.Comment.ID, Body: "nested"})
+	require.NoError(t, err)
+	require.Equal(t, reply.Comment.ID, nested.Comment.ReplyToID)
+	require.Equal(t, root.Comment.ID, nested.Comment.ThreadPostID)
+	other, err := client.CommentCreate(bobCtx, &sgtmpb.CommentCreate_Request{PostID: track.ID, Body: "second"})
+	require.NoError(t, err)
+
+	list, err := client.CommentList(context.Background(), &sgtmpb.CommentList_Request{PostID: track.ID})
+	require.NoError(t, err)
+	require.Len(t, list.Comments, 2)
+	require.Equal(t, "first", list.Comments[0].Body)
+	require.Equal(t, "reply", list.Comments[0].Replies[0].Body)
+	require.Equal(t, "nested", list.Comments[0].Replies[0].Replies[0].Body)
+	require.Equal(t, "second", list.Comments[1].Body)
+
+	// edit: author only
+	_, err = client.CommentUpdate(bobCtx, &sgtmpb.CommentUpdate_Request{ID: root.Comment.ID, Body: "hacked"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.CommentUpdate(aliceCtx, &sgtmpb.CommentUpdate_Request{ID: other.Comment.ID + 100, Body: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	updated, err := client.CommentUpdate(aliceCtx, &sgtmpb.CommentUpdate_Request{ID: root.Comment.ID, Body: "first (edited)"})
+	require.NoError(t, err)
+	require.Equal(t, "first (edited)", updated.Comment.Body)
+	require.NotZero(t, updated.Comment.EditedAt)
+
+	// delete: author, track owner or admin
+	_, err = client.CommentDelete(bobCtx, &sgtmpb.CommentDelete_Request{ID: root.Comment.ID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.CommentDelete(aliceCtx, &sgtmpb.CommentDelete_Request{ID: root.Comment.ID})
+	require.NoError(t, err)
+	_, err = client.CommentDelete(ownerCtx, &sgtmpb.CommentDelete_Request{ID: other.Comment.ID})
+	require.NoError(t, err)
+	_, err = client.CommentCreate(bobCtx, &sgtmpb.CommentCreate_Request{PostID: track.ID, ReplyToID: root.Comment.ID, Body: "too late"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// deleted comments are kept as placeholders while they have replies
+	list, err = client.CommentList(context.Background(), &sgtmpb.CommentList_Request{PostID: track.ID})
+	require.NoError(t, err)
+	require.Len(t, list.Comments, 1)
+	require.NotZero(t, list.Comments[0].DeletedAt)
+	require.Empty(t, list.Comments[0].Body)
+	require.Nil(t, list.Comments[0].Author)
+	require.Equal(t, "reply", list.Comments[0].Replies[0].Body)
+}
+
+func TestServiceAPITokens(t *testing.T) {
+	svc := TestingService(t)
+	client := testingClient(t, &svc)
+
+	user := sgtmpb.User{Email: "alice@example.com", Slug: "alice"}
+	require.NoError(t, svc.rwdb().Create(&user).Error)
+	sessionCtx := testingAuthContext(t, &svc, user.ID)
+	tokenCtx := func(secret string) context.Context {
+		return metadata.NewOutgoingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+secret))
+	}
+
+	// create
+	_, err := client.APITokenCreate(sessionCtx, &sgtmpb.APITokenCreate_Request{Name: "script"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.APITokenCreate(sessionCtx, &sgtmpb.APITokenCreate_Request{Name: "script", Scopes: []string{"admin"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	created, err := client.APITokenCreate(sessionCtx, &sgtmpb.APITokenCreate_Request{Name: "script", Scopes: []string{scopeRead}})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(created.Secret, apiTokenSecretPrefix))
+	require.Empty(t, created.Token.TokenHash)
+	var stored sgtmpb.APIToken
+	require.NoError(t, svc.rodb().First(&stored, created.Token.ID).Error)
+	require.NotEqual(t, created.Secret, stored.TokenHash)
+	require.Equal(t, hashAPITokenSecret(created.Secret), stored.TokenHash)
+
+	// use
+	me, err := client.Me(tokenCtx(created.Secret), &sgtmpb.Me_Request{})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, me.User.ID)
+	_, err = client.Me(tokenCtx(apiTokenSecretPrefix+"invalid"), &sgtmpb.Me_Request{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.PostCreate(tokenCtx(created.Secret), &sgtmpb.PostCreate_Request{URL: "https://soundcloud.com/a/b"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.APITokenList(tokenCtx(created.Secret), &sgtmpb.APITokenList_Request{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	list, err := client.APITokenList(sessionCtx, &sgtmpb.APITokenList_Request{})
+	require.NoError(t, err)
+	require.Len(t, list.Tokens, 1)
+	require.NotZero(t, list.Tokens[0].LastUsedAt)
+
+	// the usage is recorded at most once per minute
+	lastUsedAt := list.Tokens[0].LastUsedAt
+	_, err = svc.apiTokenFromSecret(created.Secret)
+	require.NoError(t, err)
+	require.NoError(t, svc.rodb().First(&stored, created.Token.ID).Error)
+	require.Equal(t, lastUsedAt, stored.LastUsedAt)
+	outdated := time.Now().Add(-2 * apiTokenUsageResolution).UnixNano()
+	require.NoError(t, svc.rwdb().Model(&stored).UpdateColumn("last_used_at", outdated).Error)
+	_, err = svc.apiTokenFromSecret(created.Secret)
+	require.NoError(t, err)
+	require.NoError(t, svc.rodb().First(&stored, created.Token.ID).Error)
+	require.Greater(t, stored.LastUsedAt, outdated)
+
+	// revoke
+	_, err = client.APITokenRevoke(sessionCtx, &sgtmpb.APITokenRevoke_Request{ID: created.Token.ID})
+	require.NoError(t, err)
+	_, err = client.APITokenRevoke(sessionCtx, &sgtmpb.APITokenRevoke_Request{ID: created.Token.ID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.Me(tokenCtx(created.Secret), &sgtmpb.Me_Request{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,153 @@
+package sgtm
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// scopes of the personal API tokens
+const (
+	scopeRead         = "read"
+	scopePostWrite    = "post:write"
+	scopeCommentWrite = "comment:write"
+
+	// scopeSessionOnly is used by the methods that cannot be called with an API token.
+	scopeSessionOnly = ""
+)
+
+var apiTokenScopes = []string{scopeRead, scopePostWrite, scopeCommentWrite}
+
+const (
+	apiTokenSecretPrefix = "sgtm_"
+	apiTokenSecretBytes  = 32
+	apiTokenPrefixLength = 12
+	maxAPITokensPerUser  = 20
+
+	// apiTokenUsageResolution avoids a write on each request authenticated with a token.
+	apiTokenUsageResolution = time.Minute
+)
+
+func hashAPITokenSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// bearerFromMetadata returns the value of the "Authorization: Bearer" header, if any.
+func bearerFromMetadata(md metadata.MD) string {
+	for _, value := range md.Get("authorization") {
+		if len(value) > 7 && strings.EqualFold(value[:7], "bearer ") {
+			return strings.TrimSpace(value[7:])
+		}
+	}
+	return ""
+}
+
+// createAPIToken generates a new token for the user and returns its secret; only its hash is stored.
+func (svc *Service) createAPIToken(user *sgtmpb.User, name string, scopes []string) (*sgtmpb.APIToken, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", postInputError("A token needs a name.")
+	}
+	if len(scopes) == 0 {
+		return nil, "", postInputError("A token needs at least one scope.")
+	}
+	for _, scope := range scopes {
+		if !isValidAPITokenScope(scope) {
+			return nil, "", postInputError(fmt.Sprintf("Invalid scope: %q.", scope))
+		}
+	}
+	var count int64
+	if err := svc.rodb().Model(&sgtmpb.APIToken{}).Where(sgtmpb.APIToken{UserID: user.ID}).Count(&count).Error; err != nil {
+		return nil, "", err
+	}
+	if count >= maxAPITokensPerUser {
+		return nil, "", postInputError(fmt.Sprintf("You cannot have more than %d tokens.", maxAPITokensPerUser))
+	}
+
+	raw := make([]byte, apiTokenSecretBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", err
+	}
+	secret := apiTokenSecretPrefix + base64.RawURLEncoding.EncodeToString(raw)
+	token := sgtmpb.APIToken{
+		UserID:      user.ID,
+		Name:        name,
+		TokenHash:   hashAPITokenSecret(secret),
+		TokenPrefix: secret[:apiTokenPrefixLength],
+		Scopes:      strings.Join(scopes, ","),
+	}
+	if err := svc.rwdb().Create(&token).Error; err != nil {
+		return nil, "", err
+	}
+	svc.logger.Debug("API token created", zap.Int64("id", token.ID), zap.Int64("user", user.ID), zap.String("scopes", token.Scopes))
+	return &token, secret, nil
+}
+
+func isValidAPITokenScope(scope string) bool {
+	for _, candidate := range apiTokenScopes {
+		if candidate == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (svc *Service) listAPITokens(userID int64) ([]*sgtmpb.APIToken, error) {
+	var tokens []*sgtmpb.APIToken
+	err := svc.rodb().
+		Where(sgtmpb.APIToken{UserID: userID}).
+		Order("created_at desc").
+		Find(&tokens).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (svc *Service) revokeAPIToken(userID int64, tokenID int64) error {
+	ret := svc.rwdb().
+		Where(sgtmpb.APIToken{ID: tokenID, UserID: userID}).
+		Delete(&sgtmpb.APIToken{})
+	if ret.Error != nil {
+		return ret.Error
+	}
+	if ret.RowsAffected == 0 {
+		return status.Error(codes.NotFound, "token not found")
+	}
+	svc.logger.Debug("API token revoked", zap.Int64("id", tokenID), zap.Int64("user", userID))
+	return nil
+}
+
+// apiTokenFromSecret returns the token matching a secret and records its usage, at most once per
+// apiTokenUsageResolution.
+func (svc *Service) apiTokenFromSecret(secret string) (*sgtmpb.APIToken, error) {
+	var token sgtmpb.APIToken
+	err := svc.rodb().
+		Where(sgtmpb.APIToken{TokenHash: hashAPITokenSecret(secret)}).
+		First(&token).
+		Error
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid API token")
+	}
+	now := time.Now()
+	if now.Sub(time.Unix(0, token.LastUsedAt)) < apiTokenUsageResolution {
+		return &token, nil
+	}
+	token.LastUsedAt = now.UnixNano()
+	if err := svc.rwdb().Model(&token).UpdateColumn("last_used_at", token.LastUsedAt).Error; err != nil {
+		svc.logger.Warn("update API token usage", zap.Error(err))
+	}
+	return &token, nil
+}
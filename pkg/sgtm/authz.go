@@ -0,0 +1,327 @@
+package sgtm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// accessLevel is the kind of user allowed to call an RPC or to open a page.
+type accessLevel int
+
+const (
+	// accessPublic allows anyone; the user is still resolved when authenticated.
+	accessPublic accessLevel = iota
+	// accessUser requires an authenticated user.
+	accessUser
+	// accessOwner requires one of the owners of the targeted resource, or an admin.
+	accessOwner
+	// accessRole requires a user with a specific role; admins have every role.
+	accessRole
+)
+
+const (
+	roleAdmin     = "admin"
+	roleModerator = "moderator"
+)
+
+// accessRule declares who can call an RPC or open a page.
+type accessRule struct {
+	Level accessLevel
+	// Role is the required role, for accessRole; for accessOwner, the users with this role are allowed too.
+	Role string
+	// Scope is the scope required for the requests authenticated with an API token;
+	// scopeSessionOnly rejects the API tokens.
+	Scope string
+	// Owner returns the IDs of the users owning the resource targeted by the request, for accessOwner.
+	// The request is a proto message for the RPCs and an *http.Request for the pages.
+	Owner func(svc *Service, req interface{}) ([]int64, error)
+}
+
+var (
+	publicAccess      = accessRule{Level: accessPublic, Scope: scopeRead}
+	sessionUserAccess = accessRule{Level: accessUser, Scope: scopeSessionOnly}
+	trackOwnerAccess  = accessRule{Level: accessOwner, Scope: scopePostWrite, Owner: trackOwner}
+	adminAccess       = accessRule{Level: accessRole, Role: roleAdmin, Scope: scopeSessionOnly}
+)
+
+// methodAccess lists the access rule of every RPC; the RPCs missing here are denied.
+var methodAccess = map[string]accessRule{
+	"/sgtm.WebAPI/UserList":               publicAccess,
+	"/sgtm.WebAPI/PostList":               publicAccess,
+	"/sgtm.WebAPI/PostGet":                publicAccess,
+	"/sgtm.WebAPI/PostCreate":             {Level: accessUser, Scope: scopePostWrite},
+	"/sgtm.WebAPI/PostUpdate":             trackOwnerAccess,
+	"/sgtm.WebAPI/PostDelete":             trackOwnerAccess,
+	"/sgtm.WebAPI/PostSync":               trackOwnerAccess,
+	"/sgtm.WebAPI/CommentList":            publicAccess,
+	"/sgtm.WebAPI/CommentCreate":          {Level: accessUser, Scope: scopeCommentWrite},
+	"/sgtm.WebAPI/CommentUpdate":          {Level: accessOwner, Scope: scopeCommentWrite, Owner: commentAuthor},
+	"/sgtm.WebAPI/CommentDelete":          {Level: accessOwner, Role: roleModerator, Scope: scopeCommentWrite, Owner: commentOwners},
+	"/sgtm.WebAPI/ActivityStream":         publicAccess,
+	"/sgtm.WebAPI/RelationshipList":       publicAccess,
+	"/sgtm.WebAPI/RelationshipCreate":     {Level: accessOwner, Scope: scopePostWrite, Owner: relationshipOwner},
+	"/sgtm.WebAPI/RelationshipDelete":     {Level: accessOwner, Scope: scopePostWrite, Owner: relationshipOwner},
+	"/sgtm.WebAPI/RelationshipGraph":      publicAccess,
+	"/sgtm.WebAPI/MigrationList":          adminAccess,
+	"/sgtm.WebAPI/MigrationReplay":        adminAccess,
+	"/sgtm.WebAPI/ProcessingFailureList":  adminAccess,
+	"/sgtm.WebAPI/ProcessingFailureRetry": adminAccess,
+	"/sgtm.WebAPI/ProcessingFailureClear": adminAccess,
+	"/sgtm.WebAPI/APITokenList":           sessionUserAccess,
+	"/sgtm.WebAPI/APITokenCreate":         sessionUserAccess,
+	"/sgtm.WebAPI/APITokenRevoke":         sessionUserAccess,
+	"/sgtm.WebAPI/Me":                     {Level: accessUser, Scope: scopeRead},
+	"/sgtm.WebAPI/Ping":                   publicAccess,
+	"/sgtm.WebAPI/Status":                 publicAccess,
+}
+
+// hasRole returns true if the user has the role; admins have every role.
+func hasRole(user *sgtmpb.User, role string) bool {
+	return user != nil && (user.Role == roleAdmin || user.Role == role)
+}
+
+// trackOwner returns the author of the track targeted by a request.
+func trackOwner(svc *Service, req interface{}) ([]int64, error) {
+	authorID, err := trackAuthorID(svc, req)
+	if err != nil {
+		return nil, err
+	}
+	return []int64{authorID}, nil
+}
+
+func trackAuthorID(svc *Service, req interface{}) (int64, error) {
+	query := svc.rodb().Model(&sgtmpb.Post{}).Select("author_id")
+	switch req := req.(type) {
+	case interface{ GetID() int64 }:
+		if req.GetID() == 0 {
+			return 0, status.Error(codes.InvalidArgument, "missing post ID")
+		}
+		query = query.Where(sgtmpb.Post{ID: req.GetID(), Kind: sgtmpb.Post_TrackKind})
+	case *http.Request:
+		query = whereTrackSlug(query, chi.URLParam(req, "post_slug"))
+	default:
+		return 0, status.Errorf(codes.Internal, "cannot find the owner of %T", req)
+	}
+	var post sgtmpb.Post
+	if err := query.First(&post).Error; err != nil {
+		return 0, status.Error(codes.NotFound, "post not found")
+	}
+	return post.AuthorID, nil
+}
+
+func isOwner(user *sgtmpb.User, ownerIDs []int64) bool {
+	for _, ownerID := range ownerIDs {
+		if ownerID == user.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// authIdentity is the result of the authentication of a request.
+// Its fields are nil for the anonymous requests.
+type authIdentity struct {
+	User   *sgtmpb.User
+	Claims *jwtClaims       // set for the session cookies and JWTs
+	Token  *sgtmpb.APIToken // set for the personal API tokens
+}
+
+type authIdentityKey struct{}
+
+func withAuthIdentity(ctx context.Context, identity *authIdentity) context.Context {
+	return context.WithValue(ctx, authIdentityKey{}, identity)
+}
+
+func authIdentityFromContext(ctx context.Context) (*authIdentity, bool) {
+	identity, ok := ctx.Value(authIdentityKey{}).(*authIdentity)
+	return identity, ok
+}
+
+// authUserFromContext returns the user resolved by the authorization layer,
+// or an Unauthenticated error for the anonymous requests.
+func authUserFromContext(ctx context.Context) (*sgtmpb.User, error) {
+	identity, ok := authIdentityFromContext(ctx)
+	if !ok || identity.User == nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return identity.User, nil
+}
+
+// authenticate resolves the user from the session cookie, a JWT or a personal API token.
+// It returns an empty identity if the request has no credentials.
+func (svc *Service) authenticate(md metadata.MD) (*authIdentity, error) {
+	var (
+		identity authIdentity
+		userID   int64
+	)
+	bearer := bearerFromMetadata(md)
+	switch {
+	case strings.HasPrefix(bearer, apiTokenSecretPrefix):
+		token, err := svc.apiTokenFromSecret(bearer)
+		if err != nil {
+			return nil, err
+		}
+		identity.Token = token
+		userID = token.UserID
+	case len(md.Get(oauthTokenCookie)) > 0 || bearer != "":
+		jwt := bearer
+		if values := md.Get(oauthTokenCookie); len(values) > 0 {
+			jwt = values[0]
+		}
+		claims, err := svc.parseJWTToken(jwt)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		identity.Claims = claims
+		userID = claims.Session.UserID
+	default:
+		return &identity, nil
+	}
+
+	var user sgtmpb.User
+	if err := svc.rodb().First(&user, userID).Error; err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unknown user")
+	}
+	identity.User = &user
+	return &identity, nil
+}
+
+// checkAccess enforces a rule on an authenticated request, and returns the identity to use for the call.
+func (svc *Service) checkAccess(identity *authIdentity, rule accessRule, req interface{}) (*authIdentity, error) {
+	if identity.Token != nil && (rule.Scope == scopeSessionOnly || !identity.Token.HasScope(rule.Scope)) {
+		if rule.Level == accessPublic {
+			// public methods are still available, anonymously
+			return &authIdentity{}, nil
+		}
+		if rule.Scope == scopeSessionOnly {
+			return nil, status.Error(codes.PermissionDenied, "this method cannot be called with an API token")
+		}
+		return nil, status.Errorf(codes.PermissionDenied, "the API token does not have the %q scope", rule.Scope)
+	}
+	if rule.Level == accessPublic {
+		return identity, nil
+	}
+
+	user := identity.User
+	if user == nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	switch rule.Level {
+	case accessRole:
+		if !hasRole(user, rule.Role) {
+			return nil, status.Errorf(codes.PermissionDenied, "the %q role is required", rule.Role)
+		}
+	case accessOwner:
+		ownerIDs, err := rule.Owner(svc, req)
+		if err != nil {
+			return nil, err
+		}
+		if !isOwner(user, ownerIDs) && !isAdmin(user) && (rule.Role == "" || !hasRole(user, rule.Role)) {
+			return nil, status.Error(codes.PermissionDenied, "only the owner can do this")
+		}
+	}
+	return identity, nil
+}
+
+// authorize authenticates a gRPC call and enforces the rule of its method.
+func (svc *Service) authorize(ctx context.Context, fullMethod string, req interface{}) (context.Context, error) {
+	rule, found := methodAccess[fullMethod]
+	if !found {
+		return nil, status.Errorf(codes.PermissionDenied, "no access rule for %s", fullMethod)
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	identity, err := svc.authenticate(md)
+	if err != nil {
+		if rule.Level != accessPublic {
+			return nil, err
+		}
+		identity = &authIdentity{}
+	}
+	identity, err = svc.checkAccess(identity, rule, req)
+	if err != nil {
+		return nil, err
+	}
+	return withAuthIdentity(ctx, identity), nil
+}
+
+func (svc *Service) unaryAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := svc.authorize(ctx, info.FullMethod, req)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (svc *Service) streamAuthInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := svc.authorize(stream.Context(), info.FullMethod, nil)
+	if err != nil {
+		return err
+	}
+	wrapped := grpc_middleware.WrapServerStream(stream)
+	wrapped.WrappedContext = ctx
+	return handler(srv, wrapped)
+}
+
+// httpAuthenticate is a middleware resolving the user of the session cookie once per request.
+// An invalid session is considered anonymous.
+func (svc *Service) httpAuthenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identity := svc.httpIdentity(r)
+		next.ServeHTTP(w, r.WithContext(withAuthIdentity(r.Context(), identity)))
+	})
+}
+
+func (svc *Service) httpIdentity(r *http.Request) *authIdentity {
+	cookie, err := r.Cookie(oauthTokenCookie)
+	if err != nil {
+		return &authIdentity{}
+	}
+	identity, err := svc.authenticate(metadata.Pairs(oauthTokenCookie, cookie.Value))
+	if err != nil {
+		svc.logger.Debug("invalid session cookie", zap.Error(err))
+		return &authIdentity{}
+	}
+	return identity
+}
+
+// httpAccess returns a middleware enforcing a rule on the pages; it must be used after httpAuthenticate.
+// The anonymous users are redirected to the login page, and the pages of the resources owned by
+// someone else are not found, so their existence is not disclosed.
+func (svc *Service) httpAccess(rule accessRule) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			identity, ok := authIdentityFromContext(r.Context())
+			if !ok {
+				svc.errRenderHTML(w, r, fmt.Errorf("missing authentication middleware"), http.StatusInternalServerError)
+				return
+			}
+			if _, err := svc.checkAccess(identity, rule, r); err != nil {
+				code := status.Code(err)
+				switch {
+				case code == codes.Unauthenticated:
+					http.Redirect(w, r, "/login", http.StatusTemporaryRedirect)
+					return
+				case code == codes.PermissionDenied && rule.Level == accessOwner:
+					err = status.Error(codes.NotFound, "page not found")
+					code = codes.NotFound
+				}
+				svc.errRenderHTML(w, r, errors.New(status.Convert(err).Message()), runtime.HTTPStatusFromCode(code))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package sgtm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	packr "github.com/gobuffalo/packr/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+func TestMethodAccessIsComplete(t *testing.T) {
+	service := sgtmpb.File_sgtm_proto.Services().ByName("WebAPI")
+	require.NotNil(t, service)
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		fullMethod := fmt.Sprintf("/%s/%s", service.FullName(), methods.Get(i).Name())
+		_, found := methodAccess[fullMethod]
+		require.True(t, found, "missing access rule for %s", fullMethod)
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	svc := TestingService(t)
+	client := testingClient(t, &svc)
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	other := sgtmpb.User{Email: "other@example.com", Slug: "other"}
+	require.NoError(t, svc.rwdb().Create(&other).Error)
+	moderator := sgtmpb.User{Email: "moderator@example.com", Slug: "moderator", Role: roleModerator}
+	require.NoError(t, svc.rwdb().Create(&moderator).Error)
+	admin := sgtmpb.User{Email: "admin@example.com", Slug: "admin", Role: roleAdmin}
+	require.NoError(t, svc.rwdb().Create(&admin).Error)
+	track := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Public, Slug: "track"}
+	require.NoError(t, svc.rwdb().Create(&track).Error)
+
+	// public
+	_, err := client.Ping(context.Background(), &sgtmpb.Ping_Request{})
+	require.NoError(t, err)
+	invalidCtx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(oauthTokenCookie, "invalid"))
+	_, err = client.PostGet(invalidCtx, &sgtmpb.PostGet_Request{ID: track.ID})
+	require.NoError(t, err)
+
+	// user
+	_, err = client.Me(context.Background(), &sgtmpb.Me_Request{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.Me(invalidCtx, &sgtmpb.Me_Request{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	me, err := client.Me(testingAuthContext(t, &svc, other.ID), &sgtmpb.Me_Request{})
+	require.NoError(t, err)
+	require.Equal(t, other.ID, me.User.ID)
+
+	// owner
+	req := &sgtmpb.PostUpdate_Request{ID: track.ID, Post: &sgtmpb.Post{Title: "new"}}
+	_, err = client.PostUpdate(context.Background(), req)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.PostUpdate(testingAuthContext(t, &svc, other.ID), req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.PostUpdate(testingAuthContext(t, &svc, moderator.ID), req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.PostDelete(testingAuthContext(t, &svc, author.ID), &sgtmpb.PostDelete_Request{ID: track.ID + 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.PostUpdate(testingAuthContext(t, &svc, author.ID), req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err)) // authorized, but the update_mask is empty
+	_, err = client.PostUpdate(testingAuthContext(t, &svc, admin.ID), req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// roles
+	require.True(t, hasRole(&moderator, roleModerator))
+	require.True(t, hasRole(&admin, roleModerator))
+	require.False(t, hasRole(&moderator, roleAdmin))
+	require.False(t, hasRole(&other, roleModerator))
+	require.False(t, hasRole(nil, roleModerator))
+	moderatorRule := accessRule{Level: accessRole, Role: roleModerator}
+	_, err = svc.checkAccess(&authIdentity{User: &other}, moderatorRule, nil)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = svc.checkAccess(&authIdentity{User: &moderator}, moderatorRule, nil)
+	require.NoError(t, err)
+
+	// unknown methods are denied
+	_, err = svc.authorize(context.Background(), "/sgtm.WebAPI/Unknown", nil)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestHTTPAccess(t *testing.T) {
+	svc := TestingService(t)
+	svc.errRenderHTML = func(w http.ResponseWriter, r *http.Request, err error, status int) {
+		http.Error(w, err.Error(), status)
+	}
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	other := sgtmpb.User{Email: "other@example.com", Slug: "other"}
+	require.NoError(t, svc.rwdb().Create(&other).Error)
+	track := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Public, Slug: "track"}
+	require.NoError(t, svc.rwdb().Create(&track).Error)
+	draft := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Draft, Slug: "draft"}
+	require.NoError(t, svc.rwdb().Create(&draft).Error)
+
+	box := packr.New("src", ".")
+	r := chi.NewRouter()
+	r.Use(svc.httpAuthenticate)
+	ok := func(w http.ResponseWriter, r *http.Request) {
+		user, _ := authUserFromContext(r.Context())
+		if user != nil {
+			_, _ = w.Write([]byte(user.Slug))
+		}
+	}
+	r.Get("/public", ok)
+	r.With(svc.httpAccess(sessionUserAccess)).Get("/settings", ok)
+	r.With(svc.httpAccess(trackOwnerAccess)).Get("/post/{post_slug}/edit", ok)
+	r.Get("/post/{post_slug}", svc.postPage(box))
+	r.Post("/post/{post_slug}", svc.postPage(box))
+	r.Get("/post/{post_slug}/download", svc.postDownloadPage(box))
+
+	tests := []struct {
+		path           string
+		userID         int64
+		expectedStatus int
+		expectedBody   string
+	}{
+		{"/public", 0, http.StatusOK, ""},
+		{"/public", other.ID, http.StatusOK, "other"},
+		{"/settings", 0, http.StatusTemporaryRedirect, ""},
+		{"/settings", other.ID, http.StatusOK, "other"},
+		{"/post/track/edit", 0, http.StatusTemporaryRedirect, ""},
+		{"/post/track/edit", other.ID, http.StatusNotFound, ""},
+		{"/post/unknown/edit", author.ID, http.StatusNotFound, ""},
+		{"/post/track/edit", author.ID, http.StatusOK, "author"},
+		{"/post/track", 0, http.StatusOK, ""},
+		{"/post/draft", 0, http.StatusNotFound, ""},
+		{"/post/draft", other.ID, http.StatusNotFound, ""},
+		{"/post/draft", author.ID, http.StatusOK, ""},
+		{"/post/draft?format=json", 0, http.StatusNotFound, ""},
+		{"/post/draft?format=json", author.ID, http.StatusOK, ""},
+		{"/post/draft/download", 0, http.StatusNotFound, ""},
+		{"/post/draft/download", other.ID, http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s-%d", tt.path, tt.userID), func(t *testing.T) {
+			req := httptest.NewRequest("GET", tt.path, nil)
+			if tt.userID != 0 {
+				md, _ := metadata.FromOutgoingContext(testingAuthContext(t, &svc, tt.userID))
+				req.AddCookie(&http.Cookie{Name: oauthTokenCookie, Value: md.Get(oauthTokenCookie)[0]})
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+			require.Equal(t, tt.expectedStatus, rec.Code)
+			if rec.Code == http.StatusTemporaryRedirect {
+				require.Equal(t, "/login", rec.Header().Get("Location"))
+			}
+			if tt.expectedBody != "" {
+				require.Equal(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPostPageCommentAccess(t *testing.T) {
+	svc := TestingService(t)
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	other := sgtmpb.User{Email: "other@example.com", Slug: "other"}
+	require.NoError(t, svc.rwdb().Create(&other).Error)
+	track := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Public, Slug: "track"}
+	require.NoError(t, svc.rwdb().Create(&track).Error)
+	draft := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Draft, Slug: "draft"}
+	require.NoError(t, svc.rwdb().Create(&draft).Error)
+
+	r := chi.NewRouter()
+	r.Use(svc.httpAuthenticate)
+	r.Post("/post/{post_slug}", svc.postPage(packr.New("src", ".")))
+	comment := func(slug string, userID int64) int {
+		req := httptest.NewRequest("POST", "/post/"+slug, strings.NewReader(url.Values{"comment": {"hello"}}.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		md, _ := metadata.FromOutgoingContext(testingAuthContext(t, &svc, userID))
+		req.AddCookie(&http.Cookie{Name: oauthTokenCookie, Value: md.Get(oauthTokenCookie)[0]})
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	countComments := func(targetID int64) int64 {
+		var count int64
+		require.NoError(t, svc.rodb().Model(&sgtmpb.Post{}).Where(sgtmpb.Post{Kind: sgtmpb.Post_CommentKind, TargetPostID: targetID}).Count(&count).Error)
+		return count
+	}
+
+	require.Equal(t, http.StatusFound, comment("track", other.ID))
+	require.Equal(t, int64(1), countComments(track.ID))
+	require.Equal(t, http.StatusNotFound, comment("draft", other.ID))
+	require.Zero(t, countComments(draft.ID))
+	require.Equal(t, http.StatusFound, comment("draft", author.ID))
+	require.Equal(t, int64(1), countComments(draft.ID))
+}
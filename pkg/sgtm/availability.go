@@ -0,0 +1,159 @@
+package sgtm
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+const (
+	// availabilityCheckInterval is the minimum delay between two checks of the same track.
+	availabilityCheckInterval  = 24 * time.Hour
+	availabilityCheckBatchSize = 100
+)
+
+// unavailableStates are the availabilities of the tracks hidden from the listings.
+var unavailableStates = []sgtmpb.Post_ProviderAvailability{
+	sgtmpb.Post_ProviderDeleted,
+	sgtmpb.Post_ProviderPrivate,
+	sgtmpb.Post_ProviderTokenRevoked,
+}
+
+// whereAvailable filters out the tracks that disappeared from their provider.
+func whereAvailable(query *gorm.DB) *gorm.DB {
+	return query.Where("provider_availability NOT IN ?", unavailableStates)
+}
+
+// enqueueAvailabilityChecks schedules a check of the SoundCloud tracks that were not checked recently.
+// A track is checked at most once per interval, even if the previous check failed.
+func (svc *Service) enqueueAvailabilityChecks() error {
+	recent := svc.rodb().
+		Model(&sgtmpb.Job{}).
+		Select("post_id").
+		Where("kind = ?", sgtmpb.Job_CheckAvailabilityKind).
+		Where("(state IN ? OR created_at > ?)",
+			[]sgtmpb.Job_State{sgtmpb.Job_PendingState, sgtmpb.Job_RunningState},
+			time.Now().Add(-availabilityCheckInterval).UnixNano(),
+		)
+	var ids []int64
+	err := svc.rodb().
+		Model(&sgtmpb.Post{}).
+		Where(sgtmpb.Post{Kind: sgtmpb.Post_TrackKind, Provider: sgtmpb.Provider_SoundCloud}).
+		Where("provider_checked_at < ?", time.Now().Add(-availabilityCheckInterval).UnixNano()).
+		Where("id NOT IN (?)", recent).
+		Order("provider_checked_at").
+		Limit(availabilityCheckBatchSize).
+		Pluck("id", &ids).
+		Error
+	if err != nil {
+		return fmt.Errorf("failed to fetch tracks to check: %w", err)
+	}
+	for _, id := range ids {
+		if _, err := svc.enqueueJob(sgtmpb.Job_CheckAvailabilityKind, id, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (svc *Service) checkAvailabilityJob(job *sgtmpb.Job, db *gorm.DB) error {
+	post, err := jobPost(job, db)
+	if err != nil {
+		return err
+	}
+	availability, err := svc.soundcloudAvailability(post)
+	if err != nil {
+		return err
+	}
+
+	wasUnavailable := post.IsUnavailable()
+	err = db.Model(&sgtmpb.Post{ID: post.ID}).Updates(map[string]interface{}{
+		"provider_availability": availability,
+		"provider_checked_at":   time.Now().UnixNano(),
+	}).Error
+	if err != nil {
+		return err
+	}
+	post.ProviderAvailability = availability
+	if post.IsUnavailable() != wasUnavailable {
+		svc.sitemap.invalidate()
+	}
+	svc.logger.Debug("availability checked", zap.Int64("post", post.ID), zap.Stringer("availability", availability))
+	if post.IsUnavailable() && !wasUnavailable && post.Author != nil {
+		svc.notifyTrackUnavailable(post, post.Author)
+	}
+	return nil
+}
+
+// soundcloudAvailability re-resolves a SoundCloud track. Transient errors are returned,
+// so the check is retried without changing the availability.
+func (svc *Service) soundcloudAvailability(post *sgtmpb.Post) (sgtmpb.Post_ProviderAvailability, error) {
+	if post.Provider != sgtmpb.Provider_SoundCloud || post.SoundCloudID == 0 {
+		return sgtmpb.Post_UnknownProviderAvailability, fmt.Errorf("post %d is not a SoundCloud track", post.ID)
+	}
+	hasToken := post.SoundCloudSecretToken != ""
+	hidden := sgtmpb.Post_ProviderPrivate
+	if hasToken {
+		hidden = sgtmpb.Post_ProviderTokenRevoked
+	}
+
+	track, err := svc.fetchSoundCloudTrack(post)
+	if err == nil {
+		if track.Sharing == "private" && !hasToken {
+			return sgtmpb.Post_ProviderPrivate, nil
+		}
+		return sgtmpb.Post_ProviderAvailable, nil
+	}
+	var statusErr soundcloudStatusError
+	if !errors.As(err, &statusErr) {
+		return sgtmpb.Post_UnknownProviderAvailability, err
+	}
+	switch statusErr.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return hidden, nil
+	case http.StatusNotFound:
+		// the API does not distinguish deleted and private tracks; the permalink tells if the track still exists
+		if post.URL == "" {
+			return sgtmpb.Post_ProviderDeleted, nil
+		}
+		_, err := svc.soundcloud.Resolve(post.URL)
+		if err == nil {
+			return hidden, nil
+		}
+		if !errors.As(err, &statusErr) {
+			return sgtmpb.Post_UnknownProviderAvailability, err
+		}
+		switch statusErr.StatusCode {
+		case http.StatusNotFound:
+			return sgtmpb.Post_ProviderDeleted, nil
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return hidden, nil
+		}
+	}
+	return sgtmpb.Post_UnknownProviderAvailability, err
+}
+
+// notifyTrackUnavailable tells the author and the admins that a track disappeared from its provider.
+func (svc *Service) notifyTrackUnavailable(post *sgtmpb.Post, author *sgtmpb.User) {
+	reason := availabilityReason(post.ProviderAvailability)
+	link := "https://sgtm.club" + post.CanonicalURL()
+	svc.notifyAdmins(fmt.Sprintf("⚠️ **%s** by @%s is no longer available on SoundCloud (%s): %s", post.SafeTitle(), author.Slug, reason, link))
+	svc.notifyUser(author, fmt.Sprintf("⚠️ Your track **%s** is no longer available on SoundCloud (%s), it is now hidden from SGTM: %s", post.SafeTitle(), reason, link))
+}
+
+func availabilityReason(availability sgtmpb.Post_ProviderAvailability) string {
+	switch availability {
+	case sgtmpb.Post_ProviderDeleted:
+		return "deleted"
+	case sgtmpb.Post_ProviderPrivate:
+		return "made private"
+	case sgtmpb.Post_ProviderTokenRevoked:
+		return "secret link reset"
+	}
+	return availability.String()
+}
@@ -0,0 +1,206 @@
+package sgtm
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yanatan16/golang-soundcloud/soundcloud"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+func TestCheckAvailability(t *testing.T) {
+	svc := TestingService(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tracks/1.json":
+			_ = json.NewEncoder(w).Encode(soundcloud.Track{Id: 1, Sharing: "public"})
+		case "/tracks/2.json":
+			_ = json.NewEncoder(w).Encode(soundcloud.Track{Id: 2, Sharing: "private"})
+		case "/tracks/5.json":
+			w.WriteHeader(http.StatusForbidden)
+		case "/tracks/6.json":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "/resolve.json":
+			if r.URL.Query().Get("url") == "https://soundcloud.com/author/hidden" {
+				http.Redirect(w, r, "https://api.soundcloud.com/tracks/4.json", http.StatusFound)
+				return
+			}
+			http.NotFound(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	svc.soundcloud = NewSoundCloudClient("test", server.URL)
+
+	var adminMessages, directMessages []string
+	svc.opts.DiscordAdminChannel = "admin"
+	sendMessage := func(_ string, msg string) error {
+		adminMessages = append(adminMessages, msg)
+		return nil
+	}
+	sendDM := func(discordUserID string, msg string) error {
+		require.Equal(t, "1234", discordUserID)
+		directMessages = append(directMessages, msg)
+		return nil
+	}
+	svc.discord.connected(nil, sendMessage, sendDM)
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author", DiscordID: "1234"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+
+	tests := []struct {
+		name      string
+		id        uint64
+		token     string
+		url       string
+		expected  sgtmpb.Post_ProviderAvailability
+		expectErr bool
+	}{
+		{"available", 1, "", "https://soundcloud.com/author/available", sgtmpb.Post_ProviderAvailable, false},
+		{"private", 2, "", "https://soundcloud.com/author/private", sgtmpb.Post_ProviderPrivate, false},
+		{"private-with-token", 2, "s-secret", "https://soundcloud.com/author/private", sgtmpb.Post_ProviderAvailable, false},
+		{"deleted", 3, "", "https://soundcloud.com/author/deleted", sgtmpb.Post_ProviderDeleted, false},
+		{"hidden", 4, "", "https://soundcloud.com/author/hidden", sgtmpb.Post_ProviderPrivate, false},
+		{"token-revoked", 4, "s-secret", "https://soundcloud.com/author/hidden", sgtmpb.Post_ProviderTokenRevoked, false},
+		{"forbidden", 5, "", "https://soundcloud.com/author/forbidden", sgtmpb.Post_ProviderPrivate, false},
+		{"server-error", 6, "", "https://soundcloud.com/author/error", sgtmpb.Post_UnknownProviderAvailability, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adminMessages, directMessages = nil, nil
+			post := sgtmpb.Post{
+				AuthorID:              author.ID,
+				Kind:                  sgtmpb.Post_TrackKind,
+				Visibility:            sgtmpb.Visibility_Public,
+				Provider:              sgtmpb.Provider_SoundCloud,
+				SoundCloudID:          tt.id,
+				SoundCloudSecretToken: tt.token,
+				URL:                   tt.url,
+				Title:                 tt.name,
+			}
+			require.NoError(t, svc.rwdb().Create(&post).Error)
+			job := sgtmpb.Job{Kind: sgtmpb.Job_CheckAvailabilityKind, PostID: post.ID}
+
+			err := svc.checkAvailabilityJob(&job, svc.rwdb())
+			if tt.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			var got sgtmpb.Post
+			require.NoError(t, svc.rodb().First(&got, post.ID).Error)
+			require.Equal(t, tt.expected, got.ProviderAvailability)
+			require.Equal(t, tt.expectErr, got.ProviderCheckedAt == 0)
+			if got.IsUnavailable() {
+				require.Len(t, adminMessages, 1)
+				require.Len(t, directMessages, 1)
+				require.Contains(t, directMessages[0], tt.name)
+			} else {
+				require.Empty(t, adminMessages)
+				require.Empty(t, directMessages)
+			}
+
+			// no new notification while the track stays unavailable
+			if !tt.expectErr {
+				adminMessages, directMessages = nil, nil
+				require.NoError(t, svc.checkAvailabilityJob(&job, svc.rwdb()))
+				require.Empty(t, adminMessages)
+				require.Empty(t, directMessages)
+			}
+		})
+	}
+}
+
+func TestSitemapIsRegeneratedOnAvailabilityChange(t *testing.T) {
+	svc := TestingService(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tracks/1.json":
+			_ = json.NewEncoder(w).Encode(soundcloud.Track{Id: 1, Sharing: "public"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	svc.soundcloud = NewSoundCloudClient("test", server.URL)
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	available := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Provider: sgtmpb.Provider_SoundCloud, SoundCloudID: 1}
+	require.NoError(t, svc.rwdb().Create(&available).Error)
+	deleted := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Provider: sgtmpb.Provider_SoundCloud, SoundCloudID: 2}
+	require.NoError(t, svc.rwdb().Create(&deleted).Error)
+
+	svc.cachedSitemap()
+	generatedAt := svc.sitemap.at
+	require.False(t, generatedAt.IsZero())
+	svc.cachedSitemap()
+	require.Equal(t, generatedAt, svc.sitemap.at)
+
+	// an unchanged availability keeps the cache
+	require.NoError(t, svc.checkAvailabilityJob(&sgtmpb.Job{PostID: available.ID}, svc.rwdb()))
+	require.Equal(t, generatedAt, svc.sitemap.at)
+	require.NoError(t, svc.checkAvailabilityJob(&sgtmpb.Job{PostID: deleted.ID}, svc.rwdb()))
+	require.True(t, svc.sitemap.at.IsZero())
+}
+
+func TestEnqueueAvailabilityChecks(t *testing.T) {
+	svc := TestingService(t)
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	recent := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Provider: sgtmpb.Provider_SoundCloud, SoundCloudID: 1, ProviderCheckedAt: time.Now().UnixNano()}
+	outdated := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Provider: sgtmpb.Provider_SoundCloud, SoundCloudID: 2}
+	upload := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Provider: sgtmpb.Provider_IPFS}
+	for _, post := range []*sgtmpb.Post{&recent, &outdated, &upload} {
+		require.NoError(t, svc.rwdb().Create(post).Error)
+	}
+	jobs := func() []*sgtmpb.Job {
+		var jobs []*sgtmpb.Job
+		require.NoError(t, svc.rodb().Where(sgtmpb.Job{Kind: sgtmpb.Job_CheckAvailabilityKind}).Find(&jobs).Error)
+		return jobs
+	}
+
+	require.NoError(t, svc.enqueueAvailabilityChecks())
+	require.Len(t, jobs(), 1)
+	require.Equal(t, outdated.ID, jobs()[0].PostID)
+
+	// a failed check is not retried before the next interval
+	require.NoError(t, svc.rwdb().Model(jobs()[0]).Update("state", sgtmpb.Job_FailedState).Error)
+	require.NoError(t, svc.enqueueAvailabilityChecks())
+	require.Len(t, jobs(), 1)
+}
+
+func TestPostListHidesUnavailableTracks(t *testing.T) {
+	svc := TestingService(t)
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	for _, availability := range []sgtmpb.Post_ProviderAvailability{
+		sgtmpb.Post_UnknownProviderAvailability,
+		sgtmpb.Post_ProviderAvailable,
+		sgtmpb.Post_ProviderDeleted,
+		sgtmpb.Post_ProviderPrivate,
+		sgtmpb.Post_ProviderTokenRevoked,
+	} {
+		post := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Public, ProviderAvailability: availability}
+		require.NoError(t, svc.rwdb().Create(&post).Error)
+	}
+
+	posts, _, err := svc.postList(&sgtmpb.PostList_Request{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, posts, 2)
+	for _, post := range posts {
+		require.False(t, post.IsUnavailable())
+	}
+
+	posts, _, err = svc.postList(&sgtmpb.PostList_Request{Limit: 10, IncludeUnavailable: true})
+	require.NoError(t, err)
+	require.Len(t, posts, 5)
+}
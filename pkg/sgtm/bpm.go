@@ -0,0 +1,481 @@
+package sgtm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+const (
+	bpmAnalyzerAuto           = "auto"
+	bpmAnalyzerSonicAnnotator = "sonic-annotator"
+	bpmAnalyzerGo             = "go"
+
+	bpmAnalysisTimeout = 2 * time.Minute
+	bpmMin             = 60
+	bpmMax             = 200
+	// bpmMaxSeconds is the duration of audio analyzed by the Go analyzer.
+	bpmMaxSeconds = 300
+	// bpmDecodeRate is the sample rate used when decoding with ffmpeg.
+	bpmDecodeRate = 11025
+	// bpmEnvelopeRate is the number of onset envelope frames per second.
+	bpmEnvelopeRate = 100
+)
+
+// bpmAnalyzer computes the tempo of a local audio file.
+type bpmAnalyzer interface {
+	Name() string
+	BPM(ctx context.Context, path string) (float64, error)
+}
+
+// bpmAnalyzerBinaries are the external tools needed by each analyzer.
+var bpmAnalyzerBinaries = map[string][]string{
+	bpmAnalyzerSonicAnnotator: {"sonic-annotator"},
+	bpmAnalyzerGo:             {"ffmpeg"}, // to decode the files that are not WAV
+}
+
+// missingBinaries returns the binaries that are not in $PATH.
+func missingBinaries(binaries []string) []string {
+	missing := []string{}
+	for _, binary := range binaries {
+		if _, err := exec.LookPath(binary); err != nil {
+			missing = append(missing, binary)
+		}
+	}
+	return missing
+}
+
+// newBPMAnalyzer returns an analyzer by its name; "auto" tries sonic-annotator when it is installed,
+// then the Go analyzer.
+func newBPMAnalyzer(name string) (bpmAnalyzer, error) {
+	switch name {
+	case "", bpmAnalyzerAuto:
+		if len(missingBinaries(bpmAnalyzerBinaries[bpmAnalyzerSonicAnnotator])) > 0 {
+			return goBPMAnalyzer{}, nil
+		}
+		return fallbackBPMAnalyzer{sonicAnnotatorBPMAnalyzer{}, goBPMAnalyzer{}}, nil
+	case bpmAnalyzerSonicAnnotator:
+		return sonicAnnotatorBPMAnalyzer{}, nil
+	case bpmAnalyzerGo:
+		return goBPMAnalyzer{}, nil
+	default:
+		return nil, fmt.Errorf("unknown BPM analyzer: %q", name)
+	}
+}
+
+// computeTrackBPM gets a local copy of a track and analyzes its tempo.
+func (svc *Service) computeTrackBPM(post *sgtmpb.Post) (float64, error) {
+	path, cleanup, err := svc.localTrackFile(post)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the audio file: %w", err)
+	}
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(svc.ctx, bpmAnalysisTimeout)
+	defer cancel()
+	bpm, err := svc.bpm.BPM(ctx, path)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", svc.bpm.Name(), err)
+	}
+	return bpm, nil
+}
+
+// bpmMigration computes the BPM of the tracks that have none.
+func (svc *Service) bpmMigration(post *sgtmpb.Post, _ *gorm.DB) (func(*gorm.DB) error, error) {
+	if post.BPM != 0 {
+		return nil, nil
+	}
+	if !hasProvider(post) {
+		return nil, nil
+	}
+	bpm, err := svc.computeTrackBPM(post)
+	if err != nil {
+		return nil, err
+	}
+	return func(tx *gorm.DB) error {
+		return tx.Model(post).Update("bpm", bpm).Error
+	}, nil
+}
+
+// sonicAnnotatorBPMAnalyzer uses the qm-tempotracker Vamp plugin.
+type sonicAnnotatorBPMAnalyzer struct{}
+
+func (sonicAnnotatorBPMAnalyzer) Name() string { return bpmAnalyzerSonicAnnotator }
+
+func (sonicAnnotatorBPMAnalyzer) BPM(ctx context.Context, path string) (float64, error) {
+	return extractBPMContext(ctx, path)
+}
+
+// fallbackBPMAnalyzer returns the result of the first analyzer that succeeds.
+type fallbackBPMAnalyzer []bpmAnalyzer
+
+func (analyzers fallbackBPMAnalyzer) Name() string {
+	names := make([]string, 0, len(analyzers))
+	for _, analyzer := range analyzers {
+		names = append(names, analyzer.Name())
+	}
+	return strings.Join(names, "|")
+}
+
+func (analyzers fallbackBPMAnalyzer) BPM(ctx context.Context, path string) (float64, error) {
+	errs := []string{}
+	for _, analyzer := range analyzers {
+		bpm, err := analyzer.BPM(ctx, path)
+		if err == nil && bpm > 0 {
+			return bpm, nil
+		}
+		if err == nil {
+			err = fmt.Errorf("bpm not extracted")
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", analyzer.Name(), err))
+	}
+	return 0, errors.New(strings.Join(errs, "; "))
+}
+
+// goBPMAnalyzer is a tempo estimator written in Go, based on the autocorrelation of an onset envelope.
+// WAV files are decoded natively, but the other formats need ffmpeg.
+type goBPMAnalyzer struct{}
+
+func (goBPMAnalyzer) Name() string { return bpmAnalyzerGo }
+
+func (goBPMAnalyzer) BPM(ctx context.Context, path string) (float64, error) {
+	samples, rate, err := decodeMonoPCM(ctx, path, bpmMaxSeconds)
+	if err != nil {
+		return 0, err
+	}
+	return estimateBPM(samples, rate)
+}
+
+// decodeMonoPCM returns up to maxSeconds of mono samples in [-1, 1], and the sample rate.
+func decodeMonoPCM(ctx context.Context, path string, maxSeconds int) ([]float64, int, error) {
+	samples := []float64{}
+	rate, err := streamMonoPCM(ctx, path, maxSeconds, func(chunk []float64) {
+		samples = append(samples, chunk...)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return samples, rate, nil
+}
+
+// streamMonoPCM decodes up to maxSeconds of mono samples in [-1, 1] and passes them to fn by chunks,
+// so the whole track is never loaded in memory; the chunks are reused after fn returns.
+// It returns the sample rate.
+// WAV files are decoded natively, other formats are decoded with ffmpeg.
+func streamMonoPCM(ctx context.Context, path string, maxSeconds int, fn func([]float64)) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	reader := bufio.NewReader(f)
+	if header, err := reader.Peek(12); err == nil && string(header[0:4]) == "RIFF" && string(header[8:12]) == "WAVE" {
+		return streamWAV(reader, maxSeconds, fn)
+	}
+
+	cmd := exec.CommandContext(
+		ctx,
+		"ffmpeg",
+		"-v", "error",
+		"-i", path,
+		"-t", fmt.Sprint(maxSeconds),
+		"-f", "s16le", "-acodec", "pcm_s16le",
+		"-ac", "1",
+		"-ar", fmt.Sprint(bpmDecodeRate),
+		"-",
+	)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return 0, err
+	}
+	if err := cmd.Start(); err != nil {
+		return 0, fmt.Errorf("ffmpeg: %w", err)
+	}
+	buf := make([]byte, 8192)
+	samples := make([]float64, len(buf)/2)
+	for {
+		n, readErr := io.ReadFull(stdout, buf)
+		n -= n % 2
+		for i := 0; i < n/2; i++ {
+			samples[i] = float64(int16(binary.LittleEndian.Uint16(buf[i*2:]))) / math.MaxInt16
+		}
+		if n > 0 {
+			fn(samples[:n/2])
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			_ = cmd.Process.Kill()
+			_ = cmd.Wait()
+			return 0, readErr
+		}
+	}
+	if err := cmd.Wait(); err != nil {
+		return 0, fmt.Errorf("ffmpeg: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return bpmDecodeRate, nil
+}
+
+// streamWAV decodes integer PCM and float WAV files, mixed down to mono.
+func streamWAV(r io.Reader, maxSeconds int, fn func([]float64)) (int, error) {
+	wav, err := newWAVReader(r)
+	if err != nil {
+		return 0, err
+	}
+	remaining := maxSeconds * wav.Rate
+	buf := make([]float64, 4096*wav.Channels)
+	samples := make([]float64, 0, 4096)
+	for remaining > 0 {
+		n, err := wav.ReadFrames(buf)
+		samples = samples[:0]
+		for i := 0; i < n && len(samples) < remaining; i += wav.Channels {
+			var sum float64
+			for _, v := range buf[i : i+wav.Channels] {
+				sum += v
+			}
+			samples = append(samples, sum/float64(wav.Channels))
+		}
+		if len(samples) > 0 {
+			fn(samples)
+			remaining -= len(samples)
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return wav.Rate, nil
+}
+
+// wavReader decodes the frames of integer PCM and float WAV files.
+type wavReader struct {
+	Rate     int
+	Channels int
+
+	r              io.Reader
+	format         uint16
+	bytesPerSample int
+	remaining      int64 // bytes left in the data chunk, -1 when streamed with an unknown size
+	buf            []byte
+}
+
+const (
+	wavFormatPCM   = 1
+	wavFormatFloat = 3
+)
+
+// newWAVReader reads the headers, until the beginning of the data chunk.
+func newWAVReader(r io.Reader) (*wavReader, error) {
+	var riff [12]byte
+	if _, err := io.ReadFull(r, riff[:]); err != nil {
+		return nil, fmt.Errorf("invalid WAV header: %w", err)
+	}
+	var (
+		wav           = wavReader{r: r}
+		bitsPerSample int
+		haveFormat    bool
+	)
+	for {
+		var chunk [8]byte
+		if _, err := io.ReadFull(r, chunk[:]); err != nil {
+			return nil, fmt.Errorf("missing WAV data chunk: %w", err)
+		}
+		id := string(chunk[0:4])
+		size := int64(binary.LittleEndian.Uint32(chunk[4:8]))
+		switch id {
+		case "fmt ":
+			if size > 1024 {
+				return nil, fmt.Errorf("invalid WAV fmt chunk")
+			}
+			body := make([]byte, size)
+			if _, err := io.ReadFull(r, body); err != nil || size < 16 {
+				return nil, fmt.Errorf("invalid WAV fmt chunk")
+			}
+			wav.format = binary.LittleEndian.Uint16(body[0:2])
+			wav.Channels = int(binary.LittleEndian.Uint16(body[2:4]))
+			wav.Rate = int(binary.LittleEndian.Uint32(body[4:8]))
+			bitsPerSample = int(binary.LittleEndian.Uint16(body[14:16]))
+			if wav.format == 0xFFFE && size >= 26 { // WAVE_FORMAT_EXTENSIBLE
+				wav.format = binary.LittleEndian.Uint16(body[24:26])
+			}
+			if size%2 == 1 { // chunks are word-aligned
+				if _, err := io.CopyN(ioutil.Discard, r, 1); err != nil {
+					return nil, err
+				}
+			}
+			haveFormat = true
+		case "data":
+			if !haveFormat {
+				return nil, fmt.Errorf("WAV data chunk before fmt chunk")
+			}
+			wav.bytesPerSample = bitsPerSample / 8
+			switch {
+			case wav.Channels < 1 || wav.Rate < 1:
+				return nil, fmt.Errorf("invalid WAV format")
+			case wav.format == wavFormatPCM && wav.bytesPerSample >= 1 && wav.bytesPerSample <= 4:
+			case wav.format == wavFormatFloat && wav.bytesPerSample == 4:
+			default:
+				return nil, fmt.Errorf("unsupported WAV format: %d (%d bits)", wav.format, bitsPerSample)
+			}
+			wav.remaining = size
+			if size == 0 || size == math.MaxUint32 { // written to a pipe, i.e., by ffmpeg
+				wav.remaining = -1
+			}
+			return &wav, nil
+		default:
+			if _, err := io.CopyN(ioutil.Discard, r, size+size%2); err != nil {
+				return nil, fmt.Errorf("invalid WAV chunk %q: %w", id, err)
+			}
+		}
+	}
+}
+
+// ReadFrames decodes whole frames of interleaved samples in [-1, 1] into buf, and returns the number of samples.
+// A truncated data chunk is not an error; io.EOF is returned at the end of the data.
+func (wav *wavReader) ReadFrames(buf []float64) (int, error) {
+	frameSize := wav.bytesPerSample * wav.Channels
+	size := int64(len(buf) / wav.Channels * frameSize)
+	if wav.remaining == 0 {
+		return 0, io.EOF
+	}
+	if wav.remaining > 0 && size > wav.remaining {
+		size = wav.remaining
+	}
+	if int64(cap(wav.buf)) < size {
+		wav.buf = make([]byte, size)
+	}
+	data := wav.buf[:size]
+	n, err := io.ReadFull(wav.r, data)
+	switch {
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		wav.remaining = 0
+	case err != nil:
+		return 0, err
+	case wav.remaining > 0:
+		wav.remaining -= int64(n)
+	}
+	data = data[:n-n%frameSize]
+
+	for i := range data[:len(data)/wav.bytesPerSample] {
+		b := data[i*wav.bytesPerSample:]
+		switch {
+		case wav.format == wavFormatFloat:
+			buf[i] = float64(math.Float32frombits(binary.LittleEndian.Uint32(b)))
+		case wav.bytesPerSample == 1: // unsigned
+			buf[i] = (float64(b[0]) - 128) / 128
+		case wav.bytesPerSample == 2:
+			buf[i] = float64(int16(binary.LittleEndian.Uint16(b))) / (1 << 15)
+		case wav.bytesPerSample == 3:
+			buf[i] = float64(int32(uint32(b[0])<<8|uint32(b[1])<<16|uint32(b[2])<<24)>>8) / (1 << 23)
+		case wav.bytesPerSample == 4:
+			buf[i] = float64(int32(binary.LittleEndian.Uint32(b))) / (1 << 31)
+		}
+	}
+	samples := len(data) / wav.bytesPerSample
+	if wav.remaining == 0 && samples == 0 {
+		return 0, io.EOF
+	}
+	return samples, nil
+}
+
+// estimateBPM finds the most likely beat period in the autocorrelation of an onset envelope,
+// with a mild preference for tempos around 120 BPM to reduce the octave errors.
+func estimateBPM(samples []float64, rate int) (float64, error) {
+	hop := rate / bpmEnvelopeRate
+	if hop < 1 || len(samples) < 5*rate {
+		return 0, fmt.Errorf("audio too short to extract the BPM")
+	}
+	fps := float64(rate) / float64(hop)
+
+	// onset envelope: positive variations of the log energy
+	frames := len(samples)/hop - 1
+	energy := make([]float64, frames)
+	for i := range energy {
+		var sum float64
+		for _, sample := range samples[i*hop : i*hop+2*hop] {
+			sum += sample * sample
+		}
+		energy[i] = math.Log(1e-10 + sum)
+	}
+	onsets := make([]float64, frames)
+	for i := 1; i < frames; i++ {
+		if diff := energy[i] - energy[i-1]; diff > 0 {
+			onsets[i] = diff
+		}
+	}
+	// remove the local average, to keep the peaks only
+	window := int(fps / 2)
+	var (
+		envelope = make([]float64, frames)
+		running  float64
+	)
+	for i := range onsets {
+		running += onsets[i]
+		if i >= window {
+			running -= onsets[i-window]
+		}
+		if v := onsets[i] - running/float64(window); v > 0 {
+			envelope[i] = v
+		}
+	}
+
+	// autocorrelation over the allowed tempo range
+	minLag := int(math.Floor(60 * fps / bpmMax))
+	maxLag := int(math.Ceil(60 * fps / bpmMin))
+	if maxLag+1 >= frames {
+		return 0, fmt.Errorf("audio too short to extract the BPM")
+	}
+	acf := make([]float64, maxLag+2)
+	for lag := minLag - 1; lag <= maxLag+1; lag++ {
+		var sum float64
+		for i := lag; i < frames; i++ {
+			sum += envelope[i] * envelope[i-lag]
+		}
+		acf[lag] = sum / float64(frames-lag)
+	}
+	bestLag, bestScore := 0, 0.0
+	for lag := minLag; lag <= maxLag; lag++ {
+		octaves := math.Log2(60 * fps / float64(lag) / 120)
+		score := acf[lag] * math.Exp(-0.5*octaves*octaves)
+		if score > bestScore {
+			bestLag, bestScore = lag, score
+		}
+	}
+	if bestLag == 0 {
+		return 0, fmt.Errorf("no beat detected")
+	}
+	// prefer the faster tempo when every half-beat is as strong as the beats;
+	// the half period is often between two lags, so both are summed
+	if half := bestLag / 2; half >= minLag && acf[half]+acf[half+1] >= 0.8*acf[bestLag] {
+		bestLag = half
+		if acf[half+1] > acf[half] {
+			bestLag = half + 1
+		}
+	}
+
+	// parabolic interpolation around the peak
+	lag := float64(bestLag)
+	if prev, next := acf[bestLag-1], acf[bestLag+1]; prev+next-2*acf[bestLag] < 0 {
+		lag += 0.5 * (prev - next) / (prev - 2*acf[bestLag] + next)
+	}
+	bpm := 60 * fps / lag
+	return math.Round(bpm*100) / 100, nil
+}
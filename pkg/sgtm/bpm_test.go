@@ -0,0 +1,143 @@
+package sgtm
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// testingClickTrack returns a WAV file with a decaying noise burst on every beat.
+func testingClickTrack(t *testing.T, bpm float64, seconds int, channels int, bitsPerSample int) string {
+	t.Helper()
+	const rate = 22050
+	rng := rand.New(rand.NewSource(42))
+	frames := rate * seconds
+	period := 60 / bpm * rate
+	var data bytes.Buffer
+	for i := 0; i < frames; i++ {
+		sinceBeat := math.Mod(float64(i), period)
+		value := 0.01 * (rng.Float64()*2 - 1)
+		if sinceBeat < 0.03*rate {
+			value += 0.8 * (rng.Float64()*2 - 1) * math.Exp(-sinceBeat/(0.005*rate))
+		}
+		for c := 0; c < channels; c++ {
+			switch bitsPerSample {
+			case 16:
+				_ = binary.Write(&data, binary.LittleEndian, int16(value*math.MaxInt16))
+			case 24:
+				v := int32(value * (1<<23 - 1))
+				data.Write([]byte{byte(v), byte(v >> 8), byte(v >> 16)})
+			}
+		}
+	}
+
+	var wav bytes.Buffer
+	blockAlign := channels * bitsPerSample / 8
+	wav.WriteString("RIFF")
+	_ = binary.Write(&wav, binary.LittleEndian, uint32(4+8+16+8+8+4+data.Len()))
+	wav.WriteString("WAVE")
+	wav.WriteString("fmt ")
+	for _, field := range []interface{}{
+		uint32(16), uint16(1), uint16(channels), uint32(rate), uint32(rate * blockAlign), uint16(blockAlign), uint16(bitsPerSample),
+	} {
+		_ = binary.Write(&wav, binary.LittleEndian, field)
+	}
+	wav.WriteString("LIST") // ignored chunk
+	_ = binary.Write(&wav, binary.LittleEndian, uint32(4))
+	wav.WriteString("INFO")
+	wav.WriteString("data")
+	_ = binary.Write(&wav, binary.LittleEndian, uint32(data.Len()))
+	wav.Write(data.Bytes())
+
+	path := filepath.Join(t.TempDir(), fmt.Sprintf("click-%v.wav", bpm))
+	require.NoError(t, ioutil.WriteFile(path, wav.Bytes(), 0o600))
+	return path
+}
+
+func TestGoBPMAnalyzer(t *testing.T) {
+	tests := []struct {
+		bpm           float64
+		channels      int
+		bitsPerSample int
+	}{
+		{87, 1, 16},
+		{90, 1, 16},
+		{120, 2, 16},
+		{128, 1, 24},
+		{150, 2, 16},
+		{174, 1, 16},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v-%d-%d", tt.bpm, tt.channels, tt.bitsPerSample), func(t *testing.T) {
+			path := testingClickTrack(t, tt.bpm, 20, tt.channels, tt.bitsPerSample)
+			bpm, err := goBPMAnalyzer{}.BPM(context.Background(), path)
+			require.NoError(t, err)
+			require.InDelta(t, tt.bpm, bpm, 1.5)
+		})
+	}
+
+	// too short
+	path := testingClickTrack(t, 120, 2, 1, 16)
+	_, err := goBPMAnalyzer{}.BPM(context.Background(), path)
+	require.Error(t, err)
+}
+
+type testingBPMAnalyzer struct {
+	bpm float64
+	err error
+}
+
+func (a testingBPMAnalyzer) Name() string { return "testing" }
+
+func (a testingBPMAnalyzer) BPM(context.Context, string) (float64, error) { return a.bpm, a.err }
+
+func TestBPMAnalyzers(t *testing.T) {
+	_, err := newBPMAnalyzer("unknown")
+	require.Error(t, err)
+	for _, name := range []string{"", bpmAnalyzerAuto, bpmAnalyzerSonicAnnotator, bpmAnalyzerGo} {
+		_, err := newBPMAnalyzer(name)
+		require.NoError(t, err)
+	}
+
+	// auto only tries sonic-annotator when it is installed
+	testingEmptyPath(t)
+	analyzer, err := newBPMAnalyzer(bpmAnalyzerAuto)
+	require.NoError(t, err)
+	require.Equal(t, bpmAnalyzerGo, analyzer.Name())
+	testingBinary(t, "sonic-annotator", "exit 1")
+	analyzer, err = newBPMAnalyzer(bpmAnalyzerAuto)
+	require.NoError(t, err)
+	require.Equal(t, "sonic-annotator|go", analyzer.Name())
+
+	path := testingClickTrack(t, 120, 10, 1, 16)
+	fallback := fallbackBPMAnalyzer{testingBPMAnalyzer{err: fmt.Errorf("no vamp plugin")}, testingBPMAnalyzer{}, goBPMAnalyzer{}}
+	bpm, err := fallback.BPM(context.Background(), path)
+	require.NoError(t, err)
+	require.InDelta(t, 120, bpm, 1.5)
+	_, err = fallback[:2].BPM(context.Background(), path)
+	require.EqualError(t, err, "testing: no vamp plugin; testing: bpm not extracted")
+
+	// the tracks with a BPM or an unsupported provider are skipped
+	svc := TestingService(t)
+	svc.bpm = testingBPMAnalyzer{err: fmt.Errorf("should not be called")}
+	for _, post := range []*sgtmpb.Post{
+		{Provider: sgtmpb.Provider_SoundCloud, BPM: 92},
+		{Provider: sgtmpb.Provider_UnknownProvider},
+	} {
+		save, err := svc.bpmMigration(post, svc.rwdb())
+		require.NoError(t, err)
+		require.Nil(t, save)
+	}
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+}
@@ -0,0 +1,212 @@
+package sgtm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+const maxCommentLength = 500
+
+// canViewPost returns true if the post is public, or if the user can edit it.
+func canViewPost(user *sgtmpb.User, post *sgtmpb.Post) bool {
+	return post.Visibility == sgtmpb.Visibility_Public || canEditPost(user, post)
+}
+
+// canDeleteComment returns true if the user is the author of the comment,
+// the author of the commented track, or a moderator.
+func canDeleteComment(user *sgtmpb.User, comment *sgtmpb.Post, track *sgtmpb.Post) bool {
+	return user != nil && (user.ID == comment.AuthorID || canEditPost(user, track) || hasRole(user, roleModerator))
+}
+
+// commentAuthor returns the author of the comment targeted by a request.
+func commentAuthor(svc *Service, req interface{}) ([]int64, error) {
+	comment, err := commentFromRequest(svc, req)
+	if err != nil {
+		return nil, err
+	}
+	return []int64{comment.AuthorID}, nil
+}
+
+// commentOwners returns the author of the comment targeted by a request, and the author of the commented track.
+func commentOwners(svc *Service, req interface{}) ([]int64, error) {
+	comment, err := commentFromRequest(svc, req)
+	if err != nil {
+		return nil, err
+	}
+	var track sgtmpb.Post
+	if err := svc.rodb().Select("author_id").First(&track, comment.TargetPostID).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+	return []int64{comment.AuthorID, track.AuthorID}, nil
+}
+
+func commentFromRequest(svc *Service, req interface{}) (*sgtmpb.Post, error) {
+	id, ok := req.(interface{ GetID() int64 })
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "cannot find the owner of %T", req)
+	}
+	if id.GetID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing comment ID")
+	}
+	comment, err := svc.commentByID(id.GetID())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "comment not found")
+	}
+	return comment, nil
+}
+
+// loadCommentTree returns the top-level comments of a track, oldest first, with their replies.
+// Deleted comments are kept as placeholders while they have replies.
+func (svc *Service) loadCommentTree(trackID int64) ([]*sgtmpb.Post, error) {
+	var comments []*sgtmpb.Post
+	err := svc.rodb().
+		Where(sgtmpb.Post{
+			Kind:         sgtmpb.Post_CommentKind,
+			TargetPostID: trackID,
+			Visibility:   sgtmpb.Visibility_Public,
+		}).
+		Preload("Author").
+		Order("created_at asc").
+		Find(&comments).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return buildCommentTree(comments), nil
+}
+
+func buildCommentTree(comments []*sgtmpb.Post) []*sgtmpb.Post {
+	byID := make(map[int64]*sgtmpb.Post, len(comments))
+	for _, comment := range comments {
+		byID[comment.ID] = comment
+	}
+	roots := []*sgtmpb.Post{}
+	for _, comment := range comments {
+		if parent, found := byID[comment.ReplyToID]; found && comment.ReplyToID != 0 {
+			parent.Replies = append(parent.Replies, comment)
+		} else {
+			roots = append(roots, comment)
+		}
+	}
+	return pruneDeletedComments(roots)
+}
+
+func pruneDeletedComments(comments []*sgtmpb.Post) []*sgtmpb.Post {
+	ret := comments[:0]
+	for _, comment := range comments {
+		comment.Replies = pruneDeletedComments(comment.Replies)
+		if comment.IsDeleted() && len(comment.Replies) == 0 {
+			continue
+		}
+		ret = append(ret, comment)
+	}
+	return ret
+}
+
+func validateCommentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	switch {
+	case body == "":
+		return "", postInputError("Empty comment.")
+	case len([]rune(body)) > maxCommentLength:
+		return "", postInputError(fmt.Sprintf("Comments are limited to %d characters.", maxCommentLength))
+	}
+	return body, nil
+}
+
+// createComment adds a comment on a track, or a reply if replyToID is set.
+func (svc *Service) createComment(author *sgtmpb.User, track *sgtmpb.Post, replyToID int64, body string) (*sgtmpb.Post, error) {
+	body, err := validateCommentBody(body)
+	if err != nil {
+		return nil, err
+	}
+	comment := sgtmpb.Post{
+		Kind:         sgtmpb.Post_CommentKind,
+		AuthorID:     author.ID,
+		Body:         body,
+		Visibility:   sgtmpb.Visibility_Public,
+		TargetPostID: track.ID,
+	}
+	if replyToID != 0 {
+		var parent sgtmpb.Post
+		err := svc.rodb().
+			Where(sgtmpb.Post{ID: replyToID, Kind: sgtmpb.Post_CommentKind, TargetPostID: track.ID}).
+			First(&parent).
+			Error
+		if err != nil || parent.IsDeleted() {
+			return nil, postInputError("Cannot reply to this comment.")
+		}
+		comment.ReplyToID = parent.ID
+		comment.ThreadPostID = parent.ThreadPostID
+		if comment.ThreadPostID == 0 {
+			comment.ThreadPostID = parent.ID
+		}
+	}
+	if err := svc.rwdb().Create(&comment).Error; err != nil {
+		return nil, err
+	}
+	svc.logger.Debug("comment created", zap.Any("post", &comment))
+	svc.publishActivity(&comment)
+	return &comment, nil
+}
+
+// updateComment replaces the body of a comment; only its author can edit it.
+func (svc *Service) updateComment(user *sgtmpb.User, comment *sgtmpb.Post, body string) error {
+	if user == nil || user.ID != comment.AuthorID || comment.IsDeleted() {
+		return errPermissionDenied
+	}
+	body, err := validateCommentBody(body)
+	if err != nil {
+		return err
+	}
+	fields := map[string]interface{}{
+		"body":      body,
+		"edited_at": time.Now().UnixNano(),
+	}
+	if err := svc.rwdb().Model(comment).Updates(fields).Error; err != nil {
+		return err
+	}
+	comment.Body = body
+	comment.EditedAt = fields["edited_at"].(int64)
+	svc.logger.Debug("comment updated", zap.Int64("id", comment.ID))
+	return nil
+}
+
+// deleteComment soft-deletes a comment, so its replies stay attached to the thread.
+func (svc *Service) deleteComment(user *sgtmpb.User, comment *sgtmpb.Post) error {
+	var track sgtmpb.Post
+	if err := svc.rodb().First(&track, comment.TargetPostID).Error; err != nil {
+		return err
+	}
+	if !canDeleteComment(user, comment, &track) {
+		return errPermissionDenied
+	}
+	if comment.IsDeleted() {
+		return nil
+	}
+	comment.DeletedAt = time.Now().UnixNano()
+	if err := svc.rwdb().Model(comment).Update("deleted_at", comment.DeletedAt).Error; err != nil {
+		return err
+	}
+	svc.logger.Debug("comment deleted", zap.Int64("id", comment.ID), zap.Int64("by", user.ID))
+	return nil
+}
+
+// commentByID returns a comment, deleted or not.
+func (svc *Service) commentByID(id int64) (*sgtmpb.Post, error) {
+	var comment sgtmpb.Post
+	err := svc.rodb().
+		Where(sgtmpb.Post{ID: id, Kind: sgtmpb.Post_CommentKind}).
+		First(&comment).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
@@ -1,6 +1,8 @@
 package sgtm
 
 import (
+	"reflect"
+
 	"github.com/bwmarrin/snowflake"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -17,6 +19,13 @@ func DBInit(db *gorm.DB, sfn *snowflake.Node) (*gorm.DB, error) {
 		&sgtmpb.User{},
 		&sgtmpb.Post{},
 		&sgtmpb.Relationship{},
+		&sgtmpb.APIToken{},
+		&sgtmpb.Job{},
+		&sgtmpb.MigrationRun{},
+		&sgtmpb.Rendition{},
+		&sgtmpb.Waveform{},
+		&sgtmpb.Fingerprint{},
+		&sgtmpb.FingerprintHash{},
 	)
 	if err != nil {
 		return nil, err
@@ -27,6 +36,15 @@ func DBInit(db *gorm.DB, sfn *snowflake.Node) (*gorm.DB, error) {
 
 func beforeCreate(sfn *snowflake.Node) func(*gorm.DB) {
 	return func(tx *gorm.DB) {
+		// the batches get one ID per record
+		if kind := tx.Statement.ReflectValue.Kind(); kind == reflect.Slice || kind == reflect.Array {
+			for i := 0; i < tx.Statement.ReflectValue.Len(); i++ {
+				tx.Statement.CurDestIndex = i
+				tx.Statement.SetColumn("ID", sfn.Generate().Int64())
+			}
+			tx.Statement.CurDestIndex = 0
+			return
+		}
 		tx.Statement.SetColumn("ID", sfn.Generate().Int64())
 	}
 }
@@ -74,3 +74,16 @@ func TestDBUserCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestDBBatchCreate(t *testing.T) {
+	db := TestingDB(t)
+
+	renditions := []*sgtmpb.Rendition{{PostID: 1, Codec: "mp3"}, {PostID: 1, Codec: "opus"}, {PostID: 2, Codec: "mp3"}}
+	require.NoError(t, db.Create(&renditions).Error)
+	ids := map[int64]bool{}
+	for _, rendition := range renditions {
+		require.Greater(t, rendition.ID, int64(1)<<22) // a snowflake, not a rowid
+		ids[rendition.ID] = true
+	}
+	require.Len(t, ids, len(renditions))
+}
@@ -0,0 +1,202 @@
+package sgtm
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"moul.io/godev"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// bandcampMaxPageSize is the maximum size of a Bandcamp page; the track pages are usually around 200kB.
+const bandcampMaxPageSize = 5 << 20
+
+var (
+	bandcampTralbumRegex = regexp.MustCompile(`data-tralbum="([^"]*)"`)
+	bandcampLDJSONRegex  = regexp.MustCompile(`(?s)<script type="application/ld\+json"[^>]*>(.*?)</script>`)
+)
+
+// BandcampClient is the subset of bandcamp.com used by sgtm; Bandcamp has no public API, so the pages are parsed.
+type BandcampClient interface {
+	// Page returns the HTML of a public bandcamp.com page.
+	Page(link string) ([]byte, error)
+}
+
+// NewBandcampClient returns an HTTP Bandcamp client.
+func NewBandcampClient() BandcampClient {
+	return &bandcampHTTPClient{http: &http.Client{Timeout: 10 * time.Second}}
+}
+
+type bandcampHTTPClient struct {
+	http *http.Client
+}
+
+func (c *bandcampHTTPClient) Page(link string) ([]byte, error) {
+	res, err := c.http.Get(link)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bandcamp: get %q: unexpected status %d", link, res.StatusCode)
+	}
+	return ioutil.ReadAll(io.LimitReader(res.Body, bandcampMaxPageSize))
+}
+
+// bandcampTrack is the data of a track, parsed from its page.
+type bandcampTrack struct {
+	ID          uint64    `json:"id"`
+	URL         string    `json:"url"`
+	Title       string    `json:"title"`
+	Artist      string    `json:"artist"`
+	About       string    `json:"about,omitempty"`
+	Lyrics      string    `json:"lyrics,omitempty"`
+	ISRC        string    `json:"isrc,omitempty"`
+	DurationMs  uint64    `json:"duration_ms"`
+	ArtworkURL  string    `json:"artwork_url,omitempty"`
+	Tags        []string  `json:"tags,omitempty"`
+	ReleaseDate time.Time `json:"release_date"`
+}
+
+// bandcampTralbum is the part of the data-tralbum attribute used by sgtm.
+type bandcampTralbum struct {
+	ID       uint64 `json:"id"`
+	ItemType string `json:"item_type"`
+	Artist   string `json:"artist"`
+	ArtID    uint64 `json:"art_id"`
+	URL      string `json:"url"`
+	Current  struct {
+		Title       string `json:"title"`
+		About       string `json:"about"`
+		Lyrics      string `json:"lyrics"`
+		ISRC        string `json:"isrc"`
+		ReleaseDate string `json:"release_date"`
+		PublishDate string `json:"publish_date"`
+	} `json:"current"`
+	TrackInfo []struct {
+		ID       uint64  `json:"id"`
+		Title    string  `json:"title"`
+		Duration float64 `json:"duration"` // seconds
+		Lyrics   string  `json:"lyrics"`
+	} `json:"trackinfo"`
+}
+
+// bandcampLDJSON is the part of the schema.org metadata used by sgtm.
+type bandcampLDJSON struct {
+	Keywords      json.RawMessage `json:"keywords"` // a list, or a comma separated string
+	Image         json.RawMessage `json:"image"`    // a URL, or a list of URLs
+	DatePublished string          `json:"datePublished"`
+}
+
+// parseBandcampTrackPage extracts a track from the HTML of its page; it fails for the album pages.
+func parseBandcampTrackPage(page []byte) (*bandcampTrack, error) {
+	matches := bandcampTralbumRegex.FindSubmatch(page)
+	if matches == nil {
+		return nil, fmt.Errorf("bandcamp: no track data found")
+	}
+	var tralbum bandcampTralbum
+	if err := json.Unmarshal([]byte(html.UnescapeString(string(matches[1]))), &tralbum); err != nil {
+		return nil, fmt.Errorf("bandcamp: decode track data: %w", err)
+	}
+	if tralbum.ItemType != "track" || tralbum.ID == 0 {
+		return nil, fmt.Errorf("bandcamp: not a track: %q", tralbum.ItemType)
+	}
+
+	track := bandcampTrack{
+		ID:     tralbum.ID,
+		URL:    tralbum.URL,
+		Title:  tralbum.Current.Title,
+		Artist: tralbum.Artist,
+		About:  tralbum.Current.About,
+		Lyrics: tralbum.Current.Lyrics,
+		ISRC:   tralbum.Current.ISRC,
+	}
+	for _, info := range tralbum.TrackInfo {
+		if info.ID != tralbum.ID {
+			continue
+		}
+		track.DurationMs = uint64(info.Duration * 1000)
+		if track.Title == "" {
+			track.Title = info.Title
+		}
+		if track.Lyrics == "" {
+			track.Lyrics = info.Lyrics
+		}
+	}
+	if tralbum.ArtID != 0 {
+		track.ArtworkURL = fmt.Sprintf("https://f4.bcbits.com/img/a%010d_10.jpg", tralbum.ArtID)
+	}
+	releaseDate := tralbum.Current.ReleaseDate
+	if releaseDate == "" {
+		releaseDate = tralbum.Current.PublishDate
+	}
+
+	// schema.org metadata
+	if matches := bandcampLDJSONRegex.FindSubmatch(page); matches != nil {
+		var metadata bandcampLDJSON
+		if err := json.Unmarshal(matches[1], &metadata); err == nil {
+			var keywords []string
+			var keywordList string
+			switch {
+			case json.Unmarshal(metadata.Keywords, &keywords) == nil:
+			case json.Unmarshal(metadata.Keywords, &keywordList) == nil:
+				keywords = strings.Split(keywordList, ",")
+			}
+			for _, keyword := range keywords {
+				if keyword = strings.TrimSpace(keyword); keyword != "" {
+					track.Tags = append(track.Tags, keyword)
+				}
+			}
+			var image string
+			if json.Unmarshal(metadata.Image, &image) == nil && image != "" {
+				track.ArtworkURL = image
+			}
+			if releaseDate == "" {
+				releaseDate = metadata.DatePublished
+			}
+		}
+	}
+
+	if releaseDate != "" {
+		if date, err := time.Parse("02 Jan 2006 15:04:05 MST", releaseDate); err == nil {
+			track.ReleaseDate = date
+		}
+	}
+	return &track, nil
+}
+
+// fetchBandcampTrack fetches and parses the page of a Bandcamp track.
+func (svc *Service) fetchBandcampTrack(link string) (*bandcampTrack, error) {
+	page, err := svc.bandcamp.Page(link)
+	if err != nil {
+		return nil, err
+	}
+	return parseBandcampTrackPage(page)
+}
+
+func applyBandcampTrack(post *sgtmpb.Post, track *bandcampTrack) {
+	post.ProviderMetadata = godev.JSON(track)
+	post.ProviderTitle = track.Title
+	post.ProviderDescription = track.About
+	if !track.ReleaseDate.IsZero() {
+		post.ProviderCreatedAt = track.ReleaseDate.UnixNano()
+	}
+	post.Artist = track.Artist
+	post.Tags = strings.Join(track.Tags, ", ")
+	post.Duration = track.DurationMs
+	post.ArtworkURL = track.ArtworkURL
+	post.ISRC = track.ISRC
+	if !post.IsEdited("lyrics") {
+		post.Lyrics = track.Lyrics
+	}
+	post.URL = track.URL
+	post.BandcampID = track.ID
+	post.Provider = sgtmpb.Provider_Bandcamp
+}
@@ -4,15 +4,47 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sync"
 
 	"github.com/bwmarrin/discordgo"
 	"go.uber.org/zap"
 	"moul.io/banner"
 	"moul.io/godev"
+	"moul.io/sgtm/pkg/sgtmpb"
 )
 
+// discordDriver holds the bot connection, which is set by StartDiscord while
+// the jobs and the health checks read it concurrently.
 type discordDriver struct {
+	mu      sync.RWMutex
 	session *discordgo.Session
+
+	// sendMessage and sendDM are set when the bot is connected
+	sendMessage func(channelID string, msg string) error
+	sendDM      func(discordUserID string, msg string) error
+}
+
+func newDiscordDriver() *discordDriver { return &discordDriver{} }
+
+// connected publishes the session and the message senders of a connected bot.
+func (d *discordDriver) connected(session *discordgo.Session, sendMessage func(string, string) error, sendDM func(string, string) error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.session = session
+	d.sendMessage = sendMessage
+	d.sendDM = sendDM
+}
+
+func (d *discordDriver) getSession() *discordgo.Session {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.session
+}
+
+func (d *discordDriver) getSenders() (sendMessage func(string, string) error, sendDM func(string, string) error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.sendMessage, d.sendDM
 }
 
 func (svc *Service) StartDiscord() error {
@@ -60,17 +92,52 @@ func (svc *Service) StartDiscord() error {
 		if err != nil {
 			return err
 		}
-		svc.discord.session = dg
+		sendMessage := func(channelID string, msg string) error {
+			_, err := dg.ChannelMessageSend(channelID, msg)
+			return err
+		}
+		sendDM := func(discordUserID string, msg string) error {
+			channel, err := dg.UserChannelCreate(discordUserID)
+			if err != nil {
+				return err
+			}
+			_, err = dg.ChannelMessageSend(channel.ID, msg)
+			return err
+		}
+		svc.discord.connected(dg, sendMessage, sendDM)
 
 		<-svc.ctx.Done()
 	}
 	return nil
 }
 
+// notifyAdmins sends a message to the Discord admin channel, if the bot is connected.
+func (svc *Service) notifyAdmins(msg string) {
+	sendMessage, _ := svc.discord.getSenders()
+	if sendMessage == nil || svc.opts.DiscordAdminChannel == "" {
+		return
+	}
+	if err := sendMessage(svc.opts.DiscordAdminChannel, msg); err != nil {
+		svc.logger.Warn("failed to notify admins", zap.Error(err))
+	}
+}
+
+// notifyUser sends a direct message to a user with a linked Discord account, if the bot is connected.
+func (svc *Service) notifyUser(user *sgtmpb.User, msg string) {
+	_, sendDM := svc.discord.getSenders()
+	if sendDM == nil || user.DiscordID == "" {
+		return
+	}
+	if err := sendDM(user.DiscordID, msg); err != nil {
+		svc.logger.Warn("failed to notify user", zap.Int64("user", user.ID), zap.Error(err))
+	}
+}
+
 func (svc *Service) CloseDiscord(err error) {
-	svc.logger.Debug("closing discord", zap.Bool("was-started", svc.discord.session != nil), zap.Error(err))
-	if svc.discord.session != nil {
-		svc.discord.session.Close()
+	session := svc.discord.getSession()
+	svc.logger.Debug("closing discord", zap.Bool("was-started", session != nil), zap.Error(err))
+	if session != nil {
+		session.Close()
 		svc.logger.Debug("discord closed")
 	}
 	svc.cancel()
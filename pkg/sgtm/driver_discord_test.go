@@ -0,0 +1,51 @@
+package sgtm
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/require"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+func TestDiscordDriverConcurrency(t *testing.T) {
+	svc := TestingService(t)
+	svc.opts.EnableDiscord = true
+	svc.opts.DiscordAdminChannel = "admin"
+	user := &sgtmpb.User{ID: 1, DiscordID: "1234"}
+
+	state, _ := svc.checkDiscord(context.Background())
+	require.Equal(t, sgtmpb.Status_DownState, state)
+	svc.notifyAdmins("dropped") // not connected yet
+
+	var sent int64
+	send := func(string, string) error {
+		atomic.AddInt64(&sent, 1)
+		return nil
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		svc.discord.connected(&discordgo.Session{}, send, send)
+	}()
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			svc.notifyAdmins("hello")
+			svc.notifyUser(user, "hello")
+			_, _ = svc.checkDiscord(context.Background())
+		}()
+	}
+	wg.Wait()
+
+	state, _ = svc.checkDiscord(context.Background())
+	require.Equal(t, sgtmpb.Status_DegradedState, state)
+	svc.notifyAdmins("hello")
+	svc.notifyUser(user, "hello")
+	require.GreaterOrEqual(t, atomic.LoadInt64(&sent), int64(2))
+}
@@ -0,0 +1,311 @@
+package sgtm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yanatan16/golang-soundcloud/soundcloud"
+	"moul.io/godev"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+const soundcloudAPIBaseURL = "https://api.soundcloud.com"
+
+// soundcloudTracksBatchSize is the maximum number of IDs of a /tracks request.
+const soundcloudTracksBatchSize = 50
+
+var (
+	soundcloudTrackPathRegex    = regexp.MustCompile(`/tracks/(.*).json`)
+	soundcloudPlaylistPathRegex = regexp.MustCompile(`/playlists/(.*).json`)
+	soundcloudUserPathRegex     = regexp.MustCompile(`/users/(.*).json`)
+)
+
+// SoundCloudClient is the subset of the SoundCloud API used by sgtm.
+type SoundCloudClient interface {
+	// Resolve returns the API URL of a public soundcloud.com link.
+	Resolve(link string) (*url.URL, error)
+	// Track fetches a track by its ID; params can contain a secret_token.
+	Track(id uint64, params url.Values) (*soundcloud.Track, error)
+	// Tracks fetches up to soundcloudTracksBatchSize tracks by their IDs in a single request;
+	// the tracks that are not available are missing from the result.
+	Tracks(ids []uint64, params url.Values) ([]*soundcloud.Track, error)
+	// Playlist fetches a set with its tracks, in order; params can contain a secret_token.
+	Playlist(id uint64, params url.Values) (*SoundCloudPlaylist, error)
+	// UserTracks fetches a page of the public tracks of a user; params can contain the cursor of the previous page.
+	UserTracks(id uint64, params url.Values) (*SoundCloudTrackPage, error)
+}
+
+// SoundCloudPlaylist is a SoundCloud set, i.e., an album or an EP.
+type SoundCloudPlaylist struct {
+	ID           uint64              `json:"id"`
+	Title        string              `json:"title"`
+	Description  string              `json:"description"`
+	PermalinkURL string              `json:"permalink_url"`
+	ArtworkURL   string              `json:"artwork_url"`
+	CreatedAt    string              `json:"created_at"`
+	PlaylistType string              `json:"playlist_type"` // i.e., "album", "ep"
+	Duration     uint64              `json:"duration"`
+	SecretToken  string              `json:"secret_token"`
+	User         *soundcloud.User    `json:"user"`
+	Tracks       []*soundcloud.Track `json:"tracks"` // the tracks after the first ones only have an ID
+}
+
+// SoundCloudTrackPage is a page of a paginated list of SoundCloud tracks.
+type SoundCloudTrackPage struct {
+	Collection []*soundcloud.Track `json:"collection"`
+	NextHref   string              `json:"next_href"` // link to the next page, empty for the last page
+}
+
+// NextParams returns the params of the next page, or nil for the last page.
+func (p *SoundCloudTrackPage) NextParams() (url.Values, error) {
+	if p.NextHref == "" {
+		return nil, nil
+	}
+	u, err := url.Parse(p.NextHref)
+	if err != nil {
+		return nil, fmt.Errorf("soundcloud: invalid next page: %w", err)
+	}
+	params := u.Query()
+	params.Del("client_id")
+	return params, nil
+}
+
+// NewSoundCloudClient returns an HTTP SoundCloud client.
+// If baseURL is empty, the official API is used.
+func NewSoundCloudClient(clientID string, baseURL string) SoundCloudClient {
+	if baseURL == "" {
+		baseURL = soundcloudAPIBaseURL
+	}
+	return &soundcloudHTTPClient{
+		clientID: clientID,
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		http: &http.Client{
+			Timeout: 10 * time.Second,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+type soundcloudHTTPClient struct {
+	clientID string
+	baseURL  string
+	http     *http.Client
+}
+
+func (c *soundcloudHTTPClient) Resolve(link string) (*url.URL, error) {
+	params := url.Values{}
+	params.Set("url", link)
+	res, err := c.get("/resolve.json", params)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusFound && res.StatusCode != http.StatusMovedPermanently {
+		return nil, soundcloudStatusError{Op: fmt.Sprintf("resolve %q", link), StatusCode: res.StatusCode}
+	}
+	return url.Parse(res.Header.Get("Location"))
+}
+
+func (c *soundcloudHTTPClient) Track(id uint64, params url.Values) (*soundcloud.Track, error) {
+	res, err := c.get(fmt.Sprintf("/tracks/%d.json", id), params)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, soundcloudStatusError{Op: fmt.Sprintf("get track %d", id), StatusCode: res.StatusCode}
+	}
+	var track soundcloud.Track
+	if err := json.NewDecoder(res.Body).Decode(&track); err != nil {
+		return nil, fmt.Errorf("soundcloud: decode track %d: %w", id, err)
+	}
+	return &track, nil
+}
+
+func (c *soundcloudHTTPClient) Tracks(ids []uint64, params url.Values) ([]*soundcloud.Track, error) {
+	if len(ids) > soundcloudTracksBatchSize {
+		return nil, fmt.Errorf("soundcloud: cannot get more than %d tracks at once", soundcloudTracksBatchSize)
+	}
+	strIDs := make([]string, len(ids))
+	for i, id := range ids {
+		strIDs[i] = strconv.FormatUint(id, 10)
+	}
+	query := url.Values{"ids": {strings.Join(strIDs, ",")}}
+	for key, values := range params {
+		query[key] = values
+	}
+	res, err := c.get("/tracks", query)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, soundcloudStatusError{Op: fmt.Sprintf("get %d tracks", len(ids)), StatusCode: res.StatusCode}
+	}
+	var tracks []*soundcloud.Track
+	if err := json.NewDecoder(res.Body).Decode(&tracks); err != nil {
+		return nil, fmt.Errorf("soundcloud: decode tracks: %w", err)
+	}
+	return tracks, nil
+}
+
+func (c *soundcloudHTTPClient) Playlist(id uint64, params url.Values) (*SoundCloudPlaylist, error) {
+	res, err := c.get(fmt.Sprintf("/playlists/%d.json", id), params)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, soundcloudStatusError{Op: fmt.Sprintf("get playlist %d", id), StatusCode: res.StatusCode}
+	}
+	var playlist SoundCloudPlaylist
+	if err := json.NewDecoder(res.Body).Decode(&playlist); err != nil {
+		return nil, fmt.Errorf("soundcloud: decode playlist %d: %w", id, err)
+	}
+	return &playlist, nil
+}
+
+func (c *soundcloudHTTPClient) UserTracks(id uint64, params url.Values) (*SoundCloudTrackPage, error) {
+	query := url.Values{"linked_partitioning": {"true"}, "limit": {"50"}}
+	for key, values := range params {
+		query[key] = values
+	}
+	res, err := c.get(fmt.Sprintf("/users/%d/tracks", id), query)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, soundcloudStatusError{Op: fmt.Sprintf("get tracks of user %d", id), StatusCode: res.StatusCode}
+	}
+	var page SoundCloudTrackPage
+	if err := json.NewDecoder(res.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("soundcloud: decode tracks of user %d: %w", id, err)
+	}
+	return &page, nil
+}
+
+func (c *soundcloudHTTPClient) get(path string, params url.Values) (*http.Response, error) {
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+	query.Set("client_id", c.clientID)
+	return c.http.Get(c.baseURL + path + "?" + query.Encode())
+}
+
+// soundcloudStatusError is returned when the SoundCloud API answers with an unexpected HTTP status.
+type soundcloudStatusError struct {
+	Op         string
+	StatusCode int
+}
+
+func (e soundcloudStatusError) Error() string {
+	return fmt.Sprintf("soundcloud: %s: unexpected status %d", e.Op, e.StatusCode)
+}
+
+// soundcloudTrackIDFromAPIURL extracts the track ID from a resolved API URL.
+func soundcloudTrackIDFromAPIURL(u *url.URL) (uint64, error) {
+	matches := soundcloudTrackPathRegex.FindStringSubmatch(u.Path)
+	if len(matches) != 2 {
+		return 0, fmt.Errorf("invalid SoundCloud track link")
+	}
+	return strconv.ParseUint(matches[1], 10, 64)
+}
+
+// soundcloudPlaylistIDFromAPIURL extracts the playlist ID from a resolved API URL.
+func soundcloudPlaylistIDFromAPIURL(u *url.URL) (uint64, error) {
+	matches := soundcloudPlaylistPathRegex.FindStringSubmatch(u.Path)
+	if len(matches) != 2 {
+		return 0, fmt.Errorf("invalid SoundCloud set link")
+	}
+	return strconv.ParseUint(matches[1], 10, 64)
+}
+
+// soundcloudUserIDFromAPIURL extracts the user ID from a resolved API URL.
+func soundcloudUserIDFromAPIURL(u *url.URL) (uint64, error) {
+	matches := soundcloudUserPathRegex.FindStringSubmatch(u.Path)
+	if len(matches) != 2 {
+		return 0, fmt.Errorf("invalid SoundCloud profile link")
+	}
+	return strconv.ParseUint(matches[1], 10, 64)
+}
+
+// fetchSoundCloudTrack fetches the SoundCloud track attached to the post.
+func (svc *Service) fetchSoundCloudTrack(post *sgtmpb.Post) (*soundcloud.Track, error) {
+	params := url.Values{}
+	if post.SoundCloudSecretToken != "" {
+		params.Set("secret_token", post.SoundCloudSecretToken)
+	}
+	return svc.soundcloud.Track(post.SoundCloudID, params)
+}
+
+// applySoundCloudTrack copies the provider metadata of a SoundCloud track into the post.
+func applySoundCloudTrack(post *sgtmpb.Post, track *soundcloud.Track) {
+	post.ProviderMetadata = godev.JSON(track)
+	post.ProviderTitle = track.Title
+	createdAt, err := time.Parse("2006/01/02 15:04:05 +0000", track.CreatedAt)
+	if err == nil {
+		post.ProviderCreatedAt = createdAt.UnixNano()
+	}
+	tags := append(
+		[]string{track.Genre},
+		strings.Split(track.TagList, " ")...,
+	)
+	post.Tags = strings.Join(tags, ", ")
+	post.Duration = track.Duration
+	post.ArtworkURL = strings.ReplaceAll(track.ArtworkUrl, "-large.jpg", "-t500x500.jpg")
+	post.ISRC = track.ISRC
+	post.BPM = track.Bpm
+	post.KeySignature = track.KeySignature
+	post.ProviderDescription = track.Description
+	/*
+		post.Body = track.Description
+		post.WaveformURL = track.WaveformURL
+		post.License = track.License
+		track.User
+	*/
+	if track.Downloadable {
+		post.DownloadURL = track.DownloadUrl
+	}
+	post.URL = track.PermalinkUrl
+	post.Provider = sgtmpb.Provider_SoundCloud
+}
+
+// applySoundCloudPlaylist copies the provider metadata of a SoundCloud set into the album, without its tracks.
+func applySoundCloudPlaylist(album *sgtmpb.Post, playlist *SoundCloudPlaylist) {
+	metadata := *playlist
+	metadata.Tracks = nil
+	album.ProviderMetadata = godev.JSON(metadata)
+	album.ProviderTitle = playlist.Title
+	album.ProviderDescription = playlist.Description
+	createdAt, err := time.Parse("2006/01/02 15:04:05 +0000", playlist.CreatedAt)
+	if err == nil {
+		album.ProviderCreatedAt = createdAt.UnixNano()
+	}
+	album.Duration = playlist.Duration
+	album.ArtworkURL = strings.ReplaceAll(playlist.ArtworkURL, "-large.jpg", "-t500x500.jpg")
+	if album.ArtworkURL == "" && len(playlist.Tracks) > 0 && playlist.Tracks[0].ArtworkUrl != "" {
+		album.ArtworkURL = strings.ReplaceAll(playlist.Tracks[0].ArtworkUrl, "-large.jpg", "-t500x500.jpg")
+	}
+	album.URL = playlist.PermalinkURL
+	album.Provider = sgtmpb.Provider_SoundCloud
+}
+
+func sortedKeys(fields map[string]interface{}) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,120 @@
+package sgtm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"strings"
+	"time"
+
+	"moul.io/godev"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+const (
+	// youtubeDLInfoMargin is kept between the timeout of youtube-dl and the request timeout, so the import fails
+	// before the request and no post is created once the client gave up.
+	youtubeDLInfoMargin     = time.Second
+	youtubeDLInfoMaxTimeout = 30 * time.Second
+)
+
+// youtubeDLInfoTimeout is the timeout of youtube-dl when fetching the metadata of a media, without downloading it;
+// the metadata is fetched during the requests.
+func (svc *Service) youtubeDLInfoTimeout() time.Duration {
+	timeout := svc.opts.ServerRequestTimeout - youtubeDLInfoMargin
+	switch {
+	case svc.opts.ServerRequestTimeout <= 0 || timeout > youtubeDLInfoMaxTimeout: // no request timeout
+		return youtubeDLInfoMaxTimeout
+	case timeout < youtubeDLInfoMargin:
+		return youtubeDLInfoMargin
+	}
+	return timeout
+}
+
+// fetchYoutubeDLInfo returns the metadata of a single media, as written by youtube-dl --write-info-json.
+// The links to hosts on private networks are rejected.
+func (svc *Service) fetchYoutubeDLInfo(link string) (*YoutubeDLOutput, error) {
+	ctx, cancel := context.WithTimeout(svc.ctx, svc.youtubeDLInfoTimeout())
+	defer cancel()
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPublicHost(ctx, u.Hostname()); err != nil {
+		return nil, err
+	}
+	cmd := exec.CommandContext(
+		ctx,
+		"youtube-dl",
+		"--dump-json",
+		"--no-playlist",
+		"--", // the link is never read as an option
+		link,
+	)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("youtube-dl: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	// the playlists are dumped as one line per media
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(nil, 10<<20)
+	var infos []YoutubeDLOutput
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var info YoutubeDLOutput
+		if err := json.Unmarshal(line, &info); err != nil {
+			return nil, fmt.Errorf("youtube-dl: invalid output: %w", err)
+		}
+		infos = append(infos, info)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("youtube-dl: %w", err)
+	}
+	switch {
+	case len(infos) == 0:
+		return nil, fmt.Errorf("youtube-dl: no media found")
+	case len(infos) > 1 || infos[0].Type == "playlist":
+		return nil, fmt.Errorf("youtube-dl: playlists are not supported")
+	case infos[0].ID == "" || infos[0].Extractor == "":
+		return nil, fmt.Errorf("youtube-dl: missing media ID")
+	}
+	return &infos[0], nil
+}
+
+func applyYoutubeDLInfo(post *sgtmpb.Post, info *YoutubeDLOutput) {
+	// the format links expire
+	metadata := *info
+	metadata.Formats = nil
+	metadata.URL = ""
+	post.ProviderMetadata = godev.JSON(metadata)
+
+	post.ProviderTitle = info.Title
+	post.ProviderDescription = info.Description
+	if uploadDate, err := time.Parse("20060102", info.UploadDate); err == nil {
+		post.ProviderCreatedAt = uploadDate.UnixNano()
+	} else if info.Timestamp != 0 {
+		post.ProviderCreatedAt = time.Unix(int64(info.Timestamp), 0).UnixNano()
+	}
+	post.Artist = info.Uploader
+	tags := []string{}
+	if info.Genre != "" {
+		tags = append(tags, info.Genre)
+	}
+	tags = append(tags, info.Tags...)
+	post.Tags = strings.Join(tags, ", ")
+	post.Duration = uint64(info.Duration * 1000)
+	post.ArtworkURL = info.Thumbnail
+	post.URL = info.WebpageURL
+	post.YoutubeDLExtractor = info.Extractor
+	post.YoutubeDLID = info.ID
+}
@@ -0,0 +1,490 @@
+package sgtm
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+const (
+	// fingerprintVersion should be incremented when the algorithm changes,
+	// the tracks with an older fingerprint are then processed again.
+	fingerprintVersion    = 2
+	fingerprintMaxSeconds = 15 * 60
+	fingerprintRate       = 5512 // the audio is resampled, so the files of any rate can be compared
+	fingerprintFrameSize  = 2048 // ~370ms
+	fingerprintHop        = 128  // ~23ms
+	fingerprintMinFreq    = 300
+	fingerprintMaxFreq    = 2000
+	fingerprintBands      = 33 // one bit per pair of adjacent bands
+
+	// fingerprintMinOverlap is the minimum number of aligned sub-fingerprints, ~10s, to compare two tracks.
+	fingerprintMinOverlap = 430
+	// fingerprintDuplicateThreshold is the similarity of two encodes of the same audio.
+	fingerprintDuplicateThreshold = 0.85
+	// fingerprintVersionThreshold is the similarity of two near-identical tracks, i.e., a new mix.
+	fingerprintVersionThreshold = 0.70
+	// fingerprintIndexShift keeps one distinct sub-fingerprint out of 4 in the index, chosen by value
+	// so the encodes of the same audio keep the same ones.
+	fingerprintIndexShift = 30
+	// fingerprintMinCommonHashes is the number of indexed sub-fingerprints shared with a track to compare it.
+	fingerprintMinCommonHashes = 2
+	// fingerprintLookupBatchSize keeps the lookups under the SQLite limit of variables.
+	fingerprintLookupBatchSize = 500
+	// fingerprintNotifyWindow avoids notifying about the old tracks when the fingerprints are computed again.
+	fingerprintNotifyWindow = 24 * time.Hour
+)
+
+// fingerprintMigrationName changes with the version, so the existing tracks are processed again.
+func fingerprintMigrationName() string { return fmt.Sprintf("fingerprint-v%d", fingerprintVersion) }
+
+// fingerprintMigration computes the acoustic fingerprint of the track and looks for similar tracks.
+func (svc *Service) fingerprintMigration(post *sgtmpb.Post, db *gorm.DB) (func(*gorm.DB) error, error) {
+	if post.Kind != sgtmpb.Post_TrackKind || !hasProvider(post) {
+		return nil, nil
+	}
+
+	path, cleanup, err := svc.localTrackFile(post)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the audio file: %w", err)
+	}
+	defer cleanup()
+	ctx, cancel := context.WithTimeout(svc.ctx, analysisTimeout)
+	defer cancel()
+	samples, rate, err := decodeMonoPCM(ctx, path, fingerprintMaxSeconds)
+	if err != nil {
+		return nil, err
+	}
+	hashes := computeFingerprint(samples, rate)
+	if len(hashes) == 0 {
+		return nil, fmt.Errorf("audio too short to compute the fingerprint")
+	}
+	matches, err := findSimilarTracks(post, hashes, db)
+	if err != nil {
+		return nil, err
+	}
+	return func(tx *gorm.DB) error {
+		return svc.applyFingerprint(post, hashes, matches, tx)
+	}, nil
+}
+
+// applyFingerprint replaces the fingerprint of the track and its index, then flags the likely duplicates,
+// and suggests a new version relationship with the near-identical tracks of the same author.
+func (svc *Service) applyFingerprint(post *sgtmpb.Post, hashes []uint32, matches []fingerprintMatch, tx *gorm.DB) error {
+	if err := tx.Where(sgtmpb.Fingerprint{PostID: post.ID}).Delete(&sgtmpb.Fingerprint{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where(sgtmpb.FingerprintHash{PostID: post.ID}).Delete(&sgtmpb.FingerprintHash{}).Error; err != nil {
+		return err
+	}
+	fingerprint := sgtmpb.Fingerprint{
+		PostID:  post.ID,
+		Version: fingerprintVersion,
+		Length:  int64(len(hashes)),
+		Hashes:  encodeFingerprint(hashes),
+	}
+	if err := tx.Create(&fingerprint).Error; err != nil {
+		return err
+	}
+	indexed := indexedFingerprintHashes(hashes)
+	if len(indexed) > 0 {
+		rows := make([]sgtmpb.FingerprintHash, 0, len(indexed))
+		for _, hash := range indexed {
+			rows = append(rows, sgtmpb.FingerprintHash{PostID: post.ID, Version: fingerprintVersion, Hash: hash})
+		}
+		if err := tx.CreateInBatches(&rows, fingerprintLookupBatchSize/5).Error; err != nil {
+			return err
+		}
+	}
+	if err := tx.Model(post).Update("fingerprint_version", fingerprintVersion).Error; err != nil {
+		return err
+	}
+
+	var duplicates []*sgtmpb.Post
+	for _, match := range matches {
+		if match.similarity >= fingerprintDuplicateThreshold {
+			duplicates = append(duplicates, match.post)
+			if err := addPossibleDuplicate(match.post, post.ID, tx); err != nil {
+				return err
+			}
+			if err := addPossibleDuplicate(post, match.post.ID, tx); err != nil {
+				return err
+			}
+		}
+		if match.post.AuthorID == post.AuthorID && match.similarity >= fingerprintVersionThreshold {
+			if err := suggestNewVersion(post, match.post, tx); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(duplicates) > 0 && time.Since(time.Unix(0, post.CreatedAt)) < fingerprintNotifyWindow {
+		// the notification is sent by a job, once the transaction is committed
+		payload := possibleDuplicatesPayload{}
+		for _, duplicate := range duplicates {
+			payload.DuplicateIDs = append(payload.DuplicateIDs, duplicate.ID)
+		}
+		job, err := newJob(sgtmpb.Job_NotifyPossibleDuplicatesKind, post.ID, payload)
+		if err != nil {
+			return err
+		}
+		if err := tx.Create(job).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type fingerprintMatch struct {
+	post       *sgtmpb.Post
+	similarity float64
+}
+
+// indexedFingerprintHashes returns the distinct sub-fingerprints kept in the index, without the constant parts.
+func indexedFingerprintHashes(hashes []uint32) []uint32 {
+	counts := map[uint32]int{}
+	for _, hash := range hashes {
+		if (hash*2654435761)>>fingerprintIndexShift == 0 {
+			counts[hash]++
+		}
+	}
+	indexed := make([]uint32, 0, len(counts))
+	for hash, count := range counts {
+		if count <= 10 { // silence and other constant parts, like in fingerprintSimilarity
+			indexed = append(indexed, hash)
+		}
+	}
+	sort.Slice(indexed, func(i, j int) bool { return indexed[i] < indexed[j] })
+	return indexed
+}
+
+// findCandidateTracks returns the other tracks sharing enough indexed sub-fingerprints to be compared.
+func findCandidateTracks(post *sgtmpb.Post, hashes []uint32, db *gorm.DB) ([]int64, error) {
+	indexed := indexedFingerprintHashes(hashes)
+	common := map[int64]int64{}
+	for start := 0; start < len(indexed); start += fingerprintLookupBatchSize {
+		end := start + fingerprintLookupBatchSize
+		if end > len(indexed) {
+			end = len(indexed)
+		}
+		var counts []struct {
+			PostID int64
+			Count  int64
+		}
+		err := db.
+			Model(&sgtmpb.FingerprintHash{}).
+			Select("post_id, COUNT(*) AS count").
+			Where("post_id <> ? AND version = ? AND hash IN (?)", post.ID, fingerprintVersion, indexed[start:end]).
+			Group("post_id").
+			Scan(&counts).
+			Error
+		if err != nil {
+			return nil, err
+		}
+		for _, count := range counts {
+			common[count.PostID] += count.Count
+		}
+	}
+	var ids []int64
+	for id, count := range common {
+		if count >= fingerprintMinCommonHashes {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+// findSimilarTracks compares the fingerprint with the ones of the candidate tracks.
+func findSimilarTracks(post *sgtmpb.Post, hashes []uint32, db *gorm.DB) ([]fingerprintMatch, error) {
+	candidates, err := findCandidateTracks(post, hashes, db)
+	if err != nil || len(candidates) == 0 {
+		return nil, err
+	}
+	similarities := map[int64]float64{}
+	for start := 0; start < len(candidates); start += fingerprintLookupBatchSize {
+		end := start + fingerprintLookupBatchSize
+		if end > len(candidates) {
+			end = len(candidates)
+		}
+		var fingerprints []*sgtmpb.Fingerprint
+		err := db.
+			Where("post_id IN (?) AND version = ?", candidates[start:end], fingerprintVersion).
+			Find(&fingerprints).
+			Error
+		if err != nil {
+			return nil, err
+		}
+		for _, other := range fingerprints {
+			if similarity := fingerprintSimilarity(hashes, decodeFingerprint(other.Hashes)); similarity >= fingerprintVersionThreshold {
+				similarities[other.PostID] = similarity
+			}
+		}
+	}
+	if len(similarities) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, 0, len(similarities))
+	for id := range similarities {
+		ids = append(ids, id)
+	}
+	var posts []*sgtmpb.Post
+	if err := db.Where("kind = ? AND id IN (?)", sgtmpb.Post_TrackKind, ids).Order("id").Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	matches := make([]fingerprintMatch, 0, len(posts))
+	for _, other := range posts {
+		matches = append(matches, fingerprintMatch{post: other, similarity: similarities[other.ID]})
+	}
+	return matches, nil
+}
+
+// addPossibleDuplicate adds an ID to the possible duplicates of a track.
+func addPossibleDuplicate(post *sgtmpb.Post, duplicateID int64, tx *gorm.DB) error {
+	ids := post.PossibleDuplicateIDs()
+	for _, id := range ids {
+		if id == duplicateID {
+			return nil
+		}
+	}
+	ids = append(ids, duplicateID)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, strconv.FormatInt(id, 10))
+	}
+	post.PossibleDuplicates = strings.Join(values, ",")
+	return tx.Model(&sgtmpb.Post{ID: post.ID}).Update("possible_duplicates", post.PossibleDuplicates).Error
+}
+
+// suggestNewVersion links the most recent of two tracks to the oldest one, unless they are already related.
+func suggestNewVersion(post *sgtmpb.Post, other *sgtmpb.Post, tx *gorm.DB) error {
+	var count int64
+	err := tx.
+		Model(&sgtmpb.Relationship{}).
+		Where("(source_post_id = ? AND target_post_id = ?) OR (source_post_id = ? AND target_post_id = ?)", post.ID, other.ID, other.ID, post.ID).
+		Count(&count).
+		Error
+	if err != nil || count > 0 {
+		return err
+	}
+	source, target := post, other
+	if source.SortDate < target.SortDate || (source.SortDate == target.SortDate && source.ID < target.ID) {
+		source, target = target, source
+	}
+	return tx.Create(&sgtmpb.Relationship{
+		Kind:         sgtmpb.Relationship_NewVersionOfTrackKind,
+		SourcePostID: source.ID,
+		TargetPostID: target.ID,
+		Suggested:    true,
+	}).Error
+}
+
+// possibleDuplicatesPayload is the payload of the NotifyPossibleDuplicates jobs.
+type possibleDuplicatesPayload struct {
+	DuplicateIDs []int64 `json:"duplicate_ids"`
+}
+
+// notifyPossibleDuplicatesJob warns the admins and the author that a new track looks like existing tracks.
+// The author is only told about the tracks they can view; the admins are told about all of them.
+func (svc *Service) notifyPossibleDuplicatesJob(job *sgtmpb.Job, db *gorm.DB) error {
+	post, err := jobPost(job, db)
+	if err != nil {
+		return err
+	}
+	var payload possibleDuplicatesPayload
+	if err := json.Unmarshal([]byte(job.Payload), &payload); err != nil {
+		return fmt.Errorf("invalid job payload: %w", err)
+	}
+	var duplicates []*sgtmpb.Post
+	if err := db.Where("id IN (?)", payload.DuplicateIDs).Order("id").Find(&duplicates).Error; err != nil {
+		return err
+	}
+	if len(duplicates) == 0 || post.Author == nil {
+		return nil
+	}
+
+	titles := func(duplicates []*sgtmpb.Post) string {
+		titles := make([]string, 0, len(duplicates))
+		for _, duplicate := range duplicates {
+			titles = append(titles, fmt.Sprintf("**%s** (https://sgtm.club%s)", duplicate.SafeTitle(), duplicate.CanonicalURL()))
+		}
+		return strings.Join(titles, ", ")
+	}
+	link := "https://sgtm.club" + post.CanonicalURL()
+	svc.logger.Debug("possible duplicates", zap.Int64("post", post.ID), zap.Int64s("duplicates", payload.DuplicateIDs))
+	svc.notifyAdmins(fmt.Sprintf("🔁 **%s** by @%s looks like a duplicate of %s: %s", post.SafeTitle(), post.Author.Slug, titles(duplicates), link))
+
+	visible := make([]*sgtmpb.Post, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		if canViewPost(post.Author, duplicate) {
+			visible = append(visible, duplicate)
+		}
+	}
+	if len(visible) > 0 {
+		svc.notifyUser(post.Author, fmt.Sprintf("🔁 Your new track **%s** looks like a duplicate of %s: %s", post.SafeTitle(), titles(visible), link))
+	}
+	return nil
+}
+
+// computeFingerprint returns one 32-bit sub-fingerprint per frame, based on the energy differences
+// of adjacent frequency bands in consecutive frames (Haitsma and Kalker, 2002).
+func computeFingerprint(samples []float64, rate int) []uint32 {
+	resampled := resample(samples, rate, fingerprintRate)
+	if len(resampled) < fingerprintFrameSize {
+		return nil
+	}
+
+	// logarithmically spaced bands
+	edges := make([]int, fingerprintBands+1)
+	for i := range edges {
+		freq := fingerprintMinFreq * math.Pow(float64(fingerprintMaxFreq)/fingerprintMinFreq, float64(i)/fingerprintBands)
+		edges[i] = int(math.Round(freq * fingerprintFrameSize / fingerprintRate))
+	}
+	window := make([]float64, fingerprintFrameSize)
+	for i := range window {
+		window[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/fingerprintFrameSize)
+	}
+
+	var (
+		hashes   []uint32
+		previous []float64
+		spectrum = make([]complex128, fingerprintFrameSize)
+	)
+	for start := 0; start+fingerprintFrameSize <= len(resampled); start += fingerprintHop {
+		for i := range spectrum {
+			spectrum[i] = complex(resampled[start+i]*window[i], 0)
+		}
+		fft(spectrum)
+		energies := make([]float64, fingerprintBands)
+		for band := range energies {
+			for bin := edges[band]; bin < edges[band+1]; bin++ {
+				re, im := real(spectrum[bin]), imag(spectrum[bin])
+				energies[band] += re*re + im*im
+			}
+		}
+		if previous != nil {
+			var hash uint32
+			for band := 0; band < fingerprintBands-1; band++ {
+				if energies[band]-energies[band+1]-(previous[band]-previous[band+1]) > 0 {
+					hash |= 1 << band
+				}
+			}
+			hashes = append(hashes, hash)
+		}
+		previous = energies
+	}
+	return hashes
+}
+
+// resample converts the samples to another rate, with a moving average as low-pass filter and a linear interpolation.
+func resample(samples []float64, from int, to int) []float64 {
+	if from == to {
+		return samples
+	}
+	filtered := samples
+	if width := from / to; width > 1 {
+		filtered = make([]float64, len(samples))
+		var sum float64
+		for i, sample := range samples {
+			sum += sample
+			if i >= width {
+				sum -= samples[i-width]
+			}
+			filtered[i] = sum / float64(width)
+		}
+	}
+	ratio := float64(from) / float64(to)
+	resampled := make([]float64, int(float64(len(samples))/ratio))
+	for i := range resampled {
+		pos := float64(i) * ratio
+		idx := int(pos)
+		frac := pos - float64(idx)
+		next := idx + 1
+		if next >= len(filtered) {
+			next = idx
+		}
+		resampled[i] = filtered[idx]*(1-frac) + filtered[next]*frac
+	}
+	return resampled
+}
+
+// fingerprintSimilarity returns 1 minus the bit error rate of two fingerprints, at their best alignment.
+// The candidate alignments are the most frequent offsets between identical sub-fingerprints.
+func fingerprintSimilarity(a, b []uint32) float64 {
+	positions := map[uint32][]int{}
+	for i, hash := range a {
+		positions[hash] = append(positions[hash], i)
+	}
+	votes := map[int]int{0: 0}
+	for j, hash := range b {
+		matches := positions[hash]
+		if len(matches) > 10 { // silence and other constant parts
+			continue
+		}
+		for _, i := range matches {
+			votes[i-j]++
+		}
+	}
+	offsets := make([]int, 0, len(votes))
+	for offset := range votes {
+		offsets = append(offsets, offset)
+	}
+	sort.Slice(offsets, func(i, j int) bool {
+		if votes[offsets[i]] != votes[offsets[j]] {
+			return votes[offsets[i]] > votes[offsets[j]]
+		}
+		return offsets[i] < offsets[j]
+	})
+	if len(offsets) > 5 {
+		offsets = offsets[:5]
+	}
+
+	best := 0.0
+	for _, offset := range offsets {
+		var errs, overlap int
+		for j := range b {
+			i := j + offset
+			if i < 0 || i >= len(a) {
+				continue
+			}
+			errs += bits.OnesCount32(a[i] ^ b[j])
+			overlap++
+		}
+		if overlap < fingerprintMinOverlap {
+			continue
+		}
+		if similarity := 1 - float64(errs)/float64(overlap*(fingerprintBands-1)); similarity > best {
+			best = similarity
+		}
+	}
+	return best
+}
+
+func encodeFingerprint(hashes []uint32) []byte {
+	raw := make([]byte, len(hashes)*4)
+	for i, hash := range hashes {
+		binary.LittleEndian.PutUint32(raw[i*4:], hash)
+	}
+	return raw
+}
+
+func decodeFingerprint(raw []byte) []uint32 {
+	hashes := make([]uint32, len(raw)/4)
+	for i := range hashes {
+		hashes[i] = binary.LittleEndian.Uint32(raw[i*4:])
+	}
+	return hashes
+}
@@ -0,0 +1,181 @@
+package sgtm
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// testingMelody returns a random sequence of chords, with some noise; the same seed returns the same melody.
+func testingMelody(seed int64, seconds float64, rate int, offset float64, gain float64) []float64 {
+	rng := rand.New(rand.NewSource(seed))
+	const noteDuration = 0.25
+	chords := make([][]float64, int(seconds/noteDuration)+1)
+	for i := range chords {
+		for j := 0; j < 3; j++ {
+			chords[i] = append(chords[i], 200*math.Pow(2, float64(rng.Intn(36))/12))
+		}
+	}
+	noise := rand.New(rand.NewSource(seed + 1))
+	samples := make([]float64, int((seconds+offset)*float64(rate)))
+	for i := range samples {
+		t := float64(i)/float64(rate) - offset
+		if t < 0 {
+			continue
+		}
+		var v float64
+		for _, freq := range chords[int(t/noteDuration)] {
+			v += 0.2 * math.Sin(2*math.Pi*freq*t)
+		}
+		samples[i] = gain*v + 0.01*(noise.Float64()*2-1)
+	}
+	return samples
+}
+
+func TestFingerprintSimilarity(t *testing.T) {
+	original := computeFingerprint(testingMelody(1, 30, 22050, 0, 1), 22050)
+	require.NotEmpty(t, original)
+
+	// another encode: another rate, volume and noise, with some silence at the beginning
+	reencoded := computeFingerprint(testingMelody(1, 30, 11025, 1.3, 0.6), 11025)
+	require.GreaterOrEqual(t, fingerprintSimilarity(original, reencoded), fingerprintDuplicateThreshold)
+	require.GreaterOrEqual(t, fingerprintSimilarity(reencoded, original), fingerprintDuplicateThreshold)
+
+	other := computeFingerprint(testingMelody(2, 30, 22050, 0, 1), 22050)
+	require.Less(t, fingerprintSimilarity(original, other), fingerprintVersionThreshold)
+
+	// too short to be compared
+	short := computeFingerprint(testingMelody(1, 5, 22050, 0, 1), 22050)
+	require.Zero(t, fingerprintSimilarity(original, short))
+
+	require.Equal(t, original, decodeFingerprint(encodeFingerprint(original)))
+}
+
+func TestApplyFingerprint(t *testing.T) {
+	svc := TestingService(t)
+	var adminMessages, directMessages []string
+	svc.opts.DiscordAdminChannel = "admin"
+	sendMessage := func(_ string, msg string) error {
+		adminMessages = append(adminMessages, msg)
+		return nil
+	}
+	sendDM := func(_ string, msg string) error {
+		directMessages = append(directMessages, msg)
+		return nil
+	}
+	svc.discord.connected(nil, sendMessage, sendDM)
+
+	original := computeFingerprint(testingMelody(1, 30, 22050, 0, 1), 22050)
+	reencoded := computeFingerprint(testingMelody(1, 30, 11025, 1.3, 0.6), 11025)
+	other := computeFingerprint(testingMelody(2, 30, 22050, 0, 1), 22050)
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author", DiscordID: "1234"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	someone := sgtmpb.User{Email: "someone@example.com", Slug: "someone"}
+	require.NoError(t, svc.rwdb().Create(&someone).Error)
+	newTrack := func(authorID int64, title string, sortDate time.Time) *sgtmpb.Post {
+		post := sgtmpb.Post{AuthorID: authorID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Public, Title: title, SortDate: sortDate.UnixNano()}
+		require.NoError(t, svc.rwdb().Create(&post).Error)
+		return &post
+	}
+	apply := func(post *sgtmpb.Post, hashes []uint32) error {
+		// the worker looks for the similar tracks, then saves the fingerprint in a transaction
+		matches, err := findSimilarTracks(post, hashes, svc.rodb())
+		if err != nil {
+			return err
+		}
+		err = svc.rwdb().Transaction(func(tx *gorm.DB) error { return svc.applyFingerprint(post, hashes, matches, tx) })
+		if err != nil {
+			return err
+		}
+		// the notifications are sent by a job
+		_, err = svc.runDueJobs()
+		return err
+	}
+	reload := func(post *sgtmpb.Post) *sgtmpb.Post {
+		var loaded sgtmpb.Post
+		require.NoError(t, svc.rodb().First(&loaded, post.ID).Error)
+		return &loaded
+	}
+	relationships := func() []*sgtmpb.Relationship {
+		var relationships []*sgtmpb.Relationship
+		require.NoError(t, svc.rodb().Order("id").Find(&relationships).Error)
+		return relationships
+	}
+
+	first := newTrack(author.ID, "first", time.Now().Add(-time.Hour))
+	require.NoError(t, apply(first, original))
+	unrelated := newTrack(author.ID, "unrelated", time.Now())
+	require.NoError(t, apply(unrelated, other))
+	require.Empty(t, reload(first).PossibleDuplicates)
+	require.Empty(t, relationships())
+	require.Empty(t, adminMessages)
+
+	// same author: duplicate and suggested new version
+	again := newTrack(author.ID, "again", time.Now())
+	require.NoError(t, apply(again, reencoded))
+	require.Equal(t, []int64{first.ID}, reload(again).PossibleDuplicateIDs())
+	require.Equal(t, []int64{again.ID}, reload(first).PossibleDuplicateIDs())
+	require.Len(t, relationships(), 1)
+	suggestion := relationships()[0]
+	require.True(t, suggestion.Suggested)
+	require.Equal(t, sgtmpb.Relationship_NewVersionOfTrackKind, suggestion.Kind)
+	require.Equal(t, again.ID, suggestion.SourcePostID)
+	require.Equal(t, first.ID, suggestion.TargetPostID)
+	require.Len(t, adminMessages, 1)
+	require.Len(t, directMessages, 1)
+	require.Contains(t, directMessages[0], "first")
+
+	// processing the track again does not duplicate anything
+	require.NoError(t, apply(again, reencoded))
+	require.Equal(t, []int64{first.ID}, reload(again).PossibleDuplicateIDs())
+	require.Len(t, relationships(), 1)
+
+	// another author: duplicate only
+	copied := newTrack(someone.ID, "copied", time.Now())
+	require.NoError(t, apply(copied, original))
+	require.Equal(t, []int64{first.ID, again.ID}, reload(copied).PossibleDuplicateIDs())
+	require.Equal(t, []int64{again.ID, copied.ID}, reload(first).PossibleDuplicateIDs())
+	require.Len(t, relationships(), 1)
+
+	// the author is only told about the tracks they can view
+	secret := newTrack(someone.ID, "secret", time.Now())
+	require.NoError(t, svc.rwdb().Model(secret).Update("visibility", sgtmpb.Visibility_Draft).Error)
+	require.NoError(t, apply(secret, other))
+	latest := newTrack(author.ID, "latest", time.Now())
+	require.NoError(t, apply(latest, other))
+	require.Equal(t, []int64{unrelated.ID, secret.ID}, reload(latest).PossibleDuplicateIDs())
+	require.Contains(t, adminMessages[len(adminMessages)-1], "secret")
+	require.Contains(t, adminMessages[len(adminMessages)-1], "unrelated")
+	require.Contains(t, directMessages[len(directMessages)-1], "unrelated")
+	require.NotContains(t, directMessages[len(directMessages)-1], "secret")
+
+	// only the tracks sharing indexed sub-fingerprints are compared
+	require.NotEmpty(t, indexedFingerprintHashes(original))
+	require.NoError(t, svc.rwdb().Where(sgtmpb.FingerprintHash{PostID: first.ID}).Delete(&sgtmpb.FingerprintHash{}).Error)
+	matches, err := findSimilarTracks(&sgtmpb.Post{}, original, svc.rodb())
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	require.ElementsMatch(t, []int64{again.ID, copied.ID}, []int64{matches[0].post.ID, matches[1].post.ID})
+
+	// the suggestions are not part of the lineage until confirmed
+	graph, err := svc.postLineage(&author, first.ID, defaultLineageDepth)
+	require.NoError(t, err)
+	require.Empty(t, graph.relationships)
+	confirmed, err := svc.createRelationship(&author, &sgtmpb.RelationshipCreate_Request{
+		Kind:         sgtmpb.Relationship_NewVersionOfTrackKind,
+		SourcePostID: again.ID,
+		TargetPostID: first.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, suggestion.ID, confirmed.ID)
+	require.False(t, confirmed.Suggested)
+	graph, err = svc.postLineage(&author, first.ID, defaultLineageDepth)
+	require.NoError(t, err)
+	require.Len(t, graph.relationships, 1)
+}
@@ -0,0 +1,303 @@
+package sgtm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+const (
+	// healthCheckTimeout is the maximum duration of each component check.
+	healthCheckTimeout = 5 * time.Second
+	// healthCacheTTL avoids probing every component on each Status call.
+	healthCacheTTL = 5 * time.Second
+	// slowDBThreshold is the latency above which the DB is considered degraded.
+	slowDBThreshold = 500 * time.Millisecond
+)
+
+// healthBinaries are the external tools used by the processing pipeline.
+var healthBinaries = []string{"youtube-dl", "sonic-annotator", "ffmpeg"}
+
+// processingRequiredBinaries are the tools without which the processing worker cannot work.
+var processingRequiredBinaries = map[string]bool{"ffmpeg": true}
+
+type healthCheck struct {
+	name     string
+	required bool
+	run      func(ctx context.Context) (sgtmpb.Status_State, string)
+}
+
+type healthCache struct {
+	mu      sync.Mutex
+	at      time.Time
+	status  *sgtmpb.Status_Response
+	running chan struct{} // closed when the running checks are done
+}
+
+func newHealthCache() *healthCache { return &healthCache{} }
+
+// cachedHealth returns the last health report if it is recent enough, or runs the checks again.
+// The checks are run once for all the concurrent callers, and are not canceled with the caller's context.
+func (svc *Service) cachedHealth(ctx context.Context) (*sgtmpb.Status_Response, error) {
+	svc.health.mu.Lock()
+	if svc.health.status != nil && time.Since(svc.health.at) <= healthCacheTTL {
+		defer svc.health.mu.Unlock()
+		return svc.health.status, nil
+	}
+	if svc.health.running == nil {
+		running := make(chan struct{})
+		svc.health.running = running
+		go func() {
+			status := svc.checkHealth(svc.ctx)
+			svc.health.mu.Lock()
+			svc.health.status, svc.health.at, svc.health.running = status, time.Now(), nil
+			svc.health.mu.Unlock()
+			close(running)
+		}()
+	}
+	running := svc.health.running
+	svc.health.mu.Unlock()
+
+	select {
+	case <-running:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	svc.health.mu.Lock()
+	defer svc.health.mu.Unlock()
+	return svc.health.status, nil
+}
+
+// publicHealth returns a copy of a health report without the hostname and the messages of the components,
+// which can contain internal details.
+func publicHealth(status *sgtmpb.Status_Response) *sgtmpb.Status_Response {
+	ret := sgtmpb.Status_Response{
+		Uptime:         status.Uptime,
+		EverythingIsOk: status.EverythingIsOk,
+		Ready:          status.Ready,
+		Components:     make([]*sgtmpb.Status_Component, len(status.Components)),
+	}
+	for idx, component := range status.Components {
+		ret.Components[idx] = &sgtmpb.Status_Component{
+			Name:      component.Name,
+			State:     component.State,
+			LatencyMs: component.LatencyMs,
+			Required:  component.Required,
+		}
+	}
+	return &ret
+}
+
+// checkHealth runs every component check in parallel, each with its own timeout.
+func (svc *Service) checkHealth(ctx context.Context) *sgtmpb.Status_Response {
+	checks := svc.healthChecks()
+	components := make([]*sgtmpb.Status_Component, len(checks))
+	var wg sync.WaitGroup
+	for idx, check := range checks {
+		wg.Add(1)
+		go func(idx int, check healthCheck) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+			defer cancel()
+			started := time.Now()
+			state, message := check.run(ctx)
+			components[idx] = &sgtmpb.Status_Component{
+				Name:      check.name,
+				State:     state,
+				Message:   message,
+				LatencyMs: time.Since(started).Milliseconds(),
+				Required:  check.required,
+			}
+		}(idx, check)
+	}
+	wg.Wait()
+
+	hostname, _ := os.Hostname()
+	ret := sgtmpb.Status_Response{
+		Uptime:         int32(time.Since(svc.StartedAt).Seconds()),
+		Hostname:       hostname,
+		EverythingIsOk: true,
+		Ready:          true,
+		Components:     components,
+	}
+	for _, component := range components {
+		switch component.State {
+		case sgtmpb.Status_OKState, sgtmpb.Status_DisabledState:
+		default:
+			ret.EverythingIsOk = false
+		}
+		if component.Required && component.State == sgtmpb.Status_DownState {
+			ret.Ready = false
+		}
+	}
+	return &ret
+}
+
+func (svc *Service) healthChecks() []healthCheck {
+	checks := []healthCheck{
+		{name: "db.read", required: true, run: svc.checkDBRead},
+		{name: "db.write", required: true, run: svc.checkDBWrite},
+		{name: "ipfs", run: svc.checkIPFS},
+		{name: "discord", run: svc.checkDiscord},
+		{name: "processing-worker", run: svc.checkProcessingWorker},
+		{name: "bpm-analyzer", run: svc.checkBPMAnalyzer},
+	}
+	for _, binary := range healthBinaries {
+		binary := binary
+		required := svc.opts.EnableProcessingWorker && processingRequiredBinaries[binary]
+		checks = append(checks, healthCheck{
+			name:     "bin." + binary,
+			required: required,
+			run: func(context.Context) (sgtmpb.Status_State, string) {
+				path, err := exec.LookPath(binary)
+				switch {
+				case err != nil && required:
+					return sgtmpb.Status_DownState, "not found in $PATH"
+				case err != nil:
+					return sgtmpb.Status_DegradedState, "not found in $PATH"
+				}
+				return sgtmpb.Status_OKState, path
+			},
+		})
+	}
+	return checks
+}
+
+func dbLatencyState(started time.Time) (sgtmpb.Status_State, string) {
+	if latency := time.Since(started); latency > slowDBThreshold {
+		return sgtmpb.Status_DegradedState, fmt.Sprintf("slow: %s", latency)
+	}
+	return sgtmpb.Status_OKState, ""
+}
+
+func (svc *Service) checkDBRead(ctx context.Context) (sgtmpb.Status_State, string) {
+	started := time.Now()
+	var one int
+	if err := svc.rodb().WithContext(ctx).Raw("SELECT 1").Scan(&one).Error; err != nil {
+		return sgtmpb.Status_DownState, err.Error()
+	}
+	return dbLatencyState(started)
+}
+
+// checkDBWrite takes the write lock with a no-op update, then rollbacks.
+func (svc *Service) checkDBWrite(ctx context.Context) (sgtmpb.Status_State, string) {
+	started := time.Now()
+	tx := svc.rwdb().WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return sgtmpb.Status_DownState, tx.Error.Error()
+	}
+	defer tx.Rollback()
+	err := tx.
+		Model(&sgtmpb.User{}).
+		Where("1 = 0").
+		UpdateColumn("updated_at", gorm.Expr("updated_at")).
+		Error
+	if err != nil {
+		return sgtmpb.Status_DownState, err.Error()
+	}
+	return dbLatencyState(started)
+}
+
+func (svc *Service) checkIPFS(ctx context.Context) (sgtmpb.Status_State, string) {
+	if err := svc.ipfs.ping(ctx); err != nil {
+		return sgtmpb.Status_DownState, err.Error()
+	}
+	return sgtmpb.Status_OKState, ""
+}
+
+func (svc *Service) checkDiscord(context.Context) (sgtmpb.Status_State, string) {
+	if !svc.opts.EnableDiscord {
+		return sgtmpb.Status_DisabledState, ""
+	}
+	session := svc.discord.getSession()
+	if session == nil {
+		return sgtmpb.Status_DownState, "not connected"
+	}
+	session.RLock()
+	ready := session.DataReady
+	session.RUnlock()
+	if !ready {
+		return sgtmpb.Status_DegradedState, "waiting for the gateway"
+	}
+	return sgtmpb.Status_OKState, ""
+}
+
+func (svc *Service) checkProcessingWorker(ctx context.Context) (sgtmpb.Status_State, string) {
+	if !svc.opts.EnableProcessingWorker {
+		return sgtmpb.Status_DisabledState, ""
+	}
+
+	var backlog int64
+	err := svc.outdatedTracks().
+		WithContext(ctx).
+		Count(&backlog).
+		Error
+	if err != nil {
+		return sgtmpb.Status_DegradedState, fmt.Sprintf("count backlog: %v", err)
+	}
+
+	stale := 3 * processingLoopInterval
+	lastLoopAt := atomic.LoadInt64(svc.processingWorker.lastLoopAt)
+	if lastLoopAt == 0 {
+		if time.Since(svc.StartedAt) > stale {
+			return sgtmpb.Status_DownState, fmt.Sprintf("no loop since startup, %d tracks to process", backlog)
+		}
+		return sgtmpb.Status_DegradedState, fmt.Sprintf("starting, %d tracks to process", backlog)
+	}
+	since := time.Since(time.Unix(0, lastLoopAt)).Round(time.Second)
+	message := fmt.Sprintf("last loop %s ago, %d tracks to process", since, backlog)
+	if since > stale {
+		return sgtmpb.Status_DegradedState, message
+	}
+	return sgtmpb.Status_OKState, message
+}
+
+// checkBPMAnalyzer reports the analyzer in use; it is degraded when none of its analyzers has its binaries.
+func (svc *Service) checkBPMAnalyzer(context.Context) (sgtmpb.Status_State, string) {
+	name := svc.bpm.Name()
+	problems := []string{}
+	for _, analyzer := range strings.Split(name, "|") {
+		missing := missingBinaries(bpmAnalyzerBinaries[analyzer])
+		if len(missing) == 0 {
+			return sgtmpb.Status_OKState, name
+		}
+		problems = append(problems, fmt.Sprintf("%s: %s not found in $PATH", analyzer, strings.Join(missing, ", ")))
+	}
+	return sgtmpb.Status_DegradedState, strings.Join(problems, "; ")
+}
+
+// healthzHandler is a liveness probe; it only checks that the HTTP server is responding.
+func (svc *Service) healthzHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintln(w, "ok")
+}
+
+// readyzHandler is a readiness probe; it fails with 503 when a required component is down.
+// It is public, so the details of the components are only available to the admins with the Status RPC.
+func (svc *Service) readyzHandler(w http.ResponseWriter, r *http.Request) {
+	status, err := svc.cachedHealth(r.Context())
+	if err != nil {
+		svc.errRender(w, r, err, http.StatusServiceUnavailable)
+		return
+	}
+	data, err := protojson.MarshalOptions{UseProtoNames: true, Indent: "  "}.Marshal(publicHealth(status))
+	if err != nil {
+		svc.errRender(w, r, err, http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if !status.Ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_, _ = w.Write(data)
+}
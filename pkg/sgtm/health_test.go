@@ -0,0 +1,132 @@
+package sgtm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+func TestHealth(t *testing.T) {
+	svc := TestingService(t)
+	client := testingClient(t, &svc)
+
+	componentStates := func(resp *sgtmpb.Status_Response) map[string]sgtmpb.Status_State {
+		states := map[string]sgtmpb.Status_State{}
+		for _, component := range resp.Components {
+			states[component.Name] = component.State
+		}
+		return states
+	}
+
+	ret, err := client.Status(context.Background(), &sgtmpb.Status_Request{})
+	require.NoError(t, err)
+	require.True(t, ret.Ready)
+	states := componentStates(ret)
+	require.Equal(t, sgtmpb.Status_OKState, states["db.read"])
+	require.Equal(t, sgtmpb.Status_OKState, states["db.write"])
+	require.Equal(t, sgtmpb.Status_DisabledState, states["discord"])
+	require.Equal(t, sgtmpb.Status_DisabledState, states["processing-worker"])
+	require.Contains(t, states, "bin.youtube-dl")
+	require.Contains(t, states, "bin.sonic-annotator")
+
+	// the details are only returned to the admins
+	require.Empty(t, ret.Hostname)
+	for _, component := range ret.Components {
+		require.Empty(t, component.Message, component.Name)
+	}
+	admin := sgtmpb.User{Email: "admin@example.com", Slug: "admin", Role: roleAdmin}
+	require.NoError(t, svc.rwdb().Create(&admin).Error)
+	ret, err = client.Status(testingAuthContext(t, &svc, admin.ID), &sgtmpb.Status_Request{})
+	require.NoError(t, err)
+	require.NotEmpty(t, ret.Hostname)
+
+	// processing worker
+	track := sgtmpb.Post{Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Public}
+	require.NoError(t, svc.rwdb().Create(&track).Error)
+	svc.opts.EnableProcessingWorker = true
+	state, message := svc.checkProcessingWorker(context.Background())
+	require.Equal(t, sgtmpb.Status_DegradedState, state)
+	require.Equal(t, "starting, 1 tracks to process", message)
+	svc.StartedAt = time.Now().Add(-time.Hour)
+	state, _ = svc.checkProcessingWorker(context.Background())
+	require.Equal(t, sgtmpb.Status_DownState, state)
+	atomic.StoreInt64(svc.processingWorker.lastLoopAt, time.Now().UnixNano())
+	state, _ = svc.checkProcessingWorker(context.Background())
+	require.Equal(t, sgtmpb.Status_OKState, state)
+
+	// probes
+	rec := httptest.NewRecorder()
+	svc.healthzHandler(rec, httptest.NewRequest("GET", "/healthz", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = httptest.NewRecorder()
+	svc.readyzHandler(rec, httptest.NewRequest("GET", "/readyz", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body struct {
+		Ready      bool `json:"ready"`
+		Components []struct {
+			Name string `json:"name"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.True(t, body.Ready)
+	require.Len(t, body.Components, len(ret.Components))
+	require.NotContains(t, rec.Body.String(), "hostname")
+	require.NotContains(t, rec.Body.String(), "message")
+
+	// the checks are not canceled with the request
+	svc.health.status = nil
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = svc.cachedHealth(canceled)
+	require.Equal(t, context.Canceled, err)
+	status, err := svc.cachedHealth(context.Background())
+	require.NoError(t, err)
+	require.Len(t, status.Components, len(ret.Components))
+
+	// a closed database is not ready
+	sqlDB, err := svc.rwdb().DB()
+	require.NoError(t, err)
+	require.NoError(t, sqlDB.Close())
+	ret = svc.checkHealth(context.Background())
+	require.False(t, ret.Ready)
+	require.False(t, ret.EverythingIsOk)
+}
+
+func TestHealthBinaries(t *testing.T) {
+	svc := TestingService(t)
+	testingEmptyPath(t)
+
+	components := func() map[string]*sgtmpb.Status_Component {
+		ret := map[string]*sgtmpb.Status_Component{}
+		for _, component := range svc.checkHealth(context.Background()).Components {
+			ret[component.Name] = component
+		}
+		return ret
+	}
+
+	// the missing binaries degrade the service
+	ret := components()
+	require.Equal(t, sgtmpb.Status_DegradedState, ret["bin.ffmpeg"].State)
+	require.False(t, ret["bin.ffmpeg"].Required)
+	require.Equal(t, sgtmpb.Status_DegradedState, ret["bpm-analyzer"].State)
+	require.Equal(t, "go: ffmpeg not found in $PATH", ret["bpm-analyzer"].Message)
+
+	// ffmpeg is required by the processing worker
+	svc.opts.EnableProcessingWorker = true
+	ret = components()
+	require.Equal(t, sgtmpb.Status_DownState, ret["bin.ffmpeg"].State)
+	require.True(t, ret["bin.ffmpeg"].Required)
+
+	testingBinary(t, "ffmpeg", "exit 0")
+	ret = components()
+	require.Equal(t, sgtmpb.Status_OKState, ret["bin.ffmpeg"].State)
+	require.Equal(t, sgtmpb.Status_OKState, ret["bpm-analyzer"].State)
+	require.Equal(t, bpmAnalyzerGo, ret["bpm-analyzer"].Message)
+}
@@ -2,7 +2,6 @@ package sgtm
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 	"net"
@@ -11,6 +10,8 @@ import (
 	"os"
 	"runtime/debug"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/cespare/hutil/apachelog"
 	"github.com/go-chi/chi"
@@ -20,7 +21,6 @@ import (
 	packr "github.com/gobuffalo/packr/v2"
 	"github.com/gogo/gateway"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -147,7 +147,7 @@ func (svc *Service) httpServer() (*http.Server, error) {
 	}).Handler)
 	r.Use(chilogger.Logger(svc.logger))
 	r.Use(middleware.Recoverer)
-	r.Use(middleware.Timeout(svc.opts.ServerRequestTimeout))
+	r.Use(timeoutExceptStreams(svc.opts.ServerRequestTimeout))
 	r.Use(middleware.RealIP)
 	r.Use(middleware.RequestID)
 	r.Use(render.SetContentType(render.ContentTypeJSON))
@@ -193,6 +193,10 @@ func (svc *Service) httpServer() (*http.Server, error) {
 		r.Mount("/", handler)
 	})
 
+	// probes
+	r.Get("/healthz", svc.healthzHandler)
+	r.Get("/readyz", svc.readyzHandler)
+
 	// fs
 	staticBox := packr.New("static", "../../static")
 	srcBox := packr.New("src", ".")
@@ -202,32 +206,56 @@ func (svc *Service) httpServer() (*http.Server, error) {
 
 	// dynamic pages
 	error404Page := svc.error404Page(srcBox)
-	{
-		svc.errRenderHTML = svc.errorPage(srcBox)
+	svc.errRenderHTML = svc.errorPage(srcBox)
+	r.Group(func(r chi.Router) {
+		r.Use(svc.httpAuthenticate)
+
+		// public
 		r.Get("/", svc.homePage(srcBox))
-		r.Get("/settings", svc.settingsPage(srcBox))
-		r.Post("/settings", svc.settingsPage(srcBox))
 		r.Get("/@{user_slug}", svc.profilePage(srcBox))
+		r.Get("/@{user_slug}/collaborators", svc.userCollaboratorsPage(srcBox))
 		r.Get("/open", svc.openPage(srcBox))
-		r.Get("/new", svc.newPage(srcBox))
-		r.Post("/new", svc.newPage(srcBox))
+		r.Get("/open/stream", svc.activityStreamSSE)
+		r.Get("/album/{album_id}", svc.albumPage(srcBox))
 		r.Get("/post/{post_slug}", svc.postPage(srcBox))
 		r.Post("/post/{post_slug}", svc.postPage(srcBox))
-		r.Get("/post/{post_slug}/edit", svc.postEditPage(srcBox))
-		r.Post("/post/{post_slug}/edit", svc.postEditPage(srcBox))
-		r.Get("/post/{post_slug}/maintenance", svc.postMaintenancePage(srcBox))
 		r.Get("/post/{post_slug}/download", svc.postDownloadPage(srcBox))
-		// FIXME: r.Use(ModeratorOnly) + r.Get("/moderator")
-		// FIXME: r.Use(AdminOnly) + r.Get("/admin")
+		r.Get("/post/{post_slug}/lineage", svc.postLineagePage(srcBox))
+		r.Get("/post/{post_slug}/waveform.json", svc.postWaveformPage(srcBox, "json"))
+		r.Get("/post/{post_slug}/waveform.png", svc.postWaveformPage(srcBox, "png"))
+		r.Get("/post/{post_slug}/waveform.svg", svc.postWaveformPage(srcBox, "svg"))
 		r.Get("/rss.xml", svc.rssPage(srcBox))
-	}
+
+		// users
+		r.Group(func(r chi.Router) {
+			r.Use(svc.httpAccess(sessionUserAccess))
+			r.Get("/settings", svc.settingsPage(srcBox))
+			r.Post("/settings", svc.settingsPage(srcBox))
+			r.Get("/new", svc.newPage(srcBox))
+			r.Post("/new", svc.newPage(srcBox))
+		})
+
+		// track owners and admins
+		r.Group(func(r chi.Router) {
+			r.Use(svc.httpAccess(trackOwnerAccess))
+			r.Get("/post/{post_slug}/edit", svc.postEditPage(srcBox))
+			r.Post("/post/{post_slug}/edit", svc.postEditPage(srcBox))
+			r.Get("/post/{post_slug}/maintenance", svc.postMaintenancePage(srcBox))
+		})
+
+		// admins
+		r.Group(func(r chi.Router) {
+			r.Use(svc.httpAccess(adminAccess))
+			r.Get("/admin/processing", svc.adminProcessingPage(srcBox))
+			r.Post("/admin/processing", svc.adminProcessingPage(srcBox))
+		})
+
+		// FIXME: r.Use(svc.httpAccess(accessRule{Level: accessRole, Role: roleModerator})) + r.Get("/moderator")
+	})
 
 	// special pages
 	{
-		sm := svc.generateSitemap()
-		r.Get("/sitemap.xml", func(w http.ResponseWriter, r *http.Request) {
-			_, _ = w.Write(sm.XMLContent())
-		})
+		r.Get("/sitemap.xml", svc.sitemapHandler)
 	}
 
 	// auth
@@ -285,6 +313,61 @@ func (svc *Service) httpServer() (*http.Server, error) {
 	}, nil
 }
 
+// streamingPaths are the long-lived endpoints that must not be interrupted by the request timeout.
+var streamingPaths = map[string]bool{
+	"/open/stream":           true,
+	"/api/v1/ActivityStream": true,
+}
+
+func timeoutExceptStreams(timeout time.Duration) func(http.Handler) http.Handler {
+	withTimeout := middleware.Timeout(timeout)
+	return func(next http.Handler) http.Handler {
+		nextWithTimeout := withTimeout(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if streamingPaths[r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+			nextWithTimeout.ServeHTTP(w, r)
+		})
+	}
+}
+
+// sitemapCacheTTL is the maximum age of the sitemap; the new users, posts and availability changes
+// are listed after at most this duration.
+const sitemapCacheTTL = 10 * time.Minute
+
+type sitemapCache struct {
+	mu      sync.Mutex
+	at      time.Time
+	content []byte
+}
+
+func newSitemapCache() *sitemapCache { return &sitemapCache{} }
+
+// invalidate regenerates the sitemap on the next request.
+func (c *sitemapCache) invalidate() {
+	c.mu.Lock()
+	c.at = time.Time{}
+	c.mu.Unlock()
+}
+
+// cachedSitemap returns the last generated sitemap if it is recent enough, or generates it again.
+func (svc *Service) cachedSitemap() []byte {
+	svc.sitemap.mu.Lock()
+	defer svc.sitemap.mu.Unlock()
+	if svc.sitemap.at.IsZero() || time.Since(svc.sitemap.at) > sitemapCacheTTL {
+		svc.sitemap.content = svc.generateSitemap().XMLContent()
+		svc.sitemap.at = time.Now()
+	}
+	return svc.sitemap.content
+}
+
+func (svc *Service) sitemapHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	_, _ = w.Write(svc.cachedSitemap())
+}
+
 func (svc *Service) generateSitemap() *stm.Sitemap {
 	sm := stm.NewSitemap(1)
 	sm.SetDefaultHost("https://sgtm.club")
@@ -320,11 +403,12 @@ func (svc *Service) generateSitemap() *stm.Sitemap {
 	// posts
 	{
 		var posts []*sgtmpb.Post
-		err := svc.rodb().
+		query := svc.rodb().
 			Where(sgtmpb.Post{
 				Visibility: sgtmpb.Visibility_Public,
 				Kind:       sgtmpb.Post_TrackKind,
-			}).
+			})
+		err := whereAvailable(query).
 			Find(&posts).
 			Error
 		if err != nil {
@@ -341,15 +425,7 @@ func (svc *Service) generateSitemap() *stm.Sitemap {
 	return sm
 }
 
-func (svc *Service) AuthFuncOverride(ctx context.Context, path string) (context.Context, error) {
-	// accept everything
-	return ctx, nil
-}
-
 func (svc *Service) grpcServer() *grpc.Server {
-	authFunc := func(context.Context) (context.Context, error) {
-		return nil, errors.New("auth: dummy function, see AuthFuncOverride")
-	}
 	recoveryOpts := []grpc_recovery.Option{}
 	if svc.logger.Check(zap.DebugLevel, "") != nil {
 		recoveryOpts = append(recoveryOpts, grpc_recovery.WithRecoveryHandlerContext(func(ctx context.Context, p interface{}) error {
@@ -380,13 +456,13 @@ func (svc *Service) grpcServer() *grpc.Server {
 	serverStreamOpts := []grpc.StreamServerInterceptor{grpc_recovery.StreamServerInterceptor(recoveryOpts...)}
 	serverUnaryOpts := []grpc.UnaryServerInterceptor{grpc_recovery.UnaryServerInterceptor(recoveryOpts...)}
 	serverStreamOpts = append(serverStreamOpts,
-		grpc_auth.StreamServerInterceptor(authFunc),
+		svc.streamAuthInterceptor,
 		// grpc_ctxtags.StreamServerInterceptor(),
 		grpc_zap.StreamServerInterceptor(svc.logger),
 	)
 	serverUnaryOpts = append(
 		serverUnaryOpts,
-		grpc_auth.UnaryServerInterceptor(authFunc),
+		svc.unaryAuthInterceptor,
 		// grpc_ctxtags.UnaryServerInterceptor(),
 		grpc_zap.UnaryServerInterceptor(svc.logger),
 	)
@@ -1,6 +1,7 @@
 package sgtm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -21,6 +22,20 @@ func (i *ipfsWrapper) add(reader io.Reader) (string, error) {
 	return ipfsAdd(i.api, reader)
 }
 
+// ping checks that the IPFS daemon is reachable.
+func (i *ipfsWrapper) ping(ctx context.Context) error {
+	args := []string{}
+	if i.api != "" {
+		args = append(args, "--api", i.api)
+	}
+	args = append(args, "id", "--format=<id>")
+	output, err := exec.CommandContext(ctx, "ipfs", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
 func ipfsCat(api string, cid string, size int64) ReadSeekerCloser {
 	return &ipfsReadSeeker{api: api, cid: cid, size: size, cmd: nil, pipe: nil, mu: &sync.Mutex{}}
 }
@@ -0,0 +1,351 @@
+package sgtm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+const (
+	jobDefaultMaxAttempts = 5
+	jobBaseBackoff        = 30 * time.Second
+	jobMaxBackoff         = 6 * time.Hour
+	jobBatchSize          = 100
+)
+
+// jobHandlers run a job; returning an error schedules a retry.
+// The handlers are not run in a transaction, so the slow work does not lock the database:
+// they save their result with a short transaction when it spans several writes.
+var jobHandlers = map[sgtmpb.Job_Kind]func(*Service, *sgtmpb.Job, *gorm.DB) error{
+	sgtmpb.Job_ProcessTrackKind:             (*Service).processTrackJob,
+	sgtmpb.Job_SyncSoundCloudKind:           (*Service).syncSoundCloudJob,
+	sgtmpb.Job_ExtractBPMKind:               (*Service).extractBPMJob,
+	sgtmpb.Job_DetectRelationshipsKind:      (*Service).detectRelationshipsJob,
+	sgtmpb.Job_CheckAvailabilityKind:        (*Service).checkAvailabilityJob,
+	sgtmpb.Job_ImportAudioURLKind:           (*Service).importAudioURLJob,
+	sgtmpb.Job_ImportSoundCloudCatalogKind:  (*Service).importSoundCloudCatalogJob,
+	sgtmpb.Job_NotifyPossibleDuplicatesKind: (*Service).notifyPossibleDuplicatesJob,
+}
+
+// enqueueJob schedules a job to run as soon as possible and wakes the processing worker.
+// If the same job is already pending, it is rescheduled instead of being duplicated.
+func (svc *Service) enqueueJob(kind sgtmpb.Job_Kind, postID int64, payload interface{}) (*sgtmpb.Job, error) {
+	job, err := newJob(kind, postID, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := &sgtmpb.Job{}
+	err = svc.rodb().
+		Where("kind = ? AND post_id = ? AND payload = ? AND state = ?", job.Kind, job.PostID, job.Payload, sgtmpb.Job_PendingState).
+		First(existing).
+		Error
+	switch {
+	case err == nil:
+		if err := svc.rwdb().Model(existing).Update("next_run_at", job.NextRunAt).Error; err != nil {
+			return nil, err
+		}
+		job = existing
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		if err := svc.rwdb().Create(job).Error; err != nil {
+			return nil, err
+		}
+		svc.logger.Debug("job enqueued", zap.Int64("id", job.ID), zap.Stringer("kind", job.Kind), zap.Int64("post", job.PostID))
+	default:
+		return nil, err
+	}
+
+	svc.wakeProcessingWorker()
+	return job, nil
+}
+
+// newJob returns an unsaved job to run as soon as possible; it is used to create a job in a transaction.
+func newJob(kind sgtmpb.Job_Kind, postID int64, payload interface{}) (*sgtmpb.Job, error) {
+	job := &sgtmpb.Job{
+		Kind:        kind,
+		State:       sgtmpb.Job_PendingState,
+		PostID:      postID,
+		MaxAttempts: jobDefaultMaxAttempts,
+		NextRunAt:   time.Now().UnixNano(),
+	}
+	if payload != nil {
+		raw, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("invalid job payload: %w", err)
+		}
+		job.Payload = string(raw)
+	}
+	return job, nil
+}
+
+// wakeProcessingWorker makes the processing worker loop immediately, without blocking.
+func (svc *Service) wakeProcessingWorker() {
+	select {
+	case svc.processingWorker.wake <- struct{}{}:
+	default:
+	}
+}
+
+// jobBackoff returns the delay before the next attempt of a job that failed n times.
+func jobBackoff(attempts int64) time.Duration {
+	backoff := jobBaseBackoff
+	for i := int64(1); i < attempts && backoff < jobMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > jobMaxBackoff {
+		backoff = jobMaxBackoff
+	}
+	return backoff
+}
+
+// resetRunningJobs reschedules the jobs interrupted by a previous shutdown.
+func (svc *Service) resetRunningJobs() error {
+	return svc.rwdb().
+		Model(&sgtmpb.Job{}).
+		Where(sgtmpb.Job{State: sgtmpb.Job_RunningState}).
+		Updates(map[string]interface{}{
+			"state":       sgtmpb.Job_PendingState,
+			"next_run_at": time.Now().UnixNano(),
+		}).
+		Error
+}
+
+// enqueueOutdatedTracks creates a job for the tracks with pending migrations.
+func (svc *Service) enqueueOutdatedTracks() error {
+	queued := svc.rodb().
+		Model(&sgtmpb.Job{}).
+		Select("post_id").
+		Where("kind = ? AND state IN ?", sgtmpb.Job_ProcessTrackKind, []sgtmpb.Job_State{sgtmpb.Job_PendingState, sgtmpb.Job_RunningState})
+	var ids []int64
+	err := svc.outdatedTracks().
+		Where("id NOT IN (?)", queued).
+		Pluck("id", &ids).
+		Error
+	if err != nil {
+		return fmt.Errorf("failed to fetch tracks that need to be processed: %w", err)
+	}
+	for _, id := range ids {
+		if _, err := svc.enqueueJob(sgtmpb.Job_ProcessTrackKind, id, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runDueJobs runs the pending jobs that are due, with the configured concurrency.
+// It returns the number of jobs that were claimed and run.
+func (svc *Service) runDueJobs() (int, error) {
+	var jobs []*sgtmpb.Job
+	err := svc.rodb().
+		Where(sgtmpb.Job{State: sgtmpb.Job_PendingState}).
+		Where("next_run_at <= ?", time.Now().UnixNano()).
+		Order("next_run_at").
+		Limit(jobBatchSize).
+		Find(&jobs).
+		Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch due jobs: %w", err)
+	}
+
+	concurrency := svc.opts.ProcessingWorkerConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	queue := make(chan *sgtmpb.Job)
+	var (
+		wg  sync.WaitGroup
+		ran int64
+	)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range queue {
+				if svc.runJob(job) {
+					atomic.AddInt64(&ran, 1)
+				}
+			}
+		}()
+	}
+enqueue:
+	for _, job := range jobs {
+		select {
+		case queue <- job:
+		case <-svc.ctx.Done():
+			break enqueue
+		}
+	}
+	close(queue)
+	wg.Wait()
+	return int(ran), nil
+}
+
+// nextJobDelay returns the duration until the next pending job is due, capped at max.
+func (svc *Service) nextJobDelay(max time.Duration) time.Duration {
+	var job sgtmpb.Job
+	err := svc.rodb().
+		Where(sgtmpb.Job{State: sgtmpb.Job_PendingState}).
+		Order("next_run_at").
+		First(&job).
+		Error
+	if err != nil {
+		return max
+	}
+	delay := time.Until(time.Unix(0, job.NextRunAt))
+	switch {
+	case delay < 0:
+		return 0
+	case delay > max:
+		return max
+	}
+	return delay
+}
+
+// runJob claims a job, runs its handler and saves the outcome.
+// It returns false if the job could not be claimed.
+func (svc *Service) runJob(job *sgtmpb.Job) bool {
+	logger := svc.logger.With(zap.Int64("job", job.ID), zap.Stringer("kind", job.Kind), zap.Int64("post", job.PostID))
+	claim := svc.rwdb().
+		Model(job).
+		Where("state = ?", sgtmpb.Job_PendingState).
+		Updates(map[string]interface{}{
+			"state":    sgtmpb.Job_RunningState,
+			"attempts": gorm.Expr("attempts + 1"),
+		})
+	if claim.Error != nil {
+		logger.Warn("failed to claim job", zap.Error(claim.Error))
+		return false
+	}
+	if claim.RowsAffected == 0 { // already claimed
+		return false
+	}
+	job.Attempts++
+
+	started := time.Now()
+	err := svc.runJobHandler(job)
+	fields := map[string]interface{}{}
+	switch {
+	case err == nil:
+		fields["state"] = sgtmpb.Job_DoneState
+		fields["last_error"] = ""
+		fields["finished_at"] = time.Now().UnixNano()
+		logger.Debug("job done", zap.Duration("duration", time.Since(started)))
+	case job.Attempts >= job.MaxAttempts:
+		fields["state"] = sgtmpb.Job_FailedState
+		fields["last_error"] = err.Error()
+		fields["finished_at"] = time.Now().UnixNano()
+		logger.Warn("job failed", zap.Int64("attempts", job.Attempts), zap.Error(err))
+	default:
+		backoff := jobBackoff(job.Attempts)
+		fields["state"] = sgtmpb.Job_PendingState
+		fields["last_error"] = err.Error()
+		fields["next_run_at"] = time.Now().Add(backoff).UnixNano()
+		logger.Debug("job will be retried", zap.Int64("attempts", job.Attempts), zap.Duration("backoff", backoff), zap.Error(err))
+	}
+	if err := svc.rwdb().Model(job).Updates(fields).Error; err != nil {
+		logger.Warn("failed to save job state", zap.Error(err))
+	}
+	return true
+}
+
+func (svc *Service) runJobHandler(job *sgtmpb.Job) (err error) {
+	handler, found := jobHandlers[job.Kind]
+	if !found {
+		return fmt.Errorf("unsupported job kind: %s", job.Kind)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	// a session, so the handlers can run several queries on it
+	return handler(svc, job, svc.rwdb().Session(&gorm.Session{}))
+}
+
+// jobPost loads the post targeted by a job.
+func jobPost(job *sgtmpb.Job, db *gorm.DB) (*sgtmpb.Post, error) {
+	var post sgtmpb.Post
+	if err := db.Preload("Author").First(&post, job.PostID).Error; err != nil {
+		return nil, fmt.Errorf("failed to load post %d: %w", job.PostID, err)
+	}
+	return &post, nil
+}
+
+func (svc *Service) syncSoundCloudJob(job *sgtmpb.Job, db *gorm.DB) error {
+	post, err := jobPost(job, db)
+	if err != nil {
+		return err
+	}
+	if !post.IsSoundCloud() {
+		return fmt.Errorf("post %d is not a SoundCloud track", post.ID)
+	}
+	changes, err := svc.syncPost(db, post)
+	if err != nil {
+		return err
+	}
+	svc.logger.Debug("post synced", zap.Int64("id", post.ID), zap.Any("changes", changes))
+	return nil
+}
+
+func (svc *Service) extractBPMJob(job *sgtmpb.Job, db *gorm.DB) error {
+	post, err := jobPost(job, db)
+	if err != nil {
+		return err
+	}
+	bpm, err := svc.computeTrackBPM(post)
+	if err != nil {
+		return err
+	}
+	svc.logger.Debug("BPM extracted", zap.Int64("post", post.ID), zap.Float64("bpm", bpm))
+	return db.Model(post).Update("bpm", bpm).Error
+}
+
+// detectRelationshipsJob recreates the "featuring" relationships from the title and the description.
+func (svc *Service) detectRelationshipsJob(job *sgtmpb.Job, db *gorm.DB) error {
+	// FIXME: support more relationship kinds
+	// FIXME: avoid delete/recreate associations if they didn't changed
+
+	post, err := jobPost(job, db)
+	if err != nil {
+		return err
+	}
+	body := post.SafeTitle() + "\n\n" + post.SafeDescription()
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(post).Association("RelationshipsAsSource").Clear(); err != nil {
+			return err
+		}
+		if err := tx.Model(post).Association("RelationshipsAsTarget").Clear(); err != nil {
+			return err
+		}
+
+		for _, match := range featRegex.FindAllStringSubmatch(body, -1) {
+			target := strings.ToLower(strings.TrimSpace(match[len(match)-1]))
+			var user sgtmpb.User
+			err := tx.
+				Where("LOWER(slug) = ?", target).
+				First(&user).
+				Error
+			if err != nil {
+				svc.logger.Debug("cannot find the featured artist in DB", zap.Error(err))
+				continue
+			}
+			if err := tx.Model(post).Association("RelationshipsAsSource").Append(&sgtmpb.Relationship{
+				SourcePostID: post.ID,
+				TargetUserID: user.ID,
+				Kind:         sgtmpb.Relationship_FeaturingUserKind,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
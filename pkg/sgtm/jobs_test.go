@@ -0,0 +1,121 @@
+package sgtm
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yanatan16/golang-soundcloud/soundcloud"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+func TestJobQueue(t *testing.T) {
+	svc := TestingService(t)
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	var tracks []*sgtmpb.Post
+	for _, title := range []string{"first", "second"} {
+		track := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Public, Title: title}
+		require.NoError(t, svc.rwdb().Create(&track).Error)
+		tracks = append(tracks, &track)
+	}
+	svc.processingWorker.trackMigrations = []trackMigration{
+		{Name: "lyrics", Run: func(_ *Service, post *sgtmpb.Post, _ *gorm.DB) (func(*gorm.DB) error, error) {
+			return func(tx *gorm.DB) error {
+				return tx.Model(post).Update("lyrics", "processed").Error
+			}, nil
+		}},
+	}
+	jobs := func(state sgtmpb.Job_State) []*sgtmpb.Job {
+		var jobs []*sgtmpb.Job
+		require.NoError(t, svc.rodb().Where(sgtmpb.Job{State: state}).Order("post_id").Find(&jobs).Error)
+		return jobs
+	}
+
+	// enqueue
+	require.NoError(t, svc.enqueueOutdatedTracks())
+	require.Len(t, jobs(sgtmpb.Job_PendingState), 2)
+	require.Len(t, svc.processingWorker.wake, 1)
+	require.NoError(t, svc.enqueueOutdatedTracks())
+	require.Len(t, jobs(sgtmpb.Job_PendingState), 2)
+	_, err := svc.enqueueJob(sgtmpb.Job_ProcessTrackKind, tracks[0].ID, nil)
+	require.NoError(t, err)
+	require.Len(t, jobs(sgtmpb.Job_PendingState), 2)
+
+	// a failing job does not roll back the others
+	failing, err := svc.enqueueJob(sgtmpb.Job_SyncSoundCloudKind, tracks[1].ID, nil) // not a SoundCloud track
+	require.NoError(t, err)
+	require.NoError(t, svc.processingLoop(0))
+	require.Len(t, jobs(sgtmpb.Job_DoneState), 2)
+	pending := jobs(sgtmpb.Job_PendingState)
+	require.Len(t, pending, 1)
+	require.Equal(t, failing.ID, pending[0].ID)
+	require.Equal(t, int64(1), pending[0].Attempts)
+	require.Contains(t, pending[0].LastError, "is not a SoundCloud track")
+	require.True(t, pending[0].NextRunAt > time.Now().UnixNano())
+	var posts []*sgtmpb.Post
+	require.NoError(t, svc.rodb().Order("id").Find(&posts).Error)
+	for _, post := range posts {
+		require.Equal(t, "processed", post.Lyrics)
+		require.Equal(t, int64(1), post.ProcessingVersion)
+	}
+
+	// retries until max attempts
+	for attempt := 2; attempt <= jobDefaultMaxAttempts; attempt++ {
+		require.NoError(t, svc.rwdb().Model(pending[0]).Update("next_run_at", time.Now().UnixNano()).Error)
+		ran, err := svc.runDueJobs()
+		require.NoError(t, err)
+		require.Equal(t, 1, ran)
+	}
+	failed := jobs(sgtmpb.Job_FailedState)
+	require.Len(t, failed, 1)
+	require.Equal(t, int64(jobDefaultMaxAttempts), failed[0].Attempts)
+	require.NotZero(t, failed[0].FinishedAt)
+
+	// interrupted jobs are rescheduled
+	require.NoError(t, svc.rwdb().Model(failed[0]).Update("state", sgtmpb.Job_RunningState).Error)
+	require.NoError(t, svc.resetRunningJobs())
+	require.Len(t, jobs(sgtmpb.Job_PendingState), 1)
+}
+
+func TestJobBackoff(t *testing.T) {
+	require.Equal(t, 30*time.Second, jobBackoff(1))
+	require.Equal(t, 60*time.Second, jobBackoff(2))
+	require.Equal(t, 4*time.Minute, jobBackoff(4))
+	require.Equal(t, jobMaxBackoff, jobBackoff(100))
+}
+
+func TestSyncSoundCloudJob(t *testing.T) {
+	svc := TestingService(t)
+	svc.processingWorker.trackMigrations = nil
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tracks/42.json" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(soundcloud.Track{Id: 42, Title: "new title", PermalinkUrl: "https://soundcloud.com/author/renamed", KeySignature: "Am"})
+	}))
+	defer server.Close()
+	svc.soundcloud = NewSoundCloudClient("test", server.URL)
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	post := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Provider: sgtmpb.Provider_SoundCloud, SoundCloudID: 42, ProviderTitle: "old title"}
+	require.NoError(t, svc.rwdb().Create(&post).Error)
+
+	job, err := svc.enqueueJob(sgtmpb.Job_SyncSoundCloudKind, post.ID, nil)
+	require.NoError(t, err)
+	require.NoError(t, svc.processingLoop(0))
+	require.NoError(t, svc.rodb().First(job, job.ID).Error)
+	require.Equal(t, sgtmpb.Job_DoneState, job.State, job.LastError)
+	var synced sgtmpb.Post
+	require.NoError(t, svc.rodb().First(&synced, post.ID).Error)
+	require.Equal(t, "new title", synced.ProviderTitle)
+	require.Equal(t, "https://soundcloud.com/author/renamed", synced.URL)
+	require.Equal(t, "Am", synced.KeySignature)
+}
@@ -0,0 +1,203 @@
+package sgtm
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// listCursor points to the last item of a page; the next page starts right after it.
+type listCursor struct {
+	SortKey int64 // sort_date for posts, unused for users
+	ID      int64
+}
+
+func (c listCursor) encode() string {
+	raw := fmt.Sprintf("%d:%d", c.SortKey, c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeListCursor(input string) (*listCursor, error) {
+	if input == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(input)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidCursor, err)
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("%w: %q", errInvalidCursor, input)
+	}
+	var cursor listCursor
+	if cursor.SortKey, err = strconv.ParseInt(parts[0], 10, 64); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidCursor, err)
+	}
+	if cursor.ID, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidCursor, err)
+	}
+	return &cursor, nil
+}
+
+func pageSize(limit int32) int {
+	switch {
+	case limit <= 0:
+		return defaultPageSize
+	case limit > maxPageSize:
+		return maxPageSize
+	default:
+		return int(limit)
+	}
+}
+
+// postListQuery returns a query selecting one page (plus one extra item used to
+// detect the next page) of public tracks matching the request's filters.
+func (svc *Service) postListQuery(req *sgtmpb.PostList_Request) (*gorm.DB, error) {
+	cursor, err := decodeListCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	query := svc.rodb().
+		Model(&sgtmpb.Post{}).
+		Where(sgtmpb.Post{
+			Kind:       sgtmpb.Post_TrackKind,
+			Visibility: sgtmpb.Visibility_Public,
+		})
+
+	// filters
+	if req.GetAuthorID() != 0 {
+		query = query.Where("author_id = ?", req.GetAuthorID())
+	}
+	if req.GetAuthorSlug() != "" {
+		authors := svc.rodb().
+			Model(&sgtmpb.User{}).
+			Select("id").
+			Where(sgtmpb.User{Slug: req.GetAuthorSlug()})
+		query = query.Where("author_id IN (?)", authors)
+	}
+	for _, tag := range req.GetTags() {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		// tags are stored as a comma separated list, i.e., "Electronic, chill"
+		query = query.Where(`(',' || REPLACE(LOWER(tags), ', ', ',') || ',') LIKE ? ESCAPE '\'`, "%,"+escapeLike(tag)+",%")
+	}
+	if req.GetProvider() != sgtmpb.Provider_UnknownProvider {
+		query = query.Where("provider = ?", req.GetProvider())
+	}
+	if req.GetBPMMin() > 0 {
+		query = query.Where("bpm >= ?", req.GetBPMMin())
+	}
+	if req.GetBPMMax() > 0 {
+		query = query.Where("bpm <= ?", req.GetBPMMax())
+	}
+	if req.GetKeySignature() != "" {
+		// the key set on the provider, or detected by the analysis
+		query = query.Where("(LOWER(key_signature) = LOWER(?) OR LOWER(detected_key) = LOWER(?))", req.GetKeySignature(), req.GetKeySignature())
+	}
+	if req.GetLoudnessMin() != 0 || req.GetLoudnessMax() != 0 || req.GetLoudnessRangeMin() != 0 || req.GetLoudnessRangeMax() != 0 {
+		// the tracks not analyzed yet have no loudness
+		query = query.Where("analysis_version > 0")
+	}
+	if req.GetLoudnessMin() != 0 {
+		query = query.Where("loudness >= ?", req.GetLoudnessMin())
+	}
+	if req.GetLoudnessMax() != 0 {
+		query = query.Where("loudness <= ?", req.GetLoudnessMax())
+	}
+	if req.GetLoudnessRangeMin() != 0 {
+		query = query.Where("loudness_range >= ?", req.GetLoudnessRangeMin())
+	}
+	if req.GetLoudnessRangeMax() != 0 {
+		query = query.Where("loudness_range <= ?", req.GetLoudnessRangeMax())
+	}
+	if req.GetSortDateAfter() != 0 {
+		query = query.Where("sort_date >= ?", req.GetSortDateAfter())
+	}
+	if req.GetSortDateBefore() != 0 {
+		query = query.Where("sort_date < ?", req.GetSortDateBefore())
+	}
+	if !req.GetIncludeUnavailable() {
+		query = whereAvailable(query)
+	}
+
+	// pagination
+	if cursor != nil {
+		query = query.Where("(sort_date < ? OR (sort_date = ? AND id < ?))", cursor.SortKey, cursor.SortKey, cursor.ID)
+	}
+	query = query.
+		Order("sort_date desc").
+		Order("id desc").
+		Limit(pageSize(req.GetLimit()) + 1)
+	return query, nil
+}
+
+// postList returns a page of public tracks with their authors and the cursor of the next page.
+func (svc *Service) postList(req *sgtmpb.PostList_Request) ([]*sgtmpb.Post, string, error) {
+	query, err := svc.postListQuery(req)
+	if err != nil {
+		return nil, "", err
+	}
+	var posts []*sgtmpb.Post
+	if err := query.Preload("Author").Find(&posts).Error; err != nil {
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if size := pageSize(req.GetLimit()); len(posts) > size {
+		posts = posts[:size]
+		last := posts[size-1]
+		nextCursor = listCursor{SortKey: last.SortDate, ID: last.ID}.encode()
+	}
+	return posts, nextCursor, nil
+}
+
+// userList returns a page of users, newest first, and the cursor of the next page.
+func (svc *Service) userList(req *sgtmpb.UserList_Request) ([]*sgtmpb.User, string, error) {
+	cursor, err := decodeListCursor(req.GetCursor())
+	if err != nil {
+		return nil, "", err
+	}
+	size := pageSize(req.GetLimit())
+
+	// IDs are snowflakes, so they are sorted by creation date
+	query := svc.rodb().
+		Model(&sgtmpb.User{}).
+		Order("id desc").
+		Limit(size + 1)
+	if cursor != nil {
+		query = query.Where("id < ?", cursor.ID)
+	}
+	var users []*sgtmpb.User
+	if err := query.Find(&users).Error; err != nil {
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if len(users) > size {
+		users = users[:size]
+		nextCursor = listCursor{ID: users[size-1].ID}.encode()
+	}
+	return users, nextCursor, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// escapeLike escapes the wildcards of a LIKE pattern using '\' as escape character.
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
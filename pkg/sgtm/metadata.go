@@ -0,0 +1,765 @@
+package sgtm
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+const (
+	metadataMaxTagSize     = 32 << 20 // ignore larger tags and MP4 'moov' atoms
+	metadataMaxPictureSize = 8 << 20
+)
+
+// embeddedMetadata is the metadata found in the tags of an audio file.
+type embeddedMetadata struct {
+	Format          string  `json:"format"` // id3v2, flac, vorbis, mp4
+	Title           string  `json:"title,omitempty"`
+	Artist          string  `json:"artist,omitempty"`
+	Genre           string  `json:"genre,omitempty"`
+	BPM             float64 `json:"bpm,omitempty"`
+	Key             string  `json:"key,omitempty"`
+	ISRC            string  `json:"isrc,omitempty"`
+	Lyrics          string  `json:"lyrics,omitempty"`
+	Picture         []byte  `json:"-"`
+	PictureMIMEType string  `json:"picture_mime_type,omitempty"`
+	pictureType     int     // front cover (3) is preferred
+}
+
+// metadataMigration prefills the uploaded tracks with the metadata embedded in the file.
+func (svc *Service) metadataMigration(post *sgtmpb.Post, _ *gorm.DB) (func(*gorm.DB) error, error) {
+	if !post.IsIPFS() {
+		return nil, nil
+	}
+	reader, err := svc.streamTrack(post)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	metadata, err := readEmbeddedMetadata(reader)
+	if err != nil {
+		return nil, fmt.Errorf("read metadata: %w", err)
+	}
+	if metadata == nil {
+		return nil, nil
+	}
+
+	artworkCID := ""
+	if len(metadata.Picture) > 0 && post.ArtworkURL == "" {
+		artworkCID, err = svc.ipfs.add(bytes.NewReader(metadata.Picture))
+		if err != nil {
+			return nil, fmt.Errorf("ipfs add: %w", err)
+		}
+	}
+	updates := applyEmbeddedMetadata(post, metadata, artworkCID)
+	if len(updates) == 0 {
+		return nil, nil
+	}
+	svc.logger.Debug("embedded metadata", zap.Int64("post", post.ID), zap.Any("updates", updates))
+	return func(tx *gorm.DB) error {
+		return tx.Model(post).Updates(updates).Error
+	}, nil
+}
+
+// applyEmbeddedMetadata sets the empty fields of a post, except the ones edited by the author,
+// and returns the updated columns.
+func applyEmbeddedMetadata(post *sgtmpb.Post, metadata *embeddedMetadata, artworkCID string) map[string]interface{} {
+	updates := map[string]interface{}{}
+	setString := func(column string, field *string, value string) {
+		value = strings.TrimSpace(value)
+		if value == "" || *field != "" || post.IsEdited(column) {
+			return
+		}
+		*field = value
+		updates[column] = value
+	}
+
+	if title := strings.TrimSpace(metadata.Title); title != "" && title != post.ProviderTitle {
+		// the provider title is computed from the filename and cannot be edited by the author
+		post.ProviderTitle = title
+		updates["provider_title"] = title
+	}
+	setString("artist", &post.Artist, metadata.Artist)
+	setString("tags", &post.Tags, strings.ReplaceAll(metadata.Genre, ";", ","))
+	setString("key_signature", &post.KeySignature, metadata.Key)
+	setString("isrc", &post.ISRC, metadata.ISRC)
+	setString("lyrics", &post.Lyrics, metadata.Lyrics)
+	if artworkCID != "" {
+		setString("artwork_url", &post.ArtworkURL, "https://ipfs.io/ipfs/"+artworkCID)
+	}
+	if metadata.BPM > 0 && post.BPM == 0 && !post.IsEdited("bpm") {
+		post.BPM = metadata.BPM
+		updates["bpm"] = metadata.BPM
+	}
+	if post.ProviderMetadata == "" {
+		if encoded, err := json.Marshal(metadata); err == nil {
+			setString("provider_metadata", &post.ProviderMetadata, string(encoded))
+		}
+	}
+	return updates
+}
+
+// readEmbeddedMetadata detects the container and parses its tags.
+// It returns nil if the format is not supported or if the file has no tags.
+func readEmbeddedMetadata(r io.ReadSeeker) (*embeddedMetadata, error) {
+	var header [12]byte
+	n, err := io.ReadFull(r, header[:])
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	switch {
+	case n >= 3 && string(header[:3]) == "ID3":
+		return readID3v2(r)
+	case n >= 4 && string(header[:4]) == "fLaC":
+		return readFLAC(r)
+	case n >= 4 && string(header[:4]) == "OggS":
+		return readOgg(r)
+	case n >= 8 && string(header[4:8]) == "ftyp":
+		return readMP4(r)
+	case n >= 12 && string(header[:4]) == "RIFF" && string(header[8:12]) == "WAVE":
+		return readRIFFID3(r)
+	}
+	return nil, nil
+}
+
+func (m *embeddedMetadata) isEmpty() bool {
+	return m.Title == "" && m.Artist == "" && m.Genre == "" && m.BPM == 0 && m.Key == "" && m.ISRC == "" && m.Lyrics == "" && len(m.Picture) == 0
+}
+
+func (m *embeddedMetadata) orNil() *embeddedMetadata {
+	if m.isEmpty() {
+		return nil
+	}
+	return m
+}
+
+func (m *embeddedMetadata) setPicture(data []byte, mimeType string, pictureType int) {
+	if len(data) == 0 || len(data) > metadataMaxPictureSize {
+		return
+	}
+	// keep the first front cover, or else the first picture
+	if len(m.Picture) > 0 && (m.pictureType == 3 || pictureType != 3) {
+		return
+	}
+	if mimeType == "" || !strings.Contains(mimeType, "/") {
+		mimeType = sniffPictureMIMEType(data)
+	}
+	m.Picture, m.PictureMIMEType, m.pictureType = data, mimeType, pictureType
+}
+
+func sniffPictureMIMEType(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte("\xff\xd8\xff")):
+		return "image/jpeg"
+	case bytes.HasPrefix(data, []byte("\x89PNG")):
+		return "image/png"
+	case bytes.HasPrefix(data, []byte("GIF8")):
+		return "image/gif"
+	}
+	return "application/octet-stream"
+}
+
+func parseMetadataBPM(value string) float64 {
+	bpm, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || bpm <= 0 || bpm > 1000 {
+		return 0
+	}
+	return bpm
+}
+
+// ID3v2
+
+func readID3v2(r io.Reader) (*embeddedMetadata, error) {
+	var header [10]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+	version, flags := header[3], header[5]
+	if version < 2 || version > 4 {
+		return nil, fmt.Errorf("unsupported ID3v2.%d tag", version)
+	}
+	size := syncsafeInt(header[6:10])
+	if size > metadataMaxTagSize {
+		return nil, fmt.Errorf("ID3v2 tag too large: %d bytes", size)
+	}
+	tag := make([]byte, size)
+	if _, err := io.ReadFull(r, tag); err != nil {
+		return nil, fmt.Errorf("ID3v2 tag: %w", err)
+	}
+	if flags&0x80 != 0 && version < 4 {
+		tag = bytes.ReplaceAll(tag, []byte{0xff, 0x00}, []byte{0xff})
+	}
+	if flags&0x40 != 0 && version >= 3 { // extended header
+		if len(tag) < 4 {
+			return nil, nil
+		}
+		extSize := int(binary.BigEndian.Uint32(tag[:4]))
+		if version == 4 {
+			extSize = syncsafeInt(tag[:4])
+		} else {
+			extSize += 4
+		}
+		if extSize > len(tag) {
+			return nil, fmt.Errorf("invalid ID3v2 extended header")
+		}
+		tag = tag[extSize:]
+	}
+
+	metadata := embeddedMetadata{Format: "id3v2"}
+	idSize, headerSize := 4, 10
+	if version == 2 {
+		idSize, headerSize = 3, 6
+	}
+	for len(tag) >= headerSize && tag[0] != 0 {
+		id := string(tag[:idSize])
+		var frameSize int
+		var frameFlags uint16
+		switch version {
+		case 2:
+			frameSize = int(tag[3])<<16 | int(tag[4])<<8 | int(tag[5])
+		case 3:
+			frameSize = int(binary.BigEndian.Uint32(tag[4:8]))
+			frameFlags = binary.BigEndian.Uint16(tag[8:10])
+		case 4:
+			frameSize = syncsafeInt(tag[4:8])
+			frameFlags = binary.BigEndian.Uint16(tag[8:10])
+		}
+		if frameSize < 0 || headerSize+frameSize > len(tag) {
+			break
+		}
+		frame := tag[headerSize : headerSize+frameSize]
+		tag = tag[headerSize+frameSize:]
+
+		compressedOrEncrypted := (version == 3 && frameFlags&0x00c0 != 0) || (version == 4 && frameFlags&0x000c != 0)
+		if compressedOrEncrypted || len(frame) == 0 {
+			continue
+		}
+		if version == 4 && frameFlags&0x0001 != 0 { // data length indicator
+			if len(frame) < 4 {
+				continue
+			}
+			frame = frame[4:]
+		}
+		if version == 4 && frameFlags&0x0002 != 0 { // frame unsynchronisation
+			frame = bytes.ReplaceAll(frame, []byte{0xff, 0x00}, []byte{0xff})
+		}
+
+		switch id {
+		case "TIT2", "TT2":
+			metadata.Title = id3Text(frame)
+		case "TPE1", "TP1":
+			metadata.Artist = id3Text(frame)
+		case "TCON", "TCO":
+			metadata.Genre = id3Genre(id3Text(frame))
+		case "TBPM", "TBP":
+			metadata.BPM = parseMetadataBPM(id3Text(frame))
+		case "TKEY", "TKE":
+			metadata.Key = id3Text(frame)
+		case "TSRC", "TRC":
+			metadata.ISRC = id3Text(frame)
+		case "USLT", "ULT":
+			// encoding, language, description, text
+			if len(frame) < 4 {
+				continue
+			}
+			_, text := splitID3String(frame[0], frame[4:])
+			if metadata.Lyrics == "" {
+				metadata.Lyrics = decodeID3String(frame[0], text)
+			}
+		case "APIC":
+			// encoding, MIME type, picture type, description, data
+			mimeType, rest := splitID3String(0, frame[1:])
+			if len(rest) < 1 {
+				continue
+			}
+			pictureType := int(rest[0])
+			_, data := splitID3String(frame[0], rest[1:])
+			metadata.setPicture(data, strings.ToLower(decodeID3String(0, mimeType)), pictureType)
+		case "PIC":
+			// encoding, image format, picture type, description, data
+			if len(frame) < 5 {
+				continue
+			}
+			mimeType := "image/" + strings.ToLower(string(frame[1:4]))
+			if mimeType == "image/jpg" {
+				mimeType = "image/jpeg"
+			}
+			_, data := splitID3String(frame[0], frame[5:])
+			metadata.setPicture(data, mimeType, int(frame[4]))
+		}
+	}
+	return metadata.orNil(), nil
+}
+
+func syncsafeInt(b []byte) int {
+	return int(b[0]&0x7f)<<21 | int(b[1]&0x7f)<<14 | int(b[2]&0x7f)<<7 | int(b[3]&0x7f)
+}
+
+// id3Text decodes a text frame, keeping the first value of multi-valued frames.
+func id3Text(frame []byte) string {
+	value, _ := splitID3String(frame[0], frame[1:])
+	return strings.TrimSpace(decodeID3String(frame[0], value))
+}
+
+// splitID3String returns the bytes before and after the first terminator for the encoding.
+func splitID3String(encoding byte, b []byte) ([]byte, []byte) {
+	if encoding == 1 || encoding == 2 { // UTF-16
+		for i := 0; i+1 < len(b); i += 2 {
+			if b[i] == 0 && b[i+1] == 0 {
+				return b[:i], b[i+2:]
+			}
+		}
+		return b, nil
+	}
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		return b[:i], b[i+1:]
+	}
+	return b, nil
+}
+
+func decodeID3String(encoding byte, b []byte) string {
+	switch encoding {
+	case 0: // ISO-8859-1
+		runes := make([]rune, len(b))
+		for i, c := range b {
+			runes[i] = rune(c)
+		}
+		return string(runes)
+	case 1, 2: // UTF-16 with BOM, UTF-16BE
+		bigEndian := encoding == 2
+		if len(b) >= 2 && b[0] == 0xff && b[1] == 0xfe {
+			bigEndian, b = false, b[2:]
+		} else if len(b) >= 2 && b[0] == 0xfe && b[1] == 0xff {
+			bigEndian, b = true, b[2:]
+		}
+		units := make([]uint16, len(b)/2)
+		for i := range units {
+			if bigEndian {
+				units[i] = binary.BigEndian.Uint16(b[i*2:])
+			} else {
+				units[i] = binary.LittleEndian.Uint16(b[i*2:])
+			}
+		}
+		return string(utf16.Decode(units))
+	default: // UTF-8
+		return string(b)
+	}
+}
+
+// id3Genre resolves the ID3v1 genre references, i.e., "(17)", "(17)Rock" or "17".
+func id3Genre(value string) string {
+	if strings.HasPrefix(value, "(") {
+		end := strings.Index(value, ")")
+		if end > 0 {
+			if rest := strings.TrimSpace(value[end+1:]); rest != "" {
+				return rest
+			}
+			value = value[1:end]
+		}
+	}
+	if idx, err := strconv.Atoi(value); err == nil {
+		if idx >= 0 && idx < len(id3v1Genres) {
+			return id3v1Genres[idx]
+		}
+		return ""
+	}
+	return value
+}
+
+var id3v1Genres = []string{
+	"Blues", "Classic Rock", "Country", "Dance", "Disco", "Funk", "Grunge", "Hip-Hop", "Jazz", "Metal",
+	"New Age", "Oldies", "Other", "Pop", "R&B", "Rap", "Reggae", "Rock", "Techno", "Industrial",
+	"Alternative", "Ska", "Death Metal", "Pranks", "Soundtrack", "Euro-Techno", "Ambient", "Trip-Hop", "Vocal", "Jazz+Funk",
+	"Fusion", "Trance", "Classical", "Instrumental", "Acid", "House", "Game", "Sound Clip", "Gospel", "Noise",
+	"AlternRock", "Bass", "Soul", "Punk", "Space", "Meditative", "Instrumental Pop", "Instrumental Rock", "Ethnic", "Gothic",
+	"Darkwave", "Techno-Industrial", "Electronic", "Pop-Folk", "Eurodance", "Dream", "Southern Rock", "Comedy", "Cult", "Gangsta",
+	"Top 40", "Christian Rap", "Pop/Funk", "Jungle", "Native American", "Cabaret", "New Wave", "Psychadelic", "Rave", "Showtunes",
+	"Trailer", "Lo-Fi", "Tribal", "Acid Punk", "Acid Jazz", "Polka", "Retro", "Musical", "Rock & Roll", "Hard Rock",
+}
+
+// readRIFFID3 reads the ID3v2 tag stored in the 'id3 ' chunk of a WAV file.
+func readRIFFID3(r io.ReadSeeker) (*embeddedMetadata, error) {
+	if _, err := r.Seek(12, io.SeekStart); err != nil {
+		return nil, err
+	}
+	for {
+		var header [8]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil, nil // no tag
+		}
+		size := int64(binary.LittleEndian.Uint32(header[4:8]))
+		switch string(header[:4]) {
+		case "id3 ", "ID3 ":
+			return readID3v2(io.LimitReader(r, size))
+		}
+		if _, err := r.Seek(size+size%2, io.SeekCurrent); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// FLAC and Ogg
+
+func readFLAC(r io.Reader) (*embeddedMetadata, error) {
+	if _, err := io.CopyN(ioutil.Discard, r, 4); err != nil {
+		return nil, err
+	}
+	metadata := embeddedMetadata{Format: "flac"}
+	for {
+		var header [4]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil, fmt.Errorf("FLAC metadata block: %w", err)
+		}
+		last := header[0]&0x80 != 0
+		blockType := header[0] & 0x7f
+		size := int64(header[1])<<16 | int64(header[2])<<8 | int64(header[3])
+		switch blockType {
+		case 4, 6: // VORBIS_COMMENT, PICTURE
+			block := make([]byte, size)
+			if _, err := io.ReadFull(r, block); err != nil {
+				return nil, fmt.Errorf("FLAC metadata block: %w", err)
+			}
+			if blockType == 4 {
+				parseVorbisComment(&metadata, block)
+			} else {
+				parseFLACPicture(&metadata, block)
+			}
+		default:
+			if _, err := io.CopyN(ioutil.Discard, r, size); err != nil {
+				return nil, fmt.Errorf("FLAC metadata block: %w", err)
+			}
+		}
+		if last {
+			return metadata.orNil(), nil
+		}
+	}
+}
+
+// readOgg reads the comment header of an Ogg Vorbis or Opus stream.
+func readOgg(r io.Reader) (*embeddedMetadata, error) {
+	var packet []byte
+	packets := 0
+	for total := 0; total < metadataMaxTagSize; {
+		var header [27]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil, fmt.Errorf("Ogg page: %w", err)
+		}
+		if string(header[:4]) != "OggS" {
+			return nil, fmt.Errorf("invalid Ogg page")
+		}
+		segments := make([]byte, header[26])
+		if _, err := io.ReadFull(r, segments); err != nil {
+			return nil, fmt.Errorf("Ogg page: %w", err)
+		}
+		for _, segment := range segments {
+			data := make([]byte, segment)
+			if _, err := io.ReadFull(r, data); err != nil {
+				return nil, fmt.Errorf("Ogg page: %w", err)
+			}
+			packet = append(packet, data...)
+			total += int(segment)
+			if segment == 255 { // the packet continues
+				continue
+			}
+			packets++
+			if packets == 2 { // the comment header is the second packet
+				metadata := embeddedMetadata{Format: "vorbis"}
+				switch {
+				case bytes.HasPrefix(packet, []byte("\x03vorbis")):
+					parseVorbisComment(&metadata, packet[7:])
+				case bytes.HasPrefix(packet, []byte("OpusTags")):
+					parseVorbisComment(&metadata, packet[8:])
+				}
+				return metadata.orNil(), nil
+			}
+			packet = nil
+		}
+	}
+	return nil, fmt.Errorf("Ogg comment header too large")
+}
+
+func parseVorbisComment(metadata *embeddedMetadata, b []byte) {
+	read := func() ([]byte, bool) {
+		if len(b) < 4 {
+			return nil, false
+		}
+		size := binary.LittleEndian.Uint32(b[:4])
+		if uint64(size) > uint64(len(b)-4) {
+			return nil, false
+		}
+		value := b[4 : 4+size]
+		b = b[4+size:]
+		return value, true
+	}
+	if _, ok := read(); !ok { // vendor
+		return
+	}
+	if len(b) < 4 {
+		return
+	}
+	count := binary.LittleEndian.Uint32(b[:4])
+	b = b[4:]
+	for i := uint32(0); i < count; i++ {
+		comment, ok := read()
+		if !ok {
+			return
+		}
+		parts := strings.SplitN(string(comment), "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		value := strings.TrimSpace(parts[1])
+		setIfEmpty := func(field *string) {
+			if *field == "" {
+				*field = value
+			}
+		}
+		switch strings.ToUpper(parts[0]) {
+		case "TITLE":
+			setIfEmpty(&metadata.Title)
+		case "ARTIST":
+			setIfEmpty(&metadata.Artist)
+		case "GENRE":
+			setIfEmpty(&metadata.Genre)
+		case "BPM", "TEMPO":
+			if metadata.BPM == 0 {
+				metadata.BPM = parseMetadataBPM(value)
+			}
+		case "INITIALKEY", "KEY":
+			setIfEmpty(&metadata.Key)
+		case "ISRC":
+			setIfEmpty(&metadata.ISRC)
+		case "LYRICS", "UNSYNCEDLYRICS":
+			setIfEmpty(&metadata.Lyrics)
+		case "METADATA_BLOCK_PICTURE":
+			if picture, err := base64.StdEncoding.DecodeString(value); err == nil {
+				parseFLACPicture(metadata, picture)
+			}
+		}
+	}
+}
+
+func parseFLACPicture(metadata *embeddedMetadata, b []byte) {
+	readUint32 := func() (uint32, bool) {
+		if len(b) < 4 {
+			return 0, false
+		}
+		value := binary.BigEndian.Uint32(b[:4])
+		b = b[4:]
+		return value, true
+	}
+	readBytes := func() ([]byte, bool) {
+		size, ok := readUint32()
+		if !ok || uint64(size) > uint64(len(b)) {
+			return nil, false
+		}
+		value := b[:size]
+		b = b[size:]
+		return value, true
+	}
+	pictureType, ok := readUint32()
+	if !ok {
+		return
+	}
+	mimeType, ok := readBytes()
+	if !ok {
+		return
+	}
+	if _, ok := readBytes(); !ok { // description
+		return
+	}
+	if len(b) < 16 { // width, height, depth, colors
+		return
+	}
+	b = b[16:]
+	data, ok := readBytes()
+	if !ok {
+		return
+	}
+	metadata.setPicture(data, strings.ToLower(string(mimeType)), int(pictureType))
+}
+
+// MP4
+
+func readMP4(r io.ReadSeeker) (*embeddedMetadata, error) {
+	moov, err := findMP4TopLevelAtom(r, "moov")
+	if err != nil || moov == nil {
+		return nil, err
+	}
+	ilst := findMP4Atom(moov, "udta", "meta", "ilst")
+	if ilst == nil {
+		ilst = findMP4Atom(moov, "meta", "ilst")
+	}
+	if ilst == nil {
+		return nil, nil
+	}
+
+	metadata := embeddedMetadata{Format: "mp4"}
+	for _, item := range mp4Atoms(ilst) {
+		name := item.name
+		if name == "----" { // freeform atom: mean, name, data
+			for _, child := range mp4Atoms(item.data) {
+				if child.name == "name" && len(child.data) > 4 {
+					name = strings.ToUpper(string(child.data[4:]))
+				}
+			}
+		}
+		var value []byte
+		var dataType uint32
+		for _, child := range mp4Atoms(item.data) {
+			if child.name == "data" && len(child.data) >= 8 {
+				dataType = binary.BigEndian.Uint32(child.data[:4]) & 0xffffff
+				value = child.data[8:]
+				break
+			}
+		}
+		if value == nil {
+			continue
+		}
+		text := strings.TrimSpace(string(value))
+		switch name {
+		case "\xa9nam":
+			metadata.Title = text
+		case "\xa9ART":
+			metadata.Artist = text
+		case "\xa9gen":
+			metadata.Genre = text
+		case "gnre":
+			if len(value) >= 2 {
+				metadata.Genre = id3Genre(strconv.Itoa(int(binary.BigEndian.Uint16(value)) - 1))
+			}
+		case "tmpo":
+			if len(value) >= 2 {
+				metadata.BPM = parseMetadataBPM(strconv.Itoa(int(binary.BigEndian.Uint16(value))))
+			}
+		case "\xa9lyr":
+			metadata.Lyrics = text
+		case "covr":
+			mimeType := ""
+			switch dataType {
+			case 13:
+				mimeType = "image/jpeg"
+			case 14:
+				mimeType = "image/png"
+			}
+			metadata.setPicture(value, mimeType, 3)
+		case "ISRC":
+			metadata.ISRC = text
+		case "INITIALKEY", "KEY":
+			metadata.Key = text
+		case "BPM":
+			metadata.BPM = parseMetadataBPM(text)
+		}
+	}
+	return metadata.orNil(), nil
+}
+
+// findMP4TopLevelAtom seeks through the top-level atoms and returns the content of the first one with the name.
+func findMP4TopLevelAtom(r io.ReadSeeker, name string) ([]byte, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	for {
+		var header [8]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil, nil // not found
+		}
+		size := int64(binary.BigEndian.Uint32(header[:4]))
+		headerSize := int64(8)
+		switch size {
+		case 0: // until the end of the file
+			if string(header[4:8]) != name {
+				return nil, nil
+			}
+			return readAtMost(r, metadataMaxTagSize)
+		case 1: // 64-bit size
+			var large [8]byte
+			if _, err := io.ReadFull(r, large[:]); err != nil {
+				return nil, err
+			}
+			size = int64(binary.BigEndian.Uint64(large[:]))
+			headerSize = 16
+		}
+		if size < headerSize {
+			return nil, fmt.Errorf("invalid MP4 atom size: %d", size)
+		}
+		if string(header[4:8]) == name {
+			if size-headerSize > metadataMaxTagSize {
+				return nil, fmt.Errorf("MP4 %q atom too large: %d bytes", name, size)
+			}
+			data := make([]byte, size-headerSize)
+			if _, err := io.ReadFull(r, data); err != nil {
+				return nil, err
+			}
+			return data, nil
+		}
+		if _, err := r.Seek(size-headerSize, io.SeekCurrent); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func readAtMost(r io.Reader, limit int64) ([]byte, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("atom too large")
+	}
+	return data, nil
+}
+
+type mp4Atom struct {
+	name string
+	data []byte
+}
+
+// mp4Atoms splits a buffer into atoms, ignoring the trailing garbage.
+func mp4Atoms(b []byte) []mp4Atom {
+	atoms := []mp4Atom{}
+	for len(b) >= 8 {
+		size := int(binary.BigEndian.Uint32(b[:4]))
+		if size < 8 || size > len(b) {
+			break
+		}
+		atoms = append(atoms, mp4Atom{name: string(b[4:8]), data: b[8:size]})
+		b = b[size:]
+	}
+	return atoms
+}
+
+// findMP4Atom returns the content of the atom at the path; 'meta' atoms start with a version and flags.
+func findMP4Atom(b []byte, path ...string) []byte {
+	for _, name := range path {
+		var found []byte
+		for _, atom := range mp4Atoms(b) {
+			if atom.name == name {
+				found = atom.data
+				break
+			}
+		}
+		if found == nil {
+			return nil
+		}
+		if name == "meta" && len(found) >= 8 && string(found[4:8]) != "hdlr" {
+			found = found[4:] // version and flags, absent from the QuickTime 'meta' atoms
+		}
+		b = found
+	}
+	return b
+}
@@ -0,0 +1,254 @@
+package sgtm
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/require"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+var testingJPEG = []byte("\xff\xd8\xff\xe0fake jpeg")
+
+func testingID3Frame(id string, data []byte) []byte {
+	var frame bytes.Buffer
+	frame.WriteString(id)
+	_ = binary.Write(&frame, binary.BigEndian, uint32(len(data)))
+	frame.Write([]byte{0, 0})
+	frame.Write(data)
+	return frame.Bytes()
+}
+
+func testingUTF16(value string) []byte {
+	var buf bytes.Buffer
+	buf.Write([]byte{0xff, 0xfe})
+	for _, unit := range utf16.Encode([]rune(value)) {
+		_ = binary.Write(&buf, binary.LittleEndian, unit)
+	}
+	return buf.Bytes()
+}
+
+func testingID3v2Tag() []byte {
+	var frames bytes.Buffer
+	frames.Write(testingID3Frame("TIT2", append([]byte{1}, testingUTF16("Café")...)))
+	frames.Write(testingID3Frame("TPE1", []byte("\x03Artist\x00Featuring")))
+	frames.Write(testingID3Frame("TCON", []byte("\x00(17)")))
+	frames.Write(testingID3Frame("TBPM", []byte("\x00128")))
+	frames.Write(testingID3Frame("TKEY", []byte("\x00Am")))
+	frames.Write(testingID3Frame("TSRC", []byte("\x00FRXXX2000001")))
+	frames.Write(testingID3Frame("USLT", []byte("\x03engdesc\x00la la la")))
+	frames.Write(testingID3Frame("APIC", append([]byte("\x00image/png\x00\x04back\x00"), "\x89PNG"...)))
+	frames.Write(testingID3Frame("APIC", append([]byte("\x00image/jpeg\x00\x03front\x00"), testingJPEG...)))
+	frames.Write(make([]byte, 16)) // padding
+
+	var tag bytes.Buffer
+	tag.WriteString("ID3\x03\x00\x00")
+	size := frames.Len()
+	tag.Write([]byte{byte(size >> 21 & 0x7f), byte(size >> 14 & 0x7f), byte(size >> 7 & 0x7f), byte(size & 0x7f)})
+	tag.Write(frames.Bytes())
+	return tag.Bytes()
+}
+
+func testingVorbisComment(comments ...string) []byte {
+	var buf bytes.Buffer
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(len("vendor")))
+	buf.WriteString("vendor")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(len(comments)))
+	for _, comment := range comments {
+		_ = binary.Write(&buf, binary.LittleEndian, uint32(len(comment)))
+		buf.WriteString(comment)
+	}
+	return buf.Bytes()
+}
+
+func testingFLACPicture(pictureType uint32, data []byte) []byte {
+	var buf bytes.Buffer
+	for _, field := range []interface{}{pictureType, uint32(len("image/jpeg")), []byte("image/jpeg"), uint32(0), [4]uint32{}, uint32(len(data)), data} {
+		_ = binary.Write(&buf, binary.BigEndian, field)
+	}
+	return buf.Bytes()
+}
+
+func testingMP4Atom(name string, children ...[]byte) []byte {
+	var buf bytes.Buffer
+	content := bytes.Join(children, nil)
+	_ = binary.Write(&buf, binary.BigEndian, uint32(8+len(content)))
+	buf.WriteString(name)
+	buf.Write(content)
+	return buf.Bytes()
+}
+
+func testingMP4Data(dataType uint32, value []byte) []byte {
+	var header bytes.Buffer
+	_ = binary.Write(&header, binary.BigEndian, dataType)
+	_ = binary.Write(&header, binary.BigEndian, uint32(0))
+	return testingMP4Atom("data", header.Bytes(), value)
+}
+
+func TestReadEmbeddedMetadata(t *testing.T) {
+	expected := &embeddedMetadata{
+		Format:          "id3v2",
+		Title:           "Café",
+		Artist:          "Artist",
+		Genre:           "Rock",
+		BPM:             128,
+		Key:             "Am",
+		ISRC:            "FRXXX2000001",
+		Lyrics:          "la la la",
+		Picture:         testingJPEG,
+		PictureMIMEType: "image/jpeg",
+		pictureType:     3,
+	}
+
+	t.Run("id3v2", func(t *testing.T) {
+		metadata, err := readEmbeddedMetadata(bytes.NewReader(append(testingID3v2Tag(), "\xff\xfbmp3 frames"...)))
+		require.NoError(t, err)
+		require.Equal(t, expected, metadata)
+	})
+
+	t.Run("wav", func(t *testing.T) {
+		var wav bytes.Buffer
+		tag := testingID3v2Tag()
+		wav.WriteString("RIFF\x00\x00\x00\x00WAVE")
+		wav.WriteString("data")
+		_ = binary.Write(&wav, binary.LittleEndian, uint32(3))
+		wav.Write([]byte{1, 2, 3, 0}) // odd chunks are padded
+		wav.WriteString("id3 ")
+		_ = binary.Write(&wav, binary.LittleEndian, uint32(len(tag)))
+		wav.Write(tag)
+		metadata, err := readEmbeddedMetadata(bytes.NewReader(wav.Bytes()))
+		require.NoError(t, err)
+		require.Equal(t, expected, metadata)
+	})
+
+	t.Run("flac", func(t *testing.T) {
+		var flac bytes.Buffer
+		flac.WriteString("fLaC")
+		block := func(blockType byte, data []byte) {
+			flac.Write([]byte{blockType, byte(len(data) >> 16), byte(len(data) >> 8), byte(len(data))})
+			flac.Write(data)
+		}
+		block(0, make([]byte, 34)) // STREAMINFO
+		block(4, testingVorbisComment("TITLE=Café", "artist=Artist", "GENRE=Rock", "BPM=128", "INITIALKEY=Am", "ISRC=FRXXX2000001", "UNSYNCEDLYRICS=la la la", "invalid"))
+		block(0x80|6, testingFLACPicture(3, testingJPEG))
+		flac.WriteString("audio frames")
+		metadata, err := readEmbeddedMetadata(bytes.NewReader(flac.Bytes()))
+		require.NoError(t, err)
+		flacExpected := *expected
+		flacExpected.Format = "flac"
+		require.Equal(t, &flacExpected, metadata)
+	})
+
+	t.Run("opus", func(t *testing.T) {
+		page := func(packets ...[]byte) []byte {
+			var segments, data bytes.Buffer
+			for _, packet := range packets {
+				for len(packet) >= 255 {
+					segments.WriteByte(255)
+					data.Write(packet[:255])
+					packet = packet[255:]
+				}
+				segments.WriteByte(byte(len(packet)))
+				data.Write(packet)
+			}
+			header := make([]byte, 27)
+			copy(header, "OggS")
+			header[26] = byte(segments.Len())
+			return bytes.Join([][]byte{header, segments.Bytes(), data.Bytes()}, nil)
+		}
+		picture := "METADATA_BLOCK_PICTURE=" + base64.StdEncoding.EncodeToString(testingFLACPicture(3, testingJPEG))
+		comment := append([]byte("OpusTags"), testingVorbisComment("TITLE=Café", picture, "LYRICS="+string(bytes.Repeat([]byte("la "), 200)))...)
+		ogg := append(page([]byte("OpusHead identification")), page(comment)...)
+		metadata, err := readEmbeddedMetadata(bytes.NewReader(ogg))
+		require.NoError(t, err)
+		require.Equal(t, "vorbis", metadata.Format)
+		require.Equal(t, "Café", metadata.Title)
+		require.Len(t, metadata.Lyrics, 599)
+		require.Equal(t, testingJPEG, metadata.Picture)
+	})
+
+	t.Run("mp4", func(t *testing.T) {
+		tmpo := []byte{0, 128}
+		ilst := testingMP4Atom("ilst",
+			testingMP4Atom("\xa9nam", testingMP4Data(1, []byte("Café"))),
+			testingMP4Atom("\xa9ART", testingMP4Data(1, []byte("Artist"))),
+			testingMP4Atom("gnre", testingMP4Data(0, []byte{0, 18})),
+			testingMP4Atom("tmpo", testingMP4Data(21, tmpo)),
+			testingMP4Atom("\xa9lyr", testingMP4Data(1, []byte("la la la"))),
+			testingMP4Atom("covr", testingMP4Data(13, testingJPEG)),
+			testingMP4Atom("----",
+				testingMP4Atom("mean", []byte("\x00\x00\x00\x00com.apple.iTunes")),
+				testingMP4Atom("name", []byte("\x00\x00\x00\x00ISRC")),
+				testingMP4Data(1, []byte("FRXXX2000001")),
+			),
+			testingMP4Atom("----",
+				testingMP4Atom("mean", []byte("\x00\x00\x00\x00com.apple.iTunes")),
+				testingMP4Atom("name", []byte("\x00\x00\x00\x00initialkey")),
+				testingMP4Data(1, []byte("Am")),
+			),
+		)
+		meta := testingMP4Atom("meta", []byte{0, 0, 0, 0}, testingMP4Atom("hdlr", make([]byte, 25)), ilst)
+		mp4 := bytes.Join([][]byte{
+			testingMP4Atom("ftyp", []byte("M4A \x00\x00\x00\x00")),
+			testingMP4Atom("mdat", make([]byte, 1024)),
+			testingMP4Atom("moov", testingMP4Atom("mvhd", make([]byte, 100)), testingMP4Atom("udta", meta)),
+		}, nil)
+		metadata, err := readEmbeddedMetadata(bytes.NewReader(mp4))
+		require.NoError(t, err)
+		mp4Expected := *expected
+		mp4Expected.Format = "mp4"
+		require.Equal(t, &mp4Expected, metadata)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		for _, data := range [][]byte{nil, []byte("\xff\xfbmp3 frames"), []byte("RIFF\x00\x00\x00\x00WAVEdata\x00\x00\x00\x00")} {
+			metadata, err := readEmbeddedMetadata(bytes.NewReader(data))
+			require.NoError(t, err)
+			require.Nil(t, metadata)
+		}
+		_, err := readEmbeddedMetadata(bytes.NewReader([]byte("ID3\x03\x00\x00\x00\x00\x10\x00truncated")))
+		require.Error(t, err)
+	})
+}
+
+func TestApplyEmbeddedMetadata(t *testing.T) {
+	metadata := &embeddedMetadata{Format: "id3v2", Title: "Title", Artist: "Artist", Genre: "Rock;Pop", BPM: 128, Lyrics: "la la la", Key: "Am"}
+	post := &sgtmpb.Post{ProviderTitle: "filename", Lyrics: "", KeySignature: "C", EditedFields: "title,lyrics"}
+	updates := applyEmbeddedMetadata(post, metadata, "artwork-cid")
+	require.Equal(t, map[string]interface{}{
+		"provider_title":    "Title",
+		"artist":            "Artist",
+		"tags":              "Rock,Pop",
+		"bpm":               128.0,
+		"artwork_url":       "https://ipfs.io/ipfs/artwork-cid",
+		"provider_metadata": `{"format":"id3v2","title":"Title","artist":"Artist","genre":"Rock;Pop","bpm":128,"key":"Am","lyrics":"la la la"}`,
+	}, updates)
+	require.Equal(t, "Artist", post.Artist)
+	require.Equal(t, "", post.Lyrics) // edited by the author
+	require.Equal(t, "C", post.KeySignature)
+	require.Empty(t, applyEmbeddedMetadata(post, metadata, ""))
+}
+
+func TestUpdatePostEditedFields(t *testing.T) {
+	svc := TestingService(t)
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	track := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Public, Title: "title"}
+	require.NoError(t, svc.rwdb().Create(&track).Error)
+	reload := func() *sgtmpb.Post {
+		var ret sgtmpb.Post
+		require.NoError(t, svc.rodb().First(&ret, track.ID).Error)
+		return &ret
+	}
+
+	// unchanged values are not marked as edited
+	require.NoError(t, svc.updatePost(reload(), map[string]interface{}{"title": "title", "lyrics": "new lyrics", "body": ""}))
+	require.Equal(t, "lyrics", reload().EditedFields)
+	require.NoError(t, svc.updatePost(reload(), map[string]interface{}{"title": "new title", "lyrics": ""}))
+	require.Equal(t, "lyrics,title", reload().EditedFields)
+	require.True(t, reload().IsEdited("lyrics"))
+	require.False(t, reload().IsEdited("body"))
+}
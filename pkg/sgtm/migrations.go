@@ -0,0 +1,240 @@
+package sgtm
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// migrationMaxAttempts is the number of automatic attempts before a failed migration needs to be replayed.
+const migrationMaxAttempts = 8
+
+// trackMigration is a processing step run once on every track.
+// Migrations must be idempotent; their result is stored in a MigrationRun record per track.
+type trackMigration struct {
+	// Name identifies the migration in the MigrationRun records; it must never be changed.
+	Name string
+	// Run does the slow work (downloads, decoding, analysis) outside of any transaction, and returns
+	// the function saving its result in a short transaction, or nil if there is nothing to save.
+	Run func(*Service, *sgtmpb.Post, *gorm.DB) (func(tx *gorm.DB) error, error)
+}
+
+// processTrackPayload is the payload of the ProcessTrack jobs.
+type processTrackPayload struct {
+	// Migration forces a single migration to run again, even if it was already done.
+	Migration string `json:"migration,omitempty"`
+}
+
+func (svc *Service) trackMigrationNames() []string {
+	names := make([]string, 0, len(svc.processingWorker.trackMigrations))
+	for _, migration := range svc.processingWorker.trackMigrations {
+		names = append(names, migration.Name)
+	}
+	return names
+}
+
+func (svc *Service) trackMigrationByName(name string) (trackMigration, bool) {
+	for _, migration := range svc.processingWorker.trackMigrations {
+		if migration.Name == name {
+			return migration, true
+		}
+	}
+	return trackMigration{}, false
+}
+
+// outdatedTracks returns a query on the tracks with a migration that is neither done,
+// nor failed and waiting for its next retry; the tracks with a pending import are skipped.
+func (svc *Service) outdatedTracks() *gorm.DB {
+	query := svc.rodb().
+		Model(&sgtmpb.Post{}).
+		Where(sgtmpb.Post{Kind: sgtmpb.Post_TrackKind}).
+		Where("NOT (provider = ? AND ip_fsc_id = ?)", sgtmpb.Provider_IPFS, "")
+	names := svc.trackMigrationNames()
+	if len(names) == 0 {
+		return query.Where("1 = 0")
+	}
+	done := svc.rodb().
+		Model(&sgtmpb.MigrationRun{}).
+		Select("post_id").
+		Where("state = ? AND name IN ?", sgtmpb.MigrationRun_DoneState, names).
+		Group("post_id").
+		Having("COUNT(*) = ?", len(names))
+	waiting := svc.rodb().
+		Model(&sgtmpb.MigrationRun{}).
+		Select("post_id").
+		Where("state = ? AND name IN ?", sgtmpb.MigrationRun_FailedState, names).
+		Where("next_retry_at > ? OR attempts >= ?", time.Now().UnixNano(), migrationMaxAttempts)
+	return query.
+		Where("id NOT IN (?)", done).
+		Where("id NOT IN (?)", waiting)
+}
+
+// processTrackJob runs the pending migrations of a track, in order, and stops at the first failure.
+func (svc *Service) processTrackJob(job *sgtmpb.Job, db *gorm.DB) error {
+	post, err := jobPost(job, db)
+	if err != nil {
+		return err
+	}
+	var payload processTrackPayload
+	if job.Payload != "" {
+		if err := json.Unmarshal([]byte(job.Payload), &payload); err != nil {
+			return fmt.Errorf("invalid payload: %w", err)
+		}
+	}
+
+	runs, err := svc.trackMigrationRuns(db, post.ID)
+	if err != nil {
+		return err
+	}
+	if payload.Migration != "" {
+		migration, found := svc.trackMigrationByName(payload.Migration)
+		if !found {
+			return fmt.Errorf("unknown migration: %q", payload.Migration)
+		}
+		run := runs[migration.Name]
+		run.Attempts = 0
+		if err := svc.runTrackMigration(db, post, migration, run); err != nil {
+			return err
+		}
+	} else {
+		now := time.Now().UnixNano()
+		for _, migration := range svc.processingWorker.trackMigrations {
+			run := runs[migration.Name]
+			if run.State == sgtmpb.MigrationRun_DoneState {
+				continue
+			}
+			if run.State == sgtmpb.MigrationRun_FailedState && (run.NextRetryAt > now || run.Attempts >= migrationMaxAttempts) {
+				break
+			}
+			if err := svc.runTrackMigration(db, post, migration, run); err != nil {
+				return err
+			}
+			if run.State != sgtmpb.MigrationRun_DoneState {
+				break
+			}
+		}
+	}
+	return svc.saveProcessingState(db, post, runs)
+}
+
+// trackMigrationRuns returns the runs of a track by migration name; missing runs are initialized.
+func (svc *Service) trackMigrationRuns(db *gorm.DB, postID int64) (map[string]*sgtmpb.MigrationRun, error) {
+	var list []*sgtmpb.MigrationRun
+	if err := db.Where(sgtmpb.MigrationRun{PostID: postID}).Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("failed to load migration runs: %w", err)
+	}
+	runs := map[string]*sgtmpb.MigrationRun{}
+	for _, run := range list {
+		runs[run.Name] = run
+	}
+	for _, migration := range svc.processingWorker.trackMigrations {
+		if _, found := runs[migration.Name]; !found {
+			runs[migration.Name] = &sgtmpb.MigrationRun{PostID: postID, Name: migration.Name}
+		}
+	}
+	return runs, nil
+}
+
+// runTrackMigration runs a migration, then saves its result and records the run in a short transaction;
+// a result that cannot be saved is rolled back and recorded as a failure.
+// The returned error is only set if the run cannot be recorded.
+func (svc *Service) runTrackMigration(db *gorm.DB, post *sgtmpb.Post, migration trackMigration, run *sgtmpb.MigrationRun) error {
+	started := time.Now()
+	save, err := migration.Run(svc, post, db)
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err == nil && save != nil {
+			err = tx.Transaction(save)
+		}
+		run.Attempts++
+		run.RanAt = time.Now().UnixNano()
+		run.DurationMs = time.Since(started).Milliseconds()
+		if err != nil {
+			run.State = sgtmpb.MigrationRun_FailedState
+			run.LastError = err.Error()
+			run.NextRetryAt = time.Now().Add(jobBackoff(run.Attempts)).UnixNano()
+			svc.logger.Debug("track migration failed",
+				zap.Int64("post", post.ID),
+				zap.String("migration", migration.Name),
+				zap.Int64("attempts", run.Attempts),
+				zap.Error(err),
+			)
+		} else {
+			run.State = sgtmpb.MigrationRun_DoneState
+			run.LastError = ""
+			run.NextRetryAt = 0
+		}
+		if err := tx.Save(run).Error; err != nil {
+			return fmt.Errorf("failed to save migration run: %w", err)
+		}
+		return nil
+	})
+}
+
+// saveProcessingState summarizes the migration runs in the processing columns of the post.
+func (svc *Service) saveProcessingState(db *gorm.DB, post *sgtmpb.Post, runs map[string]*sgtmpb.MigrationRun) error {
+	var (
+		done      int64
+		lastError string
+	)
+	for _, migration := range svc.processingWorker.trackMigrations {
+		run := runs[migration.Name]
+		switch run.State {
+		case sgtmpb.MigrationRun_DoneState:
+			done++
+		case sgtmpb.MigrationRun_FailedState:
+			if lastError == "" && run.LastError != "" { // the cleared errors are hidden until the next failure
+				lastError = fmt.Sprintf("%s: %s", migration.Name, run.LastError)
+			}
+		}
+	}
+	return db.
+		Model(post).
+		Updates(map[string]interface{}{
+			"processing_version": done,
+			"processing_error":   lastError,
+		}).
+		Error
+}
+
+// replayTrackMigration schedules a named migration to run again on the targeted tracks,
+// and returns the number of tracks.
+func (svc *Service) replayTrackMigration(req *sgtmpb.MigrationReplay_Request) (int64, error) {
+	if _, found := svc.trackMigrationByName(req.Name); !found {
+		return 0, postInputError(fmt.Sprintf("Unknown migration: %q.", req.Name))
+	}
+	query := svc.rodb().
+		Model(&sgtmpb.Post{}).
+		Where(sgtmpb.Post{Kind: sgtmpb.Post_TrackKind})
+	targets := 0
+	if req.PostID != 0 {
+		query = query.Where("id = ?", req.PostID)
+		targets++
+	}
+	if req.UserID != 0 {
+		query = query.Where("author_id = ?", req.UserID)
+		targets++
+	}
+	if req.AllTracks {
+		targets++
+	}
+	if targets != 1 {
+		return 0, postInputError("Exactly one of post_id, user_id and all_tracks is required.")
+	}
+
+	var ids []int64
+	if err := query.Pluck("id", &ids).Error; err != nil {
+		return 0, err
+	}
+	payload := processTrackPayload{Migration: req.Name}
+	for _, id := range ids {
+		if _, err := svc.enqueueJob(sgtmpb.Job_ProcessTrackKind, id, payload); err != nil {
+			return 0, err
+		}
+	}
+	svc.logger.Debug("track migration replayed", zap.String("migration", req.Name), zap.Int("tracks", len(ids)))
+	return int64(len(ids)), nil
+}
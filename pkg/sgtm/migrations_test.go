@@ -0,0 +1,143 @@
+package sgtm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+func TestTrackMigrations(t *testing.T) {
+	svc := TestingService(t)
+	client := testingClient(t, &svc)
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	admin := sgtmpb.User{Email: "admin@example.com", Slug: "admin", Role: roleAdmin}
+	require.NoError(t, svc.rwdb().Create(&admin).Error)
+	var tracks []*sgtmpb.Post
+	for _, title := range []string{"good", "bad", "good again"} {
+		track := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Public, Title: title}
+		require.NoError(t, svc.rwdb().Create(&track).Error)
+		tracks = append(tracks, &track)
+	}
+	bad := tracks[1]
+
+	calls := map[string]int{}
+	counter := func(name string) trackMigration {
+		return trackMigration{Name: name, Run: func(*Service, *sgtmpb.Post, *gorm.DB) (func(*gorm.DB) error, error) {
+			calls[name]++
+			return nil, nil
+		}}
+	}
+	failBad := true
+	svc.processingWorker.trackMigrations = []trackMigration{
+		counter("first"),
+		{Name: "fragile", Run: func(_ *Service, post *sgtmpb.Post, _ *gorm.DB) (func(*gorm.DB) error, error) {
+			return func(tx *gorm.DB) error {
+				if err := tx.Model(post).Update("lyrics", "partial").Error; err != nil {
+					return err
+				}
+				if failBad && post.Title == "bad" {
+					return fmt.Errorf("bad track")
+				}
+				return nil
+			}, nil
+		}},
+		counter("last"),
+	}
+	process := func() {
+		require.NoError(t, svc.enqueueOutdatedTracks())
+		require.NoError(t, svc.processingLoop(0))
+	}
+	reload := func(post *sgtmpb.Post) *sgtmpb.Post {
+		var ret sgtmpb.Post
+		require.NoError(t, svc.rodb().First(&ret, post.ID).Error)
+		return &ret
+	}
+	runsOf := func(post *sgtmpb.Post) map[string]*sgtmpb.MigrationRun {
+		runs, err := svc.trackMigrationRuns(svc.rodb(), post.ID)
+		require.NoError(t, err)
+		return runs
+	}
+
+	// the failure of a migration is recorded, and the next migrations are skipped
+	process()
+	require.Equal(t, map[string]int{"first": 3, "last": 2}, calls)
+	runs := runsOf(bad)
+	require.Equal(t, sgtmpb.MigrationRun_DoneState, runs["first"].State)
+	require.Equal(t, sgtmpb.MigrationRun_FailedState, runs["fragile"].State)
+	require.Equal(t, int64(1), runs["fragile"].Attempts)
+	require.Equal(t, "bad track", runs["fragile"].LastError)
+	require.Equal(t, sgtmpb.MigrationRun_UnknownState, runs["last"].State)
+	require.Equal(t, "fragile: bad track", reload(bad).ProcessingError)
+	require.Equal(t, int64(1), reload(bad).ProcessingVersion)
+	require.Equal(t, "", reload(bad).Lyrics) // rolled back
+	require.Equal(t, int64(3), reload(tracks[0]).ProcessingVersion)
+
+	// failed migrations are retried after a backoff
+	process()
+	require.Equal(t, int64(1), runsOf(bad)["fragile"].Attempts)
+	require.NoError(t, svc.rwdb().Model(runsOf(bad)["fragile"]).Update("next_retry_at", time.Now().UnixNano()).Error)
+	process()
+	require.Equal(t, int64(2), runsOf(bad)["fragile"].Attempts)
+	failBad = false
+	require.NoError(t, svc.rwdb().Model(runsOf(bad)["fragile"]).Update("next_retry_at", time.Now().UnixNano()).Error)
+	process()
+	require.Equal(t, sgtmpb.MigrationRun_DoneState, runsOf(bad)["fragile"].State)
+	require.Equal(t, "", reload(bad).ProcessingError)
+	require.Equal(t, int64(3), reload(bad).ProcessingVersion)
+	require.Equal(t, map[string]int{"first": 3, "last": 3}, calls)
+
+	// inserting a migration only runs the new one
+	svc.processingWorker.trackMigrations = append(
+		[]trackMigration{svc.processingWorker.trackMigrations[0], counter("inserted")},
+		svc.processingWorker.trackMigrations[1:]...,
+	)
+	process()
+	require.Equal(t, map[string]int{"first": 3, "inserted": 3, "last": 3}, calls)
+	require.Equal(t, int64(4), reload(bad).ProcessingVersion)
+
+	// replay
+	replay := func(userID int64, req *sgtmpb.MigrationReplay_Request) (int64, error) {
+		ret, err := client.MigrationReplay(testingAuthContext(t, &svc, userID), req)
+		if err != nil {
+			return 0, err
+		}
+		return ret.QueuedTracks, nil
+	}
+	_, err := replay(author.ID, &sgtmpb.MigrationReplay_Request{Name: "last", AllTracks: true})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = replay(admin.ID, &sgtmpb.MigrationReplay_Request{Name: "unknown", AllTracks: true})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = replay(admin.ID, &sgtmpb.MigrationReplay_Request{Name: "last", AllTracks: true, PostID: bad.ID})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	queued, err := replay(admin.ID, &sgtmpb.MigrationReplay_Request{Name: "last", PostID: bad.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), queued)
+	process()
+	require.Equal(t, 4, calls["last"])
+	require.Equal(t, int64(1), runsOf(bad)["last"].Attempts)
+	queued, err = replay(admin.ID, &sgtmpb.MigrationReplay_Request{Name: "first", UserID: author.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), queued)
+	queued, err = replay(admin.ID, &sgtmpb.MigrationReplay_Request{Name: "inserted", AllTracks: true})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), queued)
+	process()
+	require.Equal(t, map[string]int{"first": 6, "inserted": 6, "last": 4}, calls)
+
+	// list
+	list, err := client.MigrationList(testingAuthContext(t, &svc, admin.ID), &sgtmpb.MigrationList_Request{PostID: bad.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "inserted", "fragile", "last"}, list.Names)
+	require.Len(t, list.Runs, 4)
+	_, err = client.MigrationList(context.Background(), &sgtmpb.MigrationList_Request{PostID: bad.ID})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
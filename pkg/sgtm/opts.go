@@ -28,11 +28,18 @@ type Opts struct {
 
 	// Processing Worker
 
-	EnableProcessingWorker bool
+	EnableProcessingWorker      bool
+	ProcessingWorkerConcurrency int    // number of jobs run in parallel
+	BPMAnalyzer                 string // "auto", "sonic-annotator" or "go"
 
 	// SoundCloud
 
 	SoundCloudClientID string
+	SoundCloudClient   SoundCloudClient // if nil, an HTTP client using SoundCloudClientID is created
+
+	// Bandcamp
+
+	BandcampClient BandcampClient // if nil, an HTTP client is created
 
 	// DB
 
@@ -70,6 +77,15 @@ func (opts *Opts) applyDefaults() error {
 	if opts.JWTSigningKey == "" {
 		opts.JWTSigningKey = randString(42)
 	}
+	if opts.ProcessingWorkerConcurrency < 1 {
+		opts.ProcessingWorkerConcurrency = 1
+	}
+	if opts.SoundCloudClient == nil {
+		opts.SoundCloudClient = NewSoundCloudClient(opts.SoundCloudClientID, "")
+	}
+	if opts.BandcampClient == nil {
+		opts.BandcampClient = NewBandcampClient()
+	}
 	return nil
 }
 
@@ -86,6 +102,12 @@ func (opts *Opts) setDefaults() {
 	if opts.ServerShutdownTimeout == 0 {
 		opts.ServerShutdownTimeout = 6 * time.Second
 	}
+	if opts.ProcessingWorkerConcurrency == 0 {
+		opts.ProcessingWorkerConcurrency = 1
+	}
+	if opts.BPMAnalyzer == "" {
+		opts.BPMAnalyzer = bpmAnalyzerAuto
+	}
 	if opts.DBPath == "" {
 		opts.DBPath = "/tmp/sgtm.db"
 	}
@@ -0,0 +1,83 @@
+package sgtm
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	packr "github.com/gobuffalo/packr/v2"
+)
+
+func (svc *Service) adminProcessingPage(box *packr.Box) func(w http.ResponseWriter, r *http.Request) {
+	tmpl := loadTemplates(box, "base.tmpl.html", "admin-processing.tmpl.html")
+	return func(w http.ResponseWriter, r *http.Request) {
+		started := time.Now()
+		data, err := svc.newTemplateData(w, r)
+		if err != nil {
+			svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+			return
+		}
+		// custom
+		data.PageKind = "admin-processing"
+
+		if r.Method == "POST" {
+			validate := func() error {
+				if err := r.ParseForm(); err != nil {
+					return err
+				}
+				filter := processingFailureFilter{
+					Migration: r.Form.Get("migration"),
+					Error:     r.Form.Get("error"),
+					All:       r.Form.Get("all") == "1",
+				}
+				for key, dest := range map[string]*int64{"post_id": &filter.PostID, "user_id": &filter.UserID} {
+					if input := r.Form.Get(key); input != "" {
+						var err error
+						if *dest, err = strconv.ParseInt(input, 10, 64); err != nil {
+							return err
+						}
+					}
+				}
+				switch r.Form.Get("action") {
+				case "retry":
+					_, err := svc.retryProcessingFailures(filter)
+					return err
+				case "clear":
+					_, _, err := svc.clearProcessingFailures(filter)
+					return err
+				default:
+					return postInputError("Unknown action.")
+				}
+			}
+			err := validate()
+			var inputErr postInputError
+			switch {
+			case errors.As(err, &inputErr):
+				data.Error = inputErr.Error()
+			case err != nil:
+				svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+				return
+			default:
+				http.Redirect(w, r, "/admin/processing", http.StatusFound)
+				return
+			}
+		}
+
+		data.AdminProcessing.Failures, err = svc.processingFailures()
+		if err != nil {
+			svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+			return
+		}
+
+		// end of custom
+		if svc.opts.DevMode {
+			tmpl = loadTemplates(box, "base.tmpl.html", "admin-processing.tmpl.html")
+		}
+		data.Duration = time.Since(started)
+		if err := tmpl.Execute(w, &data); err != nil {
+			svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+			return
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package sgtm
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	packr "github.com/gobuffalo/packr/v2"
+	"moul.io/godev"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// albumPage renders an album with its tracks in order; ?format=json exports the album with its tracks.
+func (svc *Service) albumPage(box *packr.Box) func(w http.ResponseWriter, r *http.Request) {
+	tmpl := loadTemplates(box, "base.tmpl.html", "album.tmpl.html")
+	return func(w http.ResponseWriter, r *http.Request) {
+		started := time.Now()
+		data, err := svc.newTemplateData(w, r)
+		if err != nil {
+			svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+			return
+		}
+		// custom
+		data.PageKind = "album"
+		id, err := strconv.ParseInt(chi.URLParam(r, "album_id"), 10, 64)
+		if err != nil {
+			svc.error404Page(box)(w, r)
+			return
+		}
+		var album sgtmpb.Post
+		err = svc.rodb().
+			Preload("Author").
+			Where(sgtmpb.Post{ID: id, Kind: sgtmpb.Post_AlbumKind}).
+			First(&album).
+			Error
+		if err != nil || !canViewPost(data.User, &album) {
+			svc.error404Page(box)(w, r)
+			return
+		}
+		tracks, err := svc.albumTracks(album.ID)
+		if err != nil {
+			svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+			return
+		}
+		for _, track := range tracks {
+			if canViewPost(data.User, track) {
+				applyPostDefaults(track)
+				album.AlbumTracks = append(album.AlbumTracks, track)
+			}
+		}
+		applyPostDefaults(&album)
+
+		if r.URL.Query().Get("format") == "json" {
+			album.Filter()
+			album.Author.Filter()
+			for _, track := range album.AlbumTracks {
+				track.Author.Filter()
+			}
+			fmt.Fprintln(w, godev.PrettyJSONPB(&album))
+			return
+		}
+		data.Album.Album = &album
+		data.Album.Tracks = album.AlbumTracks
+		data.Album.Embed = embedTrack(&album)
+		data.Album.Original = originalLink(&album)
+		// end of custom
+		if svc.opts.DevMode {
+			tmpl = loadTemplates(box, "base.tmpl.html", "album.tmpl.html")
+		}
+		data.Duration = time.Since(started)
+		if err := tmpl.Execute(w, &data); err != nil {
+			svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+			return
+		}
+	}
+}
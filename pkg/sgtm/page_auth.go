@@ -142,12 +142,13 @@ func (svc *Service) httpAuthCallback(w http.ResponseWriter, r *http.Request) {
 				// Lastname
 			}
 			// FIXME: check if slug already exists, if yes, append something to the slug
+			var registerEvent sgtmpb.Post
 			err = svc.rwdb().Transaction(func(tx *gorm.DB) error {
 				if err := tx.Create(&dbUser).Error; err != nil {
 					return err
 				}
 
-				registerEvent := sgtmpb.Post{AuthorID: dbUser.ID, Kind: sgtmpb.Post_RegisterKind}
+				registerEvent = sgtmpb.Post{AuthorID: dbUser.ID, Kind: sgtmpb.Post_RegisterKind}
 				if err := tx.Create(&registerEvent).Error; err != nil {
 					return err
 				}
@@ -165,13 +166,14 @@ func (svc *Service) httpAuthCallback(w http.ResponseWriter, r *http.Request) {
 				svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
 				return
 			}
+			svc.publishActivity(&registerEvent)
 
 		case err == nil:
 			// user exists
 			// FIXME: update user in DB if needed
 
 			loginEvent := sgtmpb.Post{AuthorID: dbUser.ID, Kind: sgtmpb.Post_LoginKind}
-			if err := svc.rwdb().Create(&loginEvent).Error; err != nil {
+			if err := svc.createActivity(&loginEvent); err != nil {
 				svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
 				return
 			}
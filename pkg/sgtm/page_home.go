@@ -24,7 +24,7 @@ func (svc *Service) homePage(box *packr.Box) func(w http.ResponseWriter, r *http
 		// tracking
 		{
 			viewEvent := sgtmpb.Post{AuthorID: data.UserID, Kind: sgtmpb.Post_ViewHomeKind}
-			if err := svc.rwdb().Create(&viewEvent).Error; err != nil {
+			if err := svc.createActivity(&viewEvent); err != nil {
 				data.Error = "Cannot write activity: " + err.Error()
 			} else {
 				svc.logger.Debug("new view home", zap.Any("event", &viewEvent))
@@ -33,25 +33,19 @@ func (svc *Service) homePage(box *packr.Box) func(w http.ResponseWriter, r *http
 
 		// last tracks
 		{
-			limit := 50
+			var limit int32 = 50
 			if data.UserID == 0 {
 				limit = 10
 			}
-			if err := svc.rodb().
-				Model(&sgtmpb.Post{}).
-				Preload("Author").
-				Where(sgtmpb.Post{
-					Kind:       sgtmpb.Post_TrackKind,
-					Visibility: sgtmpb.Visibility_Public,
-				}).
-				Order("sort_date desc").
-				Limit(limit). // FIXME: pagination
-				Find(&data.Home.LastTracks).
-				Error; err != nil {
+			data.Home.LastTracks, data.Home.NextCursor, err = svc.postList(&sgtmpb.PostList_Request{
+				Limit:  limit,
+				Cursor: r.URL.Query().Get("cursor"),
+			})
+			if err != nil {
 				data.Error = "Cannot fetch last tracks: " + err.Error()
 			}
 			for _, track := range data.Home.LastTracks {
-				track.ApplyDefaults()
+				applyPostDefaults(track)
 			}
 		}
 
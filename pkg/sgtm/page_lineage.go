@@ -0,0 +1,84 @@
+package sgtm
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	packr "github.com/gobuffalo/packr/v2"
+	"moul.io/godev"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// postLineagePage renders the remix tree of a track; ?format=json and ?format=dot export the graph.
+func (svc *Service) postLineagePage(box *packr.Box) func(w http.ResponseWriter, r *http.Request) {
+	tmpl := loadTemplates(box, "base.tmpl.html", "lineage.tmpl.html")
+	return func(w http.ResponseWriter, r *http.Request) {
+		started := time.Now()
+		data, err := svc.newTemplateData(w, r)
+		if err != nil {
+			svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+			return
+		}
+		// custom
+		data.PageKind = "lineage"
+		query := whereTrackSlug(svc.rodb().Preload("Author"), chi.URLParam(r, "post_slug"))
+		var post sgtmpb.Post
+		if err := query.First(&post).Error; err != nil || !canViewPost(data.User, &post) {
+			svc.error404Page(box)(w, r)
+			return
+		}
+		graph, err := svc.postLineage(data.User, post.ID, defaultLineageDepth)
+		if err != nil {
+			svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+			return
+		}
+
+		switch r.URL.Query().Get("format") {
+		case "json":
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			fmt.Fprintln(w, godev.PrettyJSONPB(graph.toProto()))
+			return
+		case "dot":
+			w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+			fmt.Fprint(w, graphToDOT("lineage", graph.toProto()))
+			return
+		}
+		data.Lineage.Post = &post
+		data.Lineage.Roots = lineageTree(graph, post.ID)
+		// end of custom
+		if svc.opts.DevMode {
+			tmpl = loadTemplates(box, "base.tmpl.html", "lineage.tmpl.html")
+		}
+		data.Duration = time.Since(started)
+		if err := tmpl.Execute(w, &data); err != nil {
+			svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+			return
+		}
+	}
+}
+
+// userCollaboratorsPage exports the collaborators of a user as JSON, or as DOT with ?format=dot.
+func (svc *Service) userCollaboratorsPage(box *packr.Box) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		viewer, _ := authUserFromContext(r.Context())
+		var user sgtmpb.User
+		if err := svc.rodb().Where(sgtmpb.User{Slug: chi.URLParam(r, "user_slug")}).First(&user).Error; err != nil {
+			svc.error404Page(box)(w, r)
+			return
+		}
+		graph, err := svc.userCollaborators(viewer, user.ID, defaultCollaboratorsDepth)
+		if err != nil {
+			svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+			return
+		}
+		if r.URL.Query().Get("format") == "dot" {
+			w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+			fmt.Fprint(w, graphToDOT("collaborators", graph.toProto()))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprintln(w, godev.PrettyJSONPB(graph.toProto()))
+	}
+}
@@ -1,8 +1,10 @@
 package sgtm
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"os"
 	"regexp"
 	"strconv"
 	"strings"
@@ -11,7 +13,6 @@ import (
 	"github.com/go-chi/chi"
 	packr "github.com/gobuffalo/packr/v2"
 	"go.uber.org/zap"
-	"gorm.io/gorm"
 	"moul.io/godev"
 	"moul.io/sgtm/pkg/sgtmpb"
 )
@@ -35,25 +36,62 @@ func (svc *Service) postPage(box *packr.Box) func(w http.ResponseWriter, r *http
 		postSlug := chi.URLParam(r, "post_slug")
 		query := svc.rodb().
 			Preload("Author").
+			Preload("Album").
+			Preload("Renditions").
 			Preload("RelationshipsAsSource").
 			Preload("RelationshipsAsSource.TargetPost").
 			Preload("RelationshipsAsSource.TargetUser").
 			Preload("RelationshipsAsTarget").
 			Preload("RelationshipsAsTarget.SourcePost").
 			Preload("RelationshipsAsTarget.SourceUser")
-		id, err := strconv.ParseInt(postSlug, 10, 64)
-		if err == nil {
-			query = query.Where(sgtmpb.Post{ID: id, Kind: sgtmpb.Post_TrackKind})
-		} else {
-			query = query.Where(sgtmpb.Post{Slug: postSlug, Kind: sgtmpb.Post_TrackKind})
-		}
+		query = whereTrackSlug(query, postSlug)
 		var post sgtmpb.Post
-		if err := query.First(&post).Error; err != nil {
+		// the tracks that cannot be viewed can't be commented either, like with CommentCreate
+		if err := query.First(&post).Error; err != nil || !canViewPost(data.User, &post) {
 			svc.error404Page(box)(w, r)
 			return
 		}
 		data.Post.Post = &post
-		data.Post.Post.ApplyDefaults()
+		applyPostDefaults(data.Post.Post)
+		data.Post.Embed = embedTrack(data.Post.Post)
+		data.Post.Original = originalLink(data.Post.Post)
+
+		// hide the drafts related to this track, and the suggestions from the other users
+		{
+			canEdit := canEditPost(data.User, &post)
+			asSource := post.RelationshipsAsSource[:0]
+			for _, relationship := range post.RelationshipsAsSource {
+				if (relationship.TargetPost == nil || canViewPost(data.User, relationship.TargetPost)) && (!relationship.Suggested || canEdit) {
+					asSource = append(asSource, relationship)
+				}
+			}
+			post.RelationshipsAsSource = asSource
+			asTarget := post.RelationshipsAsTarget[:0]
+			for _, relationship := range post.RelationshipsAsTarget {
+				if (relationship.SourcePost == nil || canViewPost(data.User, relationship.SourcePost)) && (!relationship.Suggested || canEdit) {
+					asTarget = append(asTarget, relationship)
+				}
+			}
+			post.RelationshipsAsTarget = asTarget
+		}
+
+		// the likely duplicates are only shown to the author
+		if ids := post.PossibleDuplicateIDs(); len(ids) > 0 && canEditPost(data.User, &post) {
+			var duplicates []*sgtmpb.Post
+			if err := svc.rodb().Where("kind = ? AND id IN (?)", sgtmpb.Post_TrackKind, ids).Order("id").Find(&duplicates).Error; err != nil {
+				svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+				return
+			}
+			for _, duplicate := range duplicates {
+				if canViewPost(data.User, duplicate) {
+					data.Post.PossibleDuplicates = append(data.Post.PossibleDuplicates, duplicate)
+				}
+			}
+		}
+
+		if canEditPost(data.User, &post) {
+			data.Post.ProcessingError = post.ProcessingError
+		}
 
 		if r.URL.Query().Get("format") == "json" {
 			data.Post.Post.Filter()
@@ -62,47 +100,88 @@ func (svc *Service) postPage(box *packr.Box) func(w http.ResponseWriter, r *http
 			return
 		}
 
-		if r.Method == "POST" && data.UserID != 0 {
-			validate := func() *sgtmpb.Post {
+		if r.Method == "POST" && data.User != nil {
+			// returns the ID of the comment to scroll to
+			validate := func() (int64, error) {
 				if err := r.ParseForm(); err != nil {
-					data.Error = err.Error()
-					return nil
+					return 0, err
 				}
-				comment := sgtmpb.Post{
-					Kind:         sgtmpb.Post_CommentKind,
-					AuthorID:     data.UserID,
-					Body:         strings.TrimSpace(r.Form.Get("comment")),
-					Visibility:   sgtmpb.Visibility_Public,
-					TargetPostID: data.Post.Post.ID,
-				}
-				if comment.Body == "" {
-					return nil
+				switch r.Form.Get("action") {
+				case "confirm-relationship", "dismiss-relationship":
+					if !canEditPost(data.User, data.Post.Post) {
+						return 0, errPermissionDenied
+					}
+					id, err := strconv.ParseInt(r.Form.Get("relationship_id"), 10, 64)
+					if err != nil {
+						return 0, err
+					}
+					var relationship sgtmpb.Relationship
+					err = svc.rodb().
+						Where("id = ? AND suggested = ? AND (source_post_id = ? OR target_post_id = ?)", id, true, data.Post.Post.ID, data.Post.Post.ID).
+						First(&relationship).
+						Error
+					if err != nil {
+						return 0, postInputError("Unknown suggestion.")
+					}
+					if r.Form.Get("action") == "dismiss-relationship" {
+						return 0, svc.deleteRelationship(relationship.ID)
+					}
+					_, err = svc.confirmRelationship(&relationship)
+					return 0, err
+				case "dismiss-duplicates":
+					if !canEditPost(data.User, data.Post.Post) {
+						return 0, errPermissionDenied
+					}
+					return 0, svc.rwdb().Model(data.Post.Post).Update("possible_duplicates", "").Error
+				case "edit-comment", "delete-comment":
+					id, err := strconv.ParseInt(r.Form.Get("comment_id"), 10, 64)
+					if err != nil {
+						return 0, err
+					}
+					comment, err := svc.commentByID(id)
+					if err != nil || comment.TargetPostID != data.Post.Post.ID {
+						return 0, errPermissionDenied
+					}
+					if r.Form.Get("action") == "delete-comment" {
+						return comment.ReplyToID, svc.deleteComment(data.User, comment)
+					}
+					return comment.ID, svc.updateComment(data.User, comment, r.Form.Get("comment"))
+				default:
+					var replyToID int64
+					if input := r.Form.Get("reply_to"); input != "" {
+						var err error
+						if replyToID, err = strconv.ParseInt(input, 10, 64); err != nil {
+							return 0, err
+						}
+					}
+					comment, err := svc.createComment(data.User, data.Post.Post, replyToID, r.Form.Get("comment"))
+					if err != nil {
+						return 0, err
+					}
+					return comment.ID, nil
 				}
-				return &comment
 			}
-			comment := validate()
-			if comment != nil {
-				if err := svc.rwdb().Create(comment).Error; err != nil {
-					svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
-					return
+			commentID, err := validate()
+			var inputErr postInputError
+			switch {
+			case errors.As(err, &inputErr):
+				data.Error = inputErr.Error()
+			case err != nil:
+				svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+				return
+			default:
+				redirectURL := data.Post.Post.CanonicalURL()
+				if commentID != 0 {
+					redirectURL += fmt.Sprintf("#comment-%d", commentID)
 				}
-				svc.logger.Debug("comment created", zap.Any("post", comment))
-				http.Redirect(w, r, data.Post.Post.CanonicalURL(), http.StatusFound)
+				http.Redirect(w, r, redirectURL, http.StatusFound)
 				return
 			}
 		}
 
 		// load comments
 		{
-			err := svc.rodb().
-				Where(sgtmpb.Post{
-					Kind:         sgtmpb.Post_CommentKind,
-					TargetPostID: data.Post.Post.ID,
-					Visibility:   sgtmpb.Visibility_Public,
-				}).
-				Preload("Author").
-				Find(&data.Post.Comments).
-				Error
+			data.Post.Comments, err = svc.loadCommentTree(data.Post.Post.ID)
 			if err != nil {
 				svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
 				return
@@ -112,7 +191,7 @@ func (svc *Service) postPage(box *packr.Box) func(w http.ResponseWriter, r *http
 		// tracking
 		{
 			viewEvent := sgtmpb.Post{AuthorID: data.UserID, Kind: sgtmpb.Post_ViewPostKind, TargetPostID: data.Post.Post.ID}
-			if err := svc.rwdb().Create(&viewEvent).Error; err != nil {
+			if err := svc.createActivity(&viewEvent); err != nil {
 				data.Error = "Cannot write activity: " + err.Error()
 			} else {
 				svc.logger.Debug("new view post", zap.Any("event", &viewEvent))
@@ -138,7 +217,6 @@ func (svc *Service) postMaintenancePage(box *packr.Box) func(w http.ResponseWrit
 			shouldExtractBpm          = r.URL.Query().Get("extract_bpm") == "1"
 			shouldDetectRelationships = r.URL.Query().Get("detect_relationships") == "1"
 			shouldResyncSoundCloud    = r.URL.Query().Get("resync_soundcloud") == "1"
-			shouldDL                  = shouldExtractBpm
 			shouldDoSomething         = shouldExtractBpm || shouldDetectRelationships || shouldResyncSoundCloud
 		)
 		if !shouldDoSomething {
@@ -154,103 +232,34 @@ func (svc *Service) postMaintenancePage(box *packr.Box) func(w http.ResponseWrit
 			return
 		}
 		// custom
-		if data.User == nil {
-			http.Redirect(w, r, "/", http.StatusTemporaryRedirect)
-			return
-		}
 		postSlug := chi.URLParam(r, "post_slug")
-		query := svc.rodb().
-			Preload("Author").
-			Preload("RelationshipsAsSource").
-			Preload("RelationshipsAsTarget")
-		id, err := strconv.ParseInt(postSlug, 10, 64)
-		if err == nil {
-			query = query.Where(sgtmpb.Post{ID: id, Kind: sgtmpb.Post_TrackKind})
-		} else {
-			query = query.Where(sgtmpb.Post{Slug: postSlug, Kind: sgtmpb.Post_TrackKind})
-		}
+		query := svc.rodb()
+		query = whereTrackSlug(query, postSlug)
 		var post sgtmpb.Post
 		if err := query.First(&post).Error; err != nil {
 			svc.error404Page(box)(w, r)
 			return
 		}
-		if !data.IsAdmin && data.User.ID != post.Author.ID {
-			svc.error404Page(box)(w, r)
-			return
-		}
 
-		// dl file
-		var dl *Download
-		if shouldDL {
-			var err error
-			dl, err = DownloadPost(&post, false)
-			if err != nil {
-				svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
-				return
+		// enqueue jobs
+		{
+			var kinds []sgtmpb.Job_Kind
+			if shouldResyncSoundCloud {
+				kinds = append(kinds, sgtmpb.Job_SyncSoundCloudKind)
 			}
-			svc.logger.Debug("file downloaded", zap.String("path", dl.Path))
-		}
-
-		// resync soundcloud
-		if shouldResyncSoundCloud {
-			svc.error404Page(box)(w, r)
-			return
-		}
-
-		// extract bpm
-		if shouldExtractBpm {
-			bpm, err := ExtractBPM(dl.Path)
-			if err != nil {
-				svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
-				return
+			if shouldExtractBpm {
+				kinds = append(kinds, sgtmpb.Job_ExtractBPMKind)
 			}
-			svc.logger.Debug("BPM extracted", zap.Float64("bpm", bpm))
-			if err := svc.rwdb().Model(&post).Update("bpm", bpm).Error; err != nil {
-				svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
-				return
+			if shouldDetectRelationships {
+				kinds = append(kinds, sgtmpb.Job_DetectRelationshipsKind)
 			}
-		}
-
-		if shouldDetectRelationships {
-			// FIXME: support more relationship kinds
-
-			err := svc.rwdb().Transaction(func(tx *gorm.DB) error {
-				// FIXME: avoid delete/recreate associations if they didn't changed
-
-				body := post.SafeTitle() + "\n\n" + post.SafeDescription()
-
-				if err := tx.Model(&post).Association("RelationshipsAsSource").Clear(); err != nil {
-					return err
-				}
-				if err := tx.Model(&post).Association("RelationshipsAsTarget").Clear(); err != nil {
-					return err
-				}
-
-				for _, match := range featRegex.FindAllStringSubmatch(body, -1) {
-					target := strings.ToLower(strings.TrimSpace(match[len(match)-1]))
-					var user sgtmpb.User
-					err := svc.rodb().
-						Where("LOWER(slug) = ?", target).
-						First(&user).
-						Error
-					if err != nil {
-						svc.logger.Debug("cannot find the featured artist in DB", zap.Error(err))
-						continue
-					}
-
-					if err := tx.Model(&post).Association("RelationshipsAsSource").Append(&sgtmpb.Relationship{
-						SourcePostID: post.ID,
-						TargetUserID: user.ID,
-						Kind:         sgtmpb.Relationship_FeaturingUserKind,
-					}); err != nil {
-						return err
-					}
+			for _, kind := range kinds {
+				job, err := svc.enqueueJob(kind, post.ID, nil)
+				if err != nil {
+					svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+					return
 				}
-				return nil
-			})
-			if err != nil {
-				svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
-				return
+				svc.logger.Debug("maintenance job enqueued", zap.Int64("job", job.ID), zap.Stringer("kind", kind))
 			}
 		}
 
@@ -286,14 +295,6 @@ func (svc *Service) postEditPage(box *packr.Box) func(w http.ResponseWriter, r *
 		// custom
 		data.PageKind = "post-edit"
 
-		// no anonymous users
-		{
-			if data.User == nil {
-				http.Redirect(w, r, "/", http.StatusTemporaryRedirect)
-				return
-			}
-		}
-
 		// fetch post from db
 		{
 			postSlug := chi.URLParam(r, "post_slug")
@@ -301,12 +302,7 @@ func (svc *Service) postEditPage(box *packr.Box) func(w http.ResponseWriter, r *
 				Preload("Author").
 				Preload("RelationshipsAsSource").
 				Preload("RelationshipsAsTarget")
-			id, err := strconv.ParseInt(postSlug, 10, 64)
-			if err == nil {
-				query = query.Where(sgtmpb.Post{ID: id, Kind: sgtmpb.Post_TrackKind})
-			} else {
-				query = query.Where(sgtmpb.Post{Slug: postSlug, Kind: sgtmpb.Post_TrackKind})
-			}
+			query = whereTrackSlug(query, postSlug)
 			var post sgtmpb.Post
 			if err := query.First(&post).Error; err != nil {
 				svc.error404Page(box)(w, r)
@@ -315,14 +311,6 @@ func (svc *Service) postEditPage(box *packr.Box) func(w http.ResponseWriter, r *
 			data.PostEdit.Post = &post
 		}
 
-		// only author or admin
-		{
-			if !data.IsAdmin && data.User.ID != data.PostEdit.Post.Author.ID {
-				svc.error404Page(box)(w, r)
-				return
-			}
-		}
-
 		// if POST
 		if r.Method == "POST" {
 			validate := func() map[string]interface{} {
@@ -330,7 +318,6 @@ func (svc *Service) postEditPage(box *packr.Box) func(w http.ResponseWriter, r *
 					data.Error = err.Error()
 					return nil
 				}
-				// FIXME: blacklist, etc
 				fields := map[string]interface{}{}
 				fields["title"] = strings.TrimSpace(r.Form.Get("title"))
 				fields["body"] = strings.TrimSpace(r.Form.Get("body"))
@@ -342,11 +329,10 @@ func (svc *Service) postEditPage(box *packr.Box) func(w http.ResponseWriter, r *
 			}
 			fields := validate()
 			if fields != nil {
-				if err := svc.rwdb().Model(data.PostEdit.Post).Updates(fields).Error; err != nil {
+				if err := svc.updatePost(data.PostEdit.Post, fields); err != nil {
 					svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
 					return
 				}
-				svc.logger.Debug("post updated", zap.Any("fields", fields))
 				http.Redirect(w, r, data.PostEdit.Post.CanonicalURL(), http.StatusFound)
 				return
 			}
@@ -366,30 +352,46 @@ func (svc *Service) postEditPage(box *packr.Box) func(w http.ResponseWriter, r *
 
 func (svc *Service) postDownloadPage(box *packr.Box) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
+		var user *sgtmpb.User
+		if identity, ok := authIdentityFromContext(r.Context()); ok {
+			user = identity.User
+		}
 		postSlug := chi.URLParam(r, "post_slug")
 		query := svc.rodb().
-			Preload("Author")
+			Preload("Author").
+			Preload("Renditions")
 
-		id, err := strconv.ParseInt(postSlug, 10, 64)
-		if err == nil {
-			query = query.Where(sgtmpb.Post{ID: id, Kind: sgtmpb.Post_TrackKind})
-		} else {
-			query = query.Where(sgtmpb.Post{Slug: postSlug, Kind: sgtmpb.Post_TrackKind})
-		}
+		query = whereTrackSlug(query, postSlug)
 		var post sgtmpb.Post
-		if err := query.First(&post).Error; err != nil {
+		if err := query.First(&post).Error; err != nil || !canViewPost(user, &post) {
 			svc.error404Page(box)(w, r)
 			return
 		}
 
-		if post.MIMEType != "" {
-			w.Header().Set("Content-Type", post.MIMEType)
-		}
-		reader, err := StreamPost(&svc.ipfs, &post)
-		if err != nil {
-			svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+		source, ok := selectTrackSource(&post, r.URL.Query().Get("format"), r.Header.Get("Accept"))
+		if !ok {
+			svc.error404Page(box)(w, r)
 			return
 		}
+		w.Header().Set("Vary", "Accept")
+		if source.MIMEType != "" {
+			w.Header().Set("Content-Type", source.MIMEType)
+		}
+		var reader ReadSeekerCloser
+		if source.Format == originalFormat {
+			var err error
+			reader, err = svc.streamTrack(&post)
+			switch {
+			case errors.Is(err, os.ErrNotExist), errors.Is(err, errProviderUnsupported): // i.e., not downloaded yet
+				svc.error404Page(box)(w, r)
+				return
+			case err != nil:
+				svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+				return
+			}
+		} else {
+			reader = svc.ipfs.cat(source.IPFSCID, source.SizeBytes)
+		}
 		defer reader.Close()
 		http.ServeContent(w, r, "", time.Time{}, reader)
 	}
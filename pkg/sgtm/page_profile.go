@@ -39,7 +39,7 @@ func (svc *Service) profilePage(box *packr.Box) func(w http.ResponseWriter, r *h
 		// tracking
 		{
 			viewEvent := sgtmpb.Post{AuthorID: data.UserID, Kind: sgtmpb.Post_ViewProfileKind, TargetUserID: data.Profile.User.ID}
-			if err := svc.rwdb().Create(&viewEvent).Error; err != nil {
+			if err := svc.createActivity(&viewEvent); err != nil {
 				data.Error = "Cannot write activity: " + err.Error()
 			} else {
 				svc.logger.Debug("new view profile", zap.Any("event", &viewEvent))
@@ -59,16 +59,19 @@ func (svc *Service) profilePage(box *packr.Box) func(w http.ResponseWriter, r *h
 				data.Error = "Cannot fetch last tracks: " + err.Error()
 			}
 			if data.Profile.Stats.Tracks > 0 {
-				if err := query.
-					Order("sort_date desc").
-					Limit(100). // FIXME: pagination
-					Find(&data.Profile.LastTracks).
-					Error; err != nil {
+				data.Profile.LastTracks, data.Profile.NextCursor, err = svc.postList(&sgtmpb.PostList_Request{
+					AuthorID: data.Profile.User.ID,
+					Limit:    100,
+					Cursor:   r.URL.Query().Get("cursor"),
+					// the author can see their unavailable tracks
+					IncludeUnavailable: data.User != nil && data.User.ID == data.Profile.User.ID,
+				})
+				if err != nil {
 					data.Error = "Cannot fetch last tracks: " + err.Error()
 				}
 			}
 			for _, track := range data.Profile.LastTracks {
-				track.ApplyDefaults()
+				applyPostDefaults(track)
 			}
 		}
 
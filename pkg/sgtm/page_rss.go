@@ -21,21 +21,13 @@ func (svc *Service) rssPage(box *packr.Box) func(w http.ResponseWriter, r *http.
 		w.Header().Add("Content-Type", "application/xml")
 		// last tracks
 		{
-			if err := svc.rodb().
-				Model(&sgtmpb.Post{}).
-				Preload("Author").
-				Where(sgtmpb.Post{
-					Kind:       sgtmpb.Post_TrackKind,
-					Visibility: sgtmpb.Visibility_Public,
-				}).
-				Order("sort_date desc").
-				Limit(50). // FIXME: pagination
-				Find(&data.RSS.LastTracks).
-				Error; err != nil {
+			data.RSS.LastTracks, _, err = svc.postList(&sgtmpb.PostList_Request{Limit: 50})
+			if err != nil {
 				svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+				return
 			}
-			for _, track := range data.Home.LastTracks {
-				track.ApplyDefaults()
+			for _, track := range data.RSS.LastTracks {
+				applyPostDefaults(track)
 			}
 		}
 		// end of custom
@@ -1,7 +1,9 @@
 package sgtm
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,11 +22,45 @@ func (svc *Service) settingsPage(box *packr.Box) func(w http.ResponseWriter, r *
 		}
 		// custom
 		data.PageKind = "settings"
-		if data.User == nil {
-			http.Redirect(w, r, "/", http.StatusTemporaryRedirect)
+		switch {
+		case r.Method == "POST" && r.FormValue("action") == "create-api-token":
+			_, secret, err := svc.createAPIToken(data.User, r.Form.Get("token_name"), r.Form["token_scopes"])
+			var inputErr postInputError
+			switch {
+			case errors.As(err, &inputErr):
+				data.Error = inputErr.Error()
+			case err != nil:
+				svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+				return
+			default:
+				// the secret is only displayed once, so we render the page instead of redirecting
+				data.Settings.NewAPITokenSecret = secret
+			}
+		case r.Method == "POST" && r.FormValue("action") == "import-soundcloud":
+			_, err := svc.importSoundCloudCatalog(data.User)
+			var inputErr postInputError
+			switch {
+			case errors.As(err, &inputErr):
+				data.Error = inputErr.Error()
+			case err != nil:
+				svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+				return
+			default:
+				http.Redirect(w, r, "/settings#soundcloud-import", http.StatusFound)
+				return
+			}
+		case r.Method == "POST" && r.FormValue("action") == "revoke-api-token":
+			tokenID, err := strconv.ParseInt(r.Form.Get("token_id"), 10, 64)
+			if err == nil {
+				err = svc.revokeAPIToken(data.User.ID, tokenID)
+			}
+			if err != nil {
+				svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+				return
+			}
+			http.Redirect(w, r, "/settings", http.StatusFound)
 			return
-		}
-		if r.Method == "POST" {
+		case r.Method == "POST":
 			validate := func() map[string]interface{} {
 				if err := r.ParseForm(); err != nil {
 					data.Error = err.Error()
@@ -65,6 +101,22 @@ func (svc *Service) settingsPage(box *packr.Box) func(w http.ResponseWriter, r *
 				return
 			}
 		}
+
+		// API tokens
+		{
+			data.Settings.APITokenScopes = apiTokenScopes
+			data.Settings.APITokens, err = svc.listAPITokens(data.User.ID)
+			if err != nil {
+				data.Error = "Cannot fetch API tokens: " + err.Error()
+			}
+		}
+		// SoundCloud import
+		{
+			data.Settings.SoundCloudImport, err = svc.soundcloudCatalogImport(data.User.ID)
+			if err != nil {
+				data.Error = "Cannot fetch SoundCloud import: " + err.Error()
+			}
+		}
 		// end of custom
 		if svc.opts.DevMode {
 			tmpl = loadTemplates(box, "base.tmpl.html", "settings.tmpl.html")
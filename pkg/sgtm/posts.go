@@ -0,0 +1,276 @@
+package sgtm
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+var errPermissionDenied = errors.New("permission denied")
+
+// postInputError is an error caused by the user input; its message is safe to display as-is.
+type postInputError string
+
+func (e postInputError) Error() string { return string(e) }
+
+// errPostAlreadyExists is returned when a new track points to an already published link or file.
+type errPostAlreadyExists struct {
+	Post *sgtmpb.Post
+}
+
+func (e errPostAlreadyExists) Error() string {
+	return fmt.Sprintf("this track already exists: %s", e.Post.CanonicalURL())
+}
+
+// postErrorToStatus converts the errors returned by the post helpers to gRPC status errors.
+func postErrorToStatus(err error) error {
+	var (
+		inputErr  postInputError
+		existsErr errPostAlreadyExists
+	)
+	switch {
+	case errors.Is(err, errPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.As(err, &existsErr):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.As(err, &inputErr):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return err
+	}
+}
+
+func isAdmin(user *sgtmpb.User) bool {
+	return user != nil && user.Role == roleAdmin
+}
+
+// canEditPost returns true if the user is the author of the post or an admin.
+func canEditPost(user *sgtmpb.User, post *sgtmpb.Post) bool {
+	return user != nil && (isAdmin(user) || user.ID == post.AuthorID)
+}
+
+// whereTrackSlug filters a query on a track, identified by its ID or by its slug.
+func whereTrackSlug(query *gorm.DB, postSlug string) *gorm.DB {
+	id, err := strconv.ParseInt(postSlug, 10, 64)
+	if err == nil {
+		return query.Where(sgtmpb.Post{ID: id, Kind: sgtmpb.Post_TrackKind})
+	}
+	return query.Where(sgtmpb.Post{Slug: postSlug, Kind: sgtmpb.Post_TrackKind})
+}
+
+// newTrackFromURL returns an unsaved track for a link to a supported provider,
+// or an unsaved album with its tracks for a SoundCloud set.
+func (svc *Service) newTrackFromURL(authorID int64, link string) (*sgtmpb.Post, error) {
+	post := sgtmpb.Post{
+		Kind:          sgtmpb.Post_TrackKind,
+		Visibility:    sgtmpb.Visibility_Public,
+		AuthorID:      authorID,
+		Slug:          "",
+		ProviderTitle: "",
+		SortDate:      time.Now().UnixNano(),
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, postInputError(fmt.Sprintf("Parse URL: %s", err.Error()))
+	}
+	if err := svc.importTrack(&post, u); err != nil {
+		return nil, err
+	}
+	return &post, nil
+}
+
+// newTrackFromUpload streams a file to IPFS and returns an unsaved track.
+func (svc *Service) newTrackFromUpload(authorID int64, file io.Reader, filename string, mimeType string, size int64) (*sgtmpb.Post, error) {
+	cid, err := svc.ipfs.add(file)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if track already exists
+	{
+		var alreadyExists sgtmpb.Post
+		err := svc.rodb().
+			Model(&sgtmpb.Post{}).
+			Where(sgtmpb.Post{IPFSCID: cid}).
+			First(&alreadyExists).
+			Error
+		if err == nil && alreadyExists.ID != 0 {
+			return nil, errPostAlreadyExists{Post: &alreadyExists}
+		}
+	}
+
+	// FIXME: check that mimeType starts with audio/ maybe
+	// TODO: run mimetype detection on file maybe
+	filenameParts := strings.Split(filename, ".")
+	ext := "blob"
+	title := filename
+	numParts := len(filenameParts)
+	if numParts >= 2 {
+		ext = filenameParts[numParts-1]
+		title = strings.Join(filenameParts[:numParts-1], ".")
+	}
+
+	return &sgtmpb.Post{
+		Kind:               sgtmpb.Post_TrackKind,
+		Visibility:         sgtmpb.Visibility_Public,
+		AuthorID:           authorID,
+		Slug:               "",
+		ProviderTitle:      title,
+		SortDate:           time.Now().UnixNano(),
+		URL:                "https://ipfs.io/ipfs/" + cid,
+		Provider:           sgtmpb.Provider_IPFS,
+		IPFSCID:            cid,
+		MIMEType:           mimeType,
+		SizeBytes:          size,
+		FileExtension:      ext,
+		AttachmentFilename: filename,
+	}, nil
+}
+
+func (svc *Service) createPost(post *sgtmpb.Post) error {
+	if post.Kind == sgtmpb.Post_AlbumKind {
+		return svc.createAlbum(post)
+	}
+	if err := svc.rwdb().Create(post).Error; err != nil {
+		return err
+	}
+	svc.logger.Debug("new post", zap.Any("post", post))
+	svc.publishActivity(post)
+	if post.IsPendingImport() {
+		if _, err := svc.enqueueJob(sgtmpb.Job_ImportAudioURLKind, post.ID, nil); err != nil {
+			return err
+		}
+		return nil
+	}
+	svc.enqueueTrackProcessing(post)
+	return nil
+}
+
+// enqueueTrackProcessing wakes the processing worker for a new or updated track;
+// on failure, the track will still be picked by the next processing loop.
+func (svc *Service) enqueueTrackProcessing(post *sgtmpb.Post) {
+	if post.Kind != sgtmpb.Post_TrackKind {
+		return
+	}
+	if _, err := svc.enqueueJob(sgtmpb.Job_ProcessTrackKind, post.ID, nil); err != nil {
+		svc.logger.Warn("failed to enqueue track processing", zap.Int64("id", post.ID), zap.Error(err))
+	}
+}
+
+// updatePost saves the given columns; the keys must be in postEditableFields.
+func (svc *Service) updatePost(post *sgtmpb.Post, fields map[string]interface{}) error {
+	for key := range fields {
+		if !postEditableFields[key] {
+			return postInputError(fmt.Sprintf("Field %q cannot be edited.", key))
+		}
+	}
+	if visibility, ok := fields["visibility"]; ok {
+		switch visibility {
+		case sgtmpb.Visibility_Public, sgtmpb.Visibility_Draft:
+		default:
+			return postInputError(fmt.Sprintf("Invalid visibility: %v.", visibility))
+		}
+	}
+	// FIXME: blacklist, etc
+
+	// remember the edited fields, so the processing worker does not overwrite them
+	{
+		edited := []string{}
+		if post.EditedFields != "" {
+			edited = strings.Split(post.EditedFields, ",")
+		}
+		for _, key := range sortedKeys(fields) {
+			if !post.IsEdited(key) && fmt.Sprint(fields[key]) != fmt.Sprint(postFieldValue(post, key)) {
+				edited = append(edited, key)
+			}
+		}
+		if editedFields := strings.Join(edited, ","); editedFields != post.EditedFields {
+			fields["edited_fields"] = editedFields
+		}
+	}
+
+	if err := svc.rwdb().Model(post).Updates(fields).Error; err != nil {
+		return err
+	}
+	svc.logger.Debug("post updated", zap.Int64("id", post.ID), zap.Any("fields", fields))
+	svc.enqueueTrackProcessing(post)
+	return nil
+}
+
+// postEditableFields are the columns an author can update, by their proto field name.
+var postEditableFields = map[string]bool{
+	"title":      true,
+	"body":       true,
+	"lyrics":     true,
+	"visibility": true,
+}
+
+// postFieldValue returns the current value of an editable field.
+func postFieldValue(post *sgtmpb.Post, key string) interface{} {
+	switch key {
+	case "title":
+		return post.Title
+	case "body":
+		return post.Body
+	case "lyrics":
+		return post.Lyrics
+	case "visibility":
+		return post.Visibility
+	}
+	return nil
+}
+
+// deletePost deletes a post with its relationships, its comments and views, and the records of its processing;
+// the tracks of a deleted album are kept, without album.
+func (svc *Service) deletePost(post *sgtmpb.Post) error {
+	err := svc.rwdb().Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Where("source_post_id = ? OR target_post_id = ?", post.ID, post.ID).
+			Delete(&sgtmpb.Relationship{}).
+			Error; err != nil {
+			return err
+		}
+		// comments and view events
+		if err := tx.Where("target_post_id = ?", post.ID).Delete(&sgtmpb.Post{}).Error; err != nil {
+			return err
+		}
+		for _, model := range []interface{}{
+			&sgtmpb.Rendition{},
+			&sgtmpb.Waveform{},
+			&sgtmpb.Fingerprint{},
+			&sgtmpb.FingerprintHash{},
+			&sgtmpb.MigrationRun{},
+			&sgtmpb.Job{},
+		} {
+			if err := tx.Where("post_id = ?", post.ID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.
+			Model(&sgtmpb.Post{}).
+			Where("album_id = ?", post.ID).
+			Updates(map[string]interface{}{"album_id": 0, "album_position": 0}).
+			Error; err != nil {
+			return err
+		}
+		return tx.Delete(post).Error
+	})
+	if err != nil {
+		return err
+	}
+	svc.logger.Debug("post deleted", zap.Int64("id", post.ID))
+	return nil
+}
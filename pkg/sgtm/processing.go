@@ -12,6 +12,7 @@ import (
 	"os/exec"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"moul.io/sgtm/pkg/sgtmpb"
@@ -24,34 +25,58 @@ type Download struct {
 	YoutubeDL     YoutubeDLOutput
 }
 
+// downloadTimeout is the timeout of youtube-dl when downloading the audio of a track, in the processing worker.
+const downloadTimeout = 10 * time.Minute
+
+// downloadLocks serializes the downloads of a post, so the files of dl/ are not written concurrently.
+var downloadLocks sync.Map // post ID -> *sync.Mutex
+
+// DownloadPost downloads the audio of a post with youtube-dl, unless it was already downloaded.
+// It can take minutes, and must only be called by the processing worker.
 func DownloadPost(post *sgtmpb.Post, force bool) (*Download, error) {
-	download := Download{
-		YoutubeDLFile: fmt.Sprintf("dl/%d.info.json", post.ID),
+	// the providers hosting the audio themselves, like IPFS, do not download their tracks
+	if post.URL == "" {
+		return nil, fmt.Errorf("post %d has no link to download", post.ID)
 	}
-	if post.Provider == sgtmpb.Provider_SoundCloud {
-		download.URL = post.URL
-	}
-	if download.URL == "" {
-		return nil, fmt.Errorf("unsupported post")
+
+	lock, _ := downloadLocks.LoadOrStore(post.ID, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	if !force {
+		if existing, err := DownloadedPost(post); err == nil {
+			return existing, nil
+		}
 	}
 
 	// wrap youtube-dl
-	if force || !pathExists(download.YoutubeDLFile) {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-		cmd := exec.CommandContext(
-			ctx,
-			"youtube-dl",
-			"--write-info-json",
-			"-o", fmt.Sprintf("dl/%d.%%(ext)s", post.ID),
-			download.URL,
-		)
-		if err := cmd.Run(); err != nil {
-			return nil, err
-		}
+	ctx, cancel := context.WithTimeout(context.Background(), downloadTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(
+		ctx,
+		"youtube-dl",
+		"--write-info-json",
+		"--no-playlist",
+		"-f", "bestaudio",
+		"-o", fmt.Sprintf("dl/%d.%%(ext)s", post.ID),
+		"--", // the link is never read as an option
+		post.URL,
+	)
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+	return DownloadedPost(post)
+}
+
+// DownloadedPost returns the download of a post made by DownloadPost, or an error wrapping os.ErrNotExist
+// if the audio was not downloaded yet.
+func DownloadedPost(post *sgtmpb.Post) (*Download, error) {
+	download := Download{
+		URL:           post.URL,
+		YoutubeDLFile: fmt.Sprintf("dl/%d.info.json", post.ID),
 	}
 
-	// read manifest file
+	// read manifest file, written before the audio
 	{
 		f, err := os.Open(download.YoutubeDLFile)
 		if err != nil {
@@ -65,6 +90,9 @@ func DownloadPost(post *sgtmpb.Post, force bool) (*Download, error) {
 	}
 
 	download.Path = fmt.Sprintf("dl/%d.%s", post.ID, download.YoutubeDL.Ext)
+	if !pathExists(download.Path) {
+		return nil, fmt.Errorf("%s: %w", download.Path, os.ErrNotExist)
+	}
 	return &download, nil
 }
 
@@ -73,16 +101,13 @@ type ReadSeekerCloser interface {
 	io.ReadCloser
 }
 
-func StreamPost(ipfs *ipfsWrapper, post *sgtmpb.Post) (ReadSeekerCloser, error) {
-	if post.Provider != sgtmpb.Provider_IPFS {
-		return nil, fmt.Errorf("provider %q not supported", post.Provider.String())
-	}
-	return ipfs.cat(post.IPFSCID, post.SizeBytes), nil
-}
-
 func ExtractBPM(p string) (float64, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
+	return extractBPMContext(ctx, p)
+}
+
+func extractBPMContext(ctx context.Context, p string) (float64, error) {
 	cmd := exec.CommandContext(
 		ctx,
 		"sonic-annotator",
@@ -126,6 +151,7 @@ func ExtractBPM(p string) (float64, error) {
 }
 
 type YoutubeDLOutput struct {
+	Type               string      `json:"_type"` // "playlist" for the playlists, empty for the medias
 	Extractor          string      `json:"extractor"`
 	Protocol           string      `json:"protocol"`
 	UploadDate         string      `json:"upload_date"`
@@ -150,6 +176,7 @@ type YoutubeDLOutput struct {
 	Preference         interface{} `json:"preference"`
 	Uploader           string      `json:"uploader"`
 	Genre              string      `json:"genre"`
+	Tags               []string    `json:"tags"`
 	FormatID           string      `json:"format_id"`
 	UploaderID         string      `json:"uploader_id"`
 	Thumbnails         []struct {
@@ -0,0 +1,206 @@
+package sgtm
+
+import (
+	"sort"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// processingFailureFilter selects the processing failures to retry or to clear; the filters are combined.
+type processingFailureFilter struct {
+	Migration string
+	Error     string
+	PostID    int64
+	UserID    int64
+	// All is required when no filter is set, to avoid acting on every failure by mistake.
+	All bool
+}
+
+func (f processingFailureFilter) validate() error {
+	if f.Migration == "" && f.Error == "" && f.PostID == 0 && f.UserID == 0 && !f.All {
+		return postInputError("A filter or all is required.")
+	}
+	return nil
+}
+
+// failedRuns returns a query on the failed migration runs of the tracks; the cleared errors are excluded.
+func (svc *Service) failedRuns(db *gorm.DB, f processingFailureFilter) *gorm.DB {
+	tracks := db.
+		Model(&sgtmpb.Post{}).
+		Select("id").
+		Where(sgtmpb.Post{Kind: sgtmpb.Post_TrackKind})
+	if f.UserID != 0 {
+		tracks = tracks.Where("author_id = ?", f.UserID)
+	}
+	query := db.
+		Model(&sgtmpb.MigrationRun{}).
+		Where("state = ? AND last_error != ?", sgtmpb.MigrationRun_FailedState, "").
+		Where("name IN ?", svc.trackMigrationNames()).
+		Where("post_id IN (?)", tracks)
+	if f.Migration != "" {
+		query = query.Where("name = ?", f.Migration)
+	}
+	if f.Error != "" {
+		query = query.Where("last_error = ?", f.Error)
+	}
+	if f.PostID != 0 {
+		query = query.Where("post_id = ?", f.PostID)
+	}
+	return query
+}
+
+// failedUsers returns a query on the users with a processing error, or nil when the filter only targets tracks.
+func (svc *Service) failedUsers(db *gorm.DB, f processingFailureFilter) *gorm.DB {
+	if f.Migration != "" || f.PostID != 0 {
+		return nil
+	}
+	query := db.
+		Model(&sgtmpb.User{}).
+		Where("processing_error != ?", "")
+	if f.Error != "" {
+		query = query.Where("processing_error = ?", f.Error)
+	}
+	if f.UserID != 0 {
+		query = query.Where("id = ?", f.UserID)
+	}
+	return query
+}
+
+// processingFailures returns the tracks and the users with a processing error, grouped by migration and error.
+func (svc *Service) processingFailures() ([]*sgtmpb.ProcessingFailure, error) {
+	type groupKey struct{ migration, error string }
+	groups := map[groupKey]*sgtmpb.ProcessingFailure{}
+	group := func(migration, err string) *sgtmpb.ProcessingFailure {
+		key := groupKey{migration, err}
+		if _, found := groups[key]; !found {
+			groups[key] = &sgtmpb.ProcessingFailure{Migration: migration, Error: err}
+		}
+		return groups[key]
+	}
+
+	// tracks
+	{
+		var runs []*sgtmpb.MigrationRun
+		if err := svc.failedRuns(svc.rodb(), processingFailureFilter{}).Order("post_id").Find(&runs).Error; err != nil {
+			return nil, err
+		}
+		ids := make([]int64, 0, len(runs))
+		for _, run := range runs {
+			ids = append(ids, run.PostID)
+		}
+		var posts []*sgtmpb.Post
+		if len(ids) > 0 {
+			if err := svc.rodb().Preload("Author").Where("id IN (?)", ids).Find(&posts).Error; err != nil {
+				return nil, err
+			}
+		}
+		byID := map[int64]*sgtmpb.Post{}
+		for _, post := range posts {
+			post.Filter()
+			post.Author.Filter()
+			byID[post.ID] = post
+		}
+		for _, run := range runs {
+			if post, found := byID[run.PostID]; found {
+				failure := group(run.Name, run.LastError)
+				failure.Posts = append(failure.Posts, post)
+			}
+		}
+	}
+
+	// users
+	{
+		var users []*sgtmpb.User
+		if err := svc.failedUsers(svc.rodb(), processingFailureFilter{}).Order("id").Find(&users).Error; err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			failure := group("", user.ProcessingError)
+			user.Filter()
+			failure.Users = append(failure.Users, user)
+		}
+	}
+
+	ret := make([]*sgtmpb.ProcessingFailure, 0, len(groups))
+	for _, failure := range groups {
+		ret = append(ret, failure)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		a, b := ret[i], ret[j]
+		if na, nb := len(a.Posts)+len(a.Users), len(b.Posts)+len(b.Users); na != nb {
+			return na > nb
+		}
+		if a.Migration != b.Migration {
+			return a.Migration < b.Migration
+		}
+		return a.Error < b.Error
+	})
+	return ret, nil
+}
+
+// retryProcessingFailures schedules the failed migrations to run again, and returns the number of tracks.
+// The users have no migrations yet, so their errors can only be cleared.
+func (svc *Service) retryProcessingFailures(f processingFailureFilter) (int64, error) {
+	if err := f.validate(); err != nil {
+		return 0, err
+	}
+	var runs []*sgtmpb.MigrationRun
+	if err := svc.failedRuns(svc.rodb(), f).Order("post_id").Find(&runs).Error; err != nil {
+		return 0, err
+	}
+	tracks := map[int64]bool{}
+	for _, run := range runs {
+		if _, err := svc.enqueueJob(sgtmpb.Job_ProcessTrackKind, run.PostID, processTrackPayload{Migration: run.Name}); err != nil {
+			return 0, err
+		}
+		tracks[run.PostID] = true
+	}
+	svc.logger.Debug("processing failures retried", zap.Int("runs", len(runs)), zap.Int("tracks", len(tracks)))
+	return int64(len(tracks)), nil
+}
+
+// clearProcessingFailures hides the errors until the next failure, without scheduling anything,
+// and returns the number of tracks and users cleared.
+func (svc *Service) clearProcessingFailures(f processingFailureFilter) (int64, int64, error) {
+	if err := f.validate(); err != nil {
+		return 0, 0, err
+	}
+	var clearedTracks, clearedUsers int64
+	err := svc.rwdb().Transaction(func(tx *gorm.DB) error {
+		var ids []int64
+		if err := svc.failedRuns(tx, f).Distinct("post_id").Pluck("post_id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) > 0 {
+			if err := svc.failedRuns(tx, f).Update("last_error", "").Error; err != nil {
+				return err
+			}
+		}
+		for _, id := range ids {
+			runs, err := svc.trackMigrationRuns(tx, id)
+			if err != nil {
+				return err
+			}
+			if err := svc.saveProcessingState(tx, &sgtmpb.Post{ID: id}, runs); err != nil {
+				return err
+			}
+		}
+		clearedTracks = int64(len(ids))
+
+		if users := svc.failedUsers(tx, f); users != nil {
+			result := users.Update("processing_error", "")
+			if result.Error != nil {
+				return result.Error
+			}
+			clearedUsers = result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	svc.logger.Debug("processing failures cleared", zap.Int64("tracks", clearedTracks), zap.Int64("users", clearedUsers))
+	return clearedTracks, clearedUsers, nil
+}
@@ -0,0 +1,128 @@
+package sgtm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+func TestProcessingFailures(t *testing.T) {
+	svc := TestingService(t)
+	client := testingClient(t, &svc)
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	someone := sgtmpb.User{Email: "someone@example.com", Slug: "someone", ProcessingError: "avatar: not found"}
+	require.NoError(t, svc.rwdb().Create(&someone).Error)
+	admin := sgtmpb.User{Email: "admin@example.com", Slug: "admin", Role: roleAdmin}
+	require.NoError(t, svc.rwdb().Create(&admin).Error)
+	tracks := map[string]*sgtmpb.Post{}
+	for _, title := range []string{"good", "corrupted", "truncated", "slow"} {
+		track := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Public, Title: title}
+		require.NoError(t, svc.rwdb().Create(&track).Error)
+		tracks[title] = &track
+	}
+
+	failures := map[string]string{"corrupted": "decode", "truncated": "decode", "slow": "timeout"}
+	svc.processingWorker.trackMigrations = []trackMigration{
+		{Name: "decode", Run: func(_ *Service, post *sgtmpb.Post, _ *gorm.DB) (func(*gorm.DB) error, error) {
+			if failures[post.Title] == "decode" {
+				return nil, fmt.Errorf("invalid data")
+			}
+			return nil, nil
+		}},
+		{Name: "analyze", Run: func(_ *Service, post *sgtmpb.Post, _ *gorm.DB) (func(*gorm.DB) error, error) {
+			if failures[post.Title] == "timeout" {
+				return nil, fmt.Errorf("deadline exceeded")
+			}
+			return nil, nil
+		}},
+	}
+	process := func() {
+		require.NoError(t, svc.enqueueOutdatedTracks())
+		require.NoError(t, svc.processingLoop(0))
+	}
+	reload := func(post *sgtmpb.Post) *sgtmpb.Post {
+		var ret sgtmpb.Post
+		require.NoError(t, svc.rodb().First(&ret, post.ID).Error)
+		return &ret
+	}
+	adminCtx := testingAuthContext(t, &svc, admin.ID)
+	list := func() []string {
+		ret, err := client.ProcessingFailureList(adminCtx, &sgtmpb.ProcessingFailureList_Request{})
+		require.NoError(t, err)
+		groups := []string{}
+		for _, failure := range ret.Failures {
+			entries := []string{}
+			for _, post := range failure.Posts {
+				require.Equal(t, "author", post.Author.Slug)
+				entries = append(entries, post.Title)
+			}
+			for _, user := range failure.Users {
+				require.Empty(t, user.Email)
+				entries = append(entries, "@"+user.Slug)
+			}
+			groups = append(groups, fmt.Sprintf("%s: %s %v", failure.Migration, failure.Error, entries))
+		}
+		return groups
+	}
+
+	process()
+	require.Equal(t, []string{
+		"decode: invalid data [corrupted truncated]",
+		": avatar: not found [@someone]",
+		"analyze: deadline exceeded [slow]",
+	}, list())
+	require.Equal(t, "decode: invalid data", reload(tracks["corrupted"]).ProcessingError)
+
+	// admins only
+	_, err := client.ProcessingFailureList(testingAuthContext(t, &svc, author.ID), &sgtmpb.ProcessingFailureList_Request{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.ProcessingFailureRetry(adminCtx, &sgtmpb.ProcessingFailureRetry_Request{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// retry a single track
+	delete(failures, "truncated")
+	retried, err := client.ProcessingFailureRetry(adminCtx, &sgtmpb.ProcessingFailureRetry_Request{PostID: tracks["truncated"].ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), retried.QueuedTracks)
+	process()
+	require.Equal(t, "", reload(tracks["truncated"]).ProcessingError)
+	process() // the next migrations run on the next loop
+	require.Equal(t, int64(2), reload(tracks["truncated"]).ProcessingVersion)
+	require.Equal(t, []string{
+		": avatar: not found [@someone]",
+		"analyze: deadline exceeded [slow]",
+		"decode: invalid data [corrupted]",
+	}, list())
+
+	// clear a group
+	cleared, err := client.ProcessingFailureClear(adminCtx, &sgtmpb.ProcessingFailureClear_Request{Migration: "decode", Error: "invalid data"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), cleared.ClearedTracks)
+	require.Zero(t, cleared.ClearedUsers)
+	require.Equal(t, "", reload(tracks["corrupted"]).ProcessingError)
+	require.Equal(t, []string{
+		": avatar: not found [@someone]",
+		"analyze: deadline exceeded [slow]",
+	}, list())
+	process() // not retried until the backoff
+	require.Equal(t, "", reload(tracks["corrupted"]).ProcessingError)
+
+	// retry and clear in bulk
+	delete(failures, "slow")
+	retried, err = client.ProcessingFailureRetry(adminCtx, &sgtmpb.ProcessingFailureRetry_Request{All: true})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), retried.QueuedTracks)
+	process()
+	require.Equal(t, []string{": avatar: not found [@someone]"}, list())
+	cleared, err = client.ProcessingFailureClear(adminCtx, &sgtmpb.ProcessingFailureClear_Request{UserID: someone.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), cleared.ClearedUsers)
+	require.Empty(t, list())
+}
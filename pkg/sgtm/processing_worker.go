@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -12,23 +13,34 @@ import (
 	"moul.io/sgtm/pkg/sgtmpb"
 )
 
+// processingLoopInterval is the maximum pause between two loops of the processing worker.
+const processingLoopInterval = 30 * time.Second
+
 type processingWorkerDriver struct {
-	started bool
-	wg      *sync.WaitGroup
+	lastLoopAt *int64 // unix nano, accessed atomically; allocated on its own to stay 64-bit aligned on 32-bit platforms
+	started    bool
+	wg         *sync.WaitGroup
+	wake       chan struct{}
+
+	trackMigrations []trackMigration
+}
 
-	trackMigrations []func(*sgtmpb.Post, *gorm.DB) error
+func newProcessingWorkerDriver() processingWorkerDriver {
+	return processingWorkerDriver{lastLoopAt: new(int64), wake: make(chan struct{}, 1), trackMigrations: trackMigrations}
 }
 
 func (svc *Service) StartProcessingWorker() error {
 	// init
 	{
 		fmt.Fprintln(os.Stderr, banner.Inline("processing-worker"))
-		svc.logger.Debug("starting processing-worker")
-		svc.setupMigrations()
+		svc.logger.Debug("starting processing-worker", zap.Int("concurrency", svc.opts.ProcessingWorkerConcurrency))
 		svc.processingWorker.wg = &sync.WaitGroup{}
 		svc.processingWorker.wg.Add(1)
 		defer svc.processingWorker.wg.Done()
 		svc.processingWorker.started = true
+		if err := svc.resetRunningJobs(); err != nil {
+			return fmt.Errorf("failed to reset running jobs: %w", err)
+		}
 	}
 
 	// loop
@@ -38,8 +50,8 @@ func (svc *Service) StartProcessingWorker() error {
 		}
 
 		select {
-		// FIXME: add a channel to get instant worker task
-		case <-time.After(30 * time.Second):
+		case <-svc.processingWorker.wake:
+		case <-time.After(svc.nextJobDelay(processingLoopInterval)):
 		case <-svc.ctx.Done():
 			return nil
 		}
@@ -59,102 +71,84 @@ func (svc *Service) processingLoop(i int) error {
 	before := time.Now()
 
 	// track migrations
-	{
-		var outdated []*sgtmpb.Post
-		err := svc.rodb().
-			Where(sgtmpb.Post{Kind: sgtmpb.Post_TrackKind}).
-			Where("processing_error IS NULL OR processing_error == ''").
-			Where("processing_version IS NULL OR processing_version < ?", len(svc.processingWorker.trackMigrations)).
-			Preload("Author").
-			Find(&outdated).
-			Error
-		if err != nil {
-			return fmt.Errorf("failed to fetch tracks that need to be processed: %w", err)
-		}
+	if err := svc.enqueueOutdatedTracks(); err != nil {
+		return err
+	}
 
-		err = svc.rwdb().Transaction(func(tx *gorm.DB) error {
-			for _, entryPtr := range outdated {
-				entry := entryPtr
-				version := 1
-				for _, migration := range svc.processingWorker.trackMigrations {
-					err := migration(entry, tx)
-					if err != nil {
-						entry.ProcessingError = err.Error()
-						break
-					}
-					entry.ProcessingVersion = int64(version)
-					version++
-				}
-				if err := tx.
-					Model(&entry).
-					Updates(map[string]interface{}{
-						"processing_version": entry.ProcessingVersion,
-						"processing_error":   entry.ProcessingError,
-					}).
-					Error; err != nil {
-					return fmt.Errorf("failed to save processing state: %w", err)
-				}
-			}
-			return nil
-		})
-		if err != nil {
-			return fmt.Errorf("failed to run migration: %w", err)
-		}
+	// track maintenance
+	if err := svc.enqueueAvailabilityChecks(); err != nil {
+		return err
 	}
 
 	// TODO: other type migrations
-	// TODO: track maintenance (i.e., daily check if the track still exists on SoundCloud)
 
+	// jobs
+	jobs := 0
+	for svc.ctx.Err() == nil {
+		ran, err := svc.runDueJobs()
+		if err != nil {
+			return err
+		}
+		if ran == 0 {
+			break
+		}
+		jobs += ran
+	}
+
+	atomic.StoreInt64(svc.processingWorker.lastLoopAt, time.Now().UnixNano())
 	svc.logger.Debug("processing loop ended",
 		zap.Duration("duration", time.Since(before)),
 		zap.Int("loop", i),
+		zap.Int("jobs", jobs),
 	)
 	return nil
 }
 
-func (svc *Service) setupMigrations() {
-	svc.processingWorker.trackMigrations = []func(*sgtmpb.Post, *gorm.DB) error{
-		// migrate track.Genre to track.Tags
-		func(post *sgtmpb.Post, tx *gorm.DB) error {
-			if post.Tags != "" || post.Genre == "" { // nolint:staticcheck
-				// nothing to do
-				return nil
-			}
+// trackMigrations are run in this order on every track, like jobHandlers with the service passed at run time.
+var trackMigrations = []trackMigration{
+	// migrate track.Genre to track.Tags
+	{Name: "genre-to-tags", Run: func(_ *Service, post *sgtmpb.Post, _ *gorm.DB) (func(*gorm.DB) error, error) {
+		if post.Tags != "" || post.Genre == "" { // nolint:staticcheck
+			// nothing to do
+			return nil, nil
+		}
+		return func(tx *gorm.DB) error {
 			return tx.Model(post).Updates(map[string]interface{}{
 				"tags":  post.Genre, // nolint:staticcheck
 				"genre": "",
 			}).Error
-		},
+		}, nil
+	}},
 
-		// set SoundCloud provider_title
-		func(post *sgtmpb.Post, tx *gorm.DB) error {
-			if post.GetProvider() != sgtmpb.Provider_SoundCloud || post.ProviderTitle != "" {
-				return nil
-			}
+	// set SoundCloud provider_title
+	{Name: "soundcloud-provider-title", Run: func(_ *Service, post *sgtmpb.Post, _ *gorm.DB) (func(*gorm.DB) error, error) {
+		if post.GetProvider() != sgtmpb.Provider_SoundCloud || post.ProviderTitle != "" {
+			return nil, nil
+		}
 
+		return func(tx *gorm.DB) error {
 			return tx.Model(post).Updates(map[string]interface{}{
 				"provider_title": post.Title,
 				"title":          "",
 			}).Error
-		},
-
-		/*
-			// FIXME: try downloading the mp3 locally
-			func(post *sgtmpb.Post) error { return fmt.Errorf("not implemented") },
-			// FIXME: compute BPM
-			func(post *sgtmpb.Post) error { return fmt.Errorf("not implemented") },
-			// FIXME: extract thumbnail from file metadata
-			func(post *sgtmpb.Post) error { return fmt.Errorf("not implemented") },
-			// FIXME: compute other info with analysis tools
-			func(post *sgtmpb.Post) error { return fmt.Errorf("not implemented") },
-			// FIXME: create MP3 version for uploaded WAV
-			func(post *sgtmpb.Post) error {
-				if post.Provider != sgtmpb.Provider_IPFS {
-					return nil
-				}
-				// if post.mp3_192_cid == "" && format != mp3 { download; compress; upload }
-				return nil
-			},
-		*/
-	}
+		}, nil
+	}},
+
+	// prefill the uploads with the metadata and the artwork embedded in the file
+	{Name: "metadata", Run: (*Service).metadataMigration},
+
+	// compute BPM
+	{Name: "bpm", Run: (*Service).bpmMigration},
+
+	// create MP3 and Opus versions of the lossless uploads
+	{Name: "renditions", Run: (*Service).renditionsMigration},
+
+	// compute the waveform peaks
+	{Name: waveformMigrationName(), Run: (*Service).waveformMigration},
+
+	// measure the loudness and detect the key
+	{Name: analysisMigrationName(), Run: (*Service).analysisMigration},
+
+	// compute the acoustic fingerprint and detect the duplicates
+	{Name: fingerprintMigrationName(), Run: (*Service).fingerprintMigration},
 }
@@ -0,0 +1,226 @@
+package sgtm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/url"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// Provider hosts the audio of the tracks; the supported providers are registered in providers.
+// The providers are stateless: the methods needing the drivers receive the service.
+type Provider interface {
+	// Kind is the value of Post.Provider for the tracks of this provider.
+	Kind() sgtmpb.Provider
+	// MatchURL returns true if the link can be imported by this provider.
+	MatchURL(u *url.URL) bool
+	// Import fills the provider fields of an unsaved track from a link matched by MatchURL.
+	// The errors are postInputError or errPostAlreadyExists.
+	Import(svc *Service, post *sgtmpb.Post, u *url.URL) error
+	// Refresh fetches the provider metadata of a track again; it only updates the given post.
+	Refresh(svc *Service, post *sgtmpb.Post) error
+	// Stream returns the original audio file of a track.
+	Stream(svc *Service, post *sgtmpb.Post) (ReadSeekerCloser, error)
+	// LocalFile returns the path of a local copy of the audio file; the cleanup function must be called when done.
+	LocalFile(svc *Service, post *sgtmpb.Post) (string, func(), error)
+	// Embed returns the HTML player of a track.
+	Embed(post *sgtmpb.Post) template.HTML
+	// OriginalLink returns the page of a track on the provider, or nil if there is none.
+	OriginalLink(post *sgtmpb.Post) *ProviderLink
+	// ApplyDefaults fills the empty display fields of a track loaded from the database.
+	ApplyDefaults(post *sgtmpb.Post)
+}
+
+// ProviderLink is a link to the page of a track on its provider.
+type ProviderLink struct {
+	URL  string
+	Icon string // Font Awesome classes of the provider icon
+}
+
+// newProviderLink returns a link to the page of a track on its provider, or nil if the track has no link.
+func newProviderLink(url string, icon string) *ProviderLink {
+	if url == "" {
+		return nil
+	}
+	return &ProviderLink{URL: url, Icon: icon}
+}
+
+// providers are the registered providers; the links are matched in this order.
+var providers = []Provider{
+	soundcloudProvider{},
+	bandcampProvider{},
+	youtubeProvider{},
+	ipfsProvider{},
+	audioURLProvider{},  // links to audio files, matched before the youtube-dl sites, e.g., an mp3 on archive.org
+	youtubeDLProvider{}, // links to the sites of youtubeDLExtractors
+}
+
+// errProviderUnsupported is returned when a provider cannot do an operation, e.g., streaming a SoundCloud track.
+var errProviderUnsupported = errors.New("not supported by the provider")
+
+// providerOf returns the provider of a track.
+func providerOf(post *sgtmpb.Post) (Provider, error) {
+	for _, provider := range providers {
+		if provider.Kind() == post.GetProvider() {
+			return provider, nil
+		}
+	}
+	return nil, fmt.Errorf("provider %q not supported", post.GetProvider().String())
+}
+
+// hasProvider returns true if the track has a registered provider, i.e., an audio file to process.
+func hasProvider(post *sgtmpb.Post) bool {
+	_, err := providerOf(post)
+	return err == nil
+}
+
+// providerForURL returns the first provider matching a link, or nil.
+func providerForURL(u *url.URL) Provider {
+	for _, provider := range providers {
+		if provider.MatchURL(u) {
+			return provider
+		}
+	}
+	return nil
+}
+
+// importTrack fills an unsaved track from a link to a supported provider.
+func (svc *Service) importTrack(post *sgtmpb.Post, u *url.URL) error {
+	provider := providerForURL(u)
+	if provider == nil {
+		return postInputError(fmt.Sprintf("Unsupported provider: %s.", u.Host))
+	}
+	if err := provider.Import(svc, post, u); err != nil {
+		return err
+	}
+	post.Provider = provider.Kind()
+	if post.ProviderCreatedAt != 0 {
+		post.SortDate = post.ProviderCreatedAt
+	}
+	return nil
+}
+
+// streamTrack returns the original audio file of a track.
+func (svc *Service) streamTrack(post *sgtmpb.Post) (ReadSeekerCloser, error) {
+	provider, err := providerOf(post)
+	if err != nil {
+		return nil, err
+	}
+	return provider.Stream(svc, post)
+}
+
+// localTrackFile returns the path of a local copy of the audio file of a track;
+// the cleanup function must be called when done.
+func (svc *Service) localTrackFile(post *sgtmpb.Post) (string, func(), error) {
+	provider, err := providerOf(post)
+	if err != nil {
+		return "", nil, err
+	}
+	return provider.LocalFile(svc, post)
+}
+
+// originalLink returns the page of a track on its provider, or nil.
+func originalLink(post *sgtmpb.Post) *ProviderLink {
+	provider, err := providerOf(post)
+	if err != nil {
+		return nil
+	}
+	return provider.OriginalLink(post)
+}
+
+// embedTrack returns the HTML player of a track, or nothing if its provider is not supported.
+func embedTrack(post *sgtmpb.Post) template.HTML {
+	provider, err := providerOf(post)
+	if err != nil {
+		return ""
+	}
+	return provider.Embed(post)
+}
+
+// applyPostDefaults fills the empty display fields of a post loaded from the database.
+func applyPostDefaults(post *sgtmpb.Post) {
+	if provider, err := providerOf(post); err == nil {
+		provider.ApplyDefaults(post)
+	}
+}
+
+// syncPost re-fetches the provider metadata of a track, saves it with db and returns the DB columns that changed.
+func (svc *Service) syncPost(db *gorm.DB, post *sgtmpb.Post) (map[string]interface{}, error) {
+	provider, err := providerOf(post)
+	if err != nil {
+		return nil, err
+	}
+	refreshed := proto.Clone(post).(*sgtmpb.Post)
+	if err := provider.Refresh(svc, refreshed); err != nil {
+		return nil, err
+	}
+
+	changes := map[string]interface{}{}
+	if refreshed.ProviderTitle != post.ProviderTitle {
+		changes["provider_title"] = refreshed.ProviderTitle
+	}
+	if refreshed.ProviderDescription != post.ProviderDescription {
+		changes["provider_description"] = refreshed.ProviderDescription
+	}
+	if refreshed.Artist != post.Artist {
+		changes["artist"] = refreshed.Artist
+	}
+	if refreshed.Lyrics != post.Lyrics {
+		changes["lyrics"] = refreshed.Lyrics
+	}
+	if refreshed.ArtworkURL != post.ArtworkURL {
+		changes["artwork_url"] = refreshed.ArtworkURL
+	}
+	if refreshed.Tags != post.Tags {
+		changes["tags"] = refreshed.Tags
+	}
+	if refreshed.Duration != post.Duration {
+		changes["duration"] = refreshed.Duration
+	}
+	if refreshed.BPM != post.BPM {
+		changes["bpm"] = refreshed.BPM
+	}
+	if refreshed.ProviderMetadata != post.ProviderMetadata {
+		changes["provider_metadata"] = refreshed.ProviderMetadata
+	}
+	if refreshed.ProviderCreatedAt != post.ProviderCreatedAt {
+		changes["provider_created_at"] = refreshed.ProviderCreatedAt
+	}
+	if refreshed.URL != post.URL {
+		changes["url"] = refreshed.URL
+	}
+	if refreshed.DownloadURL != post.DownloadURL {
+		changes["download_url"] = refreshed.DownloadURL
+	}
+	if refreshed.ISRC != post.ISRC {
+		changes["isrc"] = refreshed.ISRC
+	}
+	if refreshed.KeySignature != post.KeySignature {
+		changes["key_signature"] = refreshed.KeySignature
+	}
+	if len(changes) == 0 {
+		return changes, nil
+	}
+
+	changes["provider_updated_at"] = time.Now().UnixNano()
+	// the saved columns are also set on the post; the other fields are left untouched
+	if err := db.Model(post).Updates(changes).Error; err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// executeEmbed renders the player of a track with a provider template.
+func executeEmbed(tmpl *template.Template, post *sgtmpb.Post) template.HTML {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, post); err != nil {
+		return ""
+	}
+	return template.HTML(buf.String())
+}
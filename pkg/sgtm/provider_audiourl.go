@@ -0,0 +1,311 @@
+package sgtm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+const (
+	// audioURLMaxSyncSize is the maximum size of the files imported during the request; the larger ones are
+	// imported by the processing worker.
+	audioURLMaxSyncSize = 8 << 20
+	audioURLMaxSize     = 500 << 20
+	audioURLSyncTimeout = 4 * time.Second // below the default request timeout
+	audioURLJobTimeout  = 30 * time.Minute
+)
+
+// audioURLMIMETypes are the supported file extensions, with their MIME type when the server does not send one.
+var audioURLMIMETypes = map[string]string{
+	"aac":  "audio/aac",
+	"aif":  "audio/aiff",
+	"aiff": "audio/aiff",
+	"flac": "audio/flac",
+	"m4a":  "audio/mp4",
+	"mp3":  "audio/mpeg",
+	"oga":  "audio/ogg",
+	"ogg":  "audio/ogg",
+	"opus": "audio/ogg; codecs=opus",
+	"wav":  "audio/wav",
+}
+
+var audioURLClient = newPublicHTTPClient()
+
+// audioURLProvider imports the audio files linked directly, i.e., https://example.com/track.wav, and pins them to IPFS.
+// The resulting tracks are normal IPFS tracks, with their link in SourceURL.
+type audioURLProvider struct{ ipfsProvider }
+
+func (audioURLProvider) MatchURL(u *url.URL) bool {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return false
+	}
+	_, found := audioURLMIMETypes[audioURLExtension(u)]
+	return found
+}
+
+func (audioURLProvider) Import(svc *Service, post *sgtmpb.Post, u *url.URL) error {
+	link := u.String()
+
+	// check if link was already imported
+	{
+		var alreadyExists sgtmpb.Post
+		err := svc.rodb().
+			Model(&sgtmpb.Post{}).
+			Where(sgtmpb.Post{SourceURL: link}).
+			First(&alreadyExists).
+			Error
+		if err == nil && alreadyExists.ID != 0 {
+			return errPostAlreadyExists{Post: &alreadyExists}
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(svc.ctx, audioURLSyncTimeout)
+	defer cancel()
+	mimeType, size, err := probeAudioURL(ctx, u)
+	if err != nil {
+		return err
+	}
+	filename, _ := url.PathUnescape(path.Base(u.Path))
+	post.ProviderTitle = strings.TrimSuffix(filename, path.Ext(filename))
+	post.AttachmentFilename = filename
+	post.FileExtension = audioURLExtension(u)
+	post.MIMEType = mimeType
+	post.SizeBytes = size
+	post.SourceURL = link
+	if size < 0 || size > audioURLMaxSyncSize {
+		post.SizeBytes = 0
+		return nil // pending, see importAudioURLJob
+	}
+
+	file, err := downloadAudioURL(ctx, u, audioURLMaxSyncSize)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Path)
+	cid, err := svc.pinAudioFile(svc.rodb(), file.Path, 0)
+	if err != nil {
+		return err
+	}
+	post.IPFSCID = cid
+	post.URL = "https://ipfs.io/ipfs/" + cid
+	post.SizeBytes = file.Size
+	return nil
+}
+
+func audioURLExtension(u *url.URL) string {
+	return strings.ToLower(strings.TrimPrefix(path.Ext(u.Path), "."))
+}
+
+// checkAudioContentType returns the MIME type of an audio file from the headers of its response.
+func checkAudioContentType(res *http.Response) (string, error) {
+	mediaType, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	switch {
+	case strings.HasPrefix(mediaType, "audio/"):
+		return res.Header.Get("Content-Type"), nil
+	case mediaType == "", mediaType == "application/octet-stream", mediaType == "binary/octet-stream", mediaType == "application/ogg":
+		return audioURLMIMETypes[audioURLExtension(res.Request.URL)], nil
+	default:
+		return "", postInputError(fmt.Sprintf("This link is not an audio file (%s).", mediaType))
+	}
+}
+
+// probeAudioURL checks a link without downloading it, and returns the MIME type and the size of the file,
+// or -1 if the server does not send it.
+func probeAudioURL(ctx context.Context, u *url.URL) (string, int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, u.String(), nil)
+	if err != nil {
+		return "", 0, postInputError(fmt.Sprintf("Invalid link: %s.", err.Error()))
+	}
+	res, err := audioURLClient.Do(req)
+	if err != nil {
+		return "", 0, postInputError(fmt.Sprintf("Fetch file: %s.", err.Error()))
+	}
+	res.Body.Close()
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusForbidden, http.StatusMethodNotAllowed, http.StatusNotImplemented: // i.e., the signed links only allow GET; the file is checked by the worker
+		return audioURLMIMETypes[audioURLExtension(u)], -1, nil
+	default:
+		return "", 0, postInputError(fmt.Sprintf("Fetch file: unexpected status %d.", res.StatusCode))
+	}
+	mimeType, err := checkAudioContentType(res)
+	if err != nil {
+		return "", 0, err
+	}
+	if res.ContentLength > audioURLMaxSize {
+		return "", 0, postInputError(fmt.Sprintf("This file is too large, the limit is %d MB.", audioURLMaxSize>>20))
+	}
+	return mimeType, res.ContentLength, nil
+}
+
+type audioURLFile struct {
+	Path     string
+	MIMEType string
+	Size     int64
+}
+
+// downloadAudioURL downloads a file to a temporary file, which must be removed by the caller.
+func downloadAudioURL(ctx context.Context, u *url.URL, maxSize int64) (*audioURLFile, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, postInputError(fmt.Sprintf("Invalid link: %s.", err.Error()))
+	}
+	res, err := audioURLClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch file: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, postInputError(fmt.Sprintf("Fetch file: unexpected status %d.", res.StatusCode))
+	}
+	mimeType, err := checkAudioContentType(res)
+	if err != nil {
+		return nil, err
+	}
+	tooLarge := postInputError(fmt.Sprintf("This file is too large, the limit is %d MB.", maxSize>>20))
+	if res.ContentLength > maxSize {
+		return nil, tooLarge
+	}
+
+	f, err := ioutil.TempFile("", "sgtm-audio-url-*."+audioURLExtension(u))
+	if err != nil {
+		return nil, err
+	}
+	size, err := io.Copy(f, io.LimitReader(res.Body, maxSize+1))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	switch {
+	case err != nil:
+		err = fmt.Errorf("fetch file: %w", err)
+	case size > maxSize:
+		err = tooLarge
+	default:
+		err = checkAudioContent(f.Name())
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return nil, err
+	}
+	return &audioURLFile{Path: f.Name(), MIMEType: mimeType, Size: size}, nil
+}
+
+// checkAudioContent rejects the files that are obviously not audio files, i.e., the login pages of the cloud storages.
+func checkAudioContent(p string) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	head := make([]byte, 512)
+	n, err := io.ReadFull(f, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return postInputError("This file is empty.")
+	}
+	detected := http.DetectContentType(head[:n])
+	if strings.HasPrefix(detected, "text/") || strings.HasPrefix(detected, "image/") {
+		return postInputError(fmt.Sprintf("This link is not an audio file (%s).", detected))
+	}
+	return nil
+}
+
+// pinAudioFile adds a local file to IPFS and returns its CID, unless another track has the same file.
+func (svc *Service) pinAudioFile(db *gorm.DB, p string, postID int64) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	cid, err := svc.ipfs.add(f)
+	if err != nil {
+		return "", fmt.Errorf("ipfs add: %w", err)
+	}
+	if err := checkAudioFileExists(db, cid, postID); err != nil {
+		return "", err
+	}
+	return cid, nil
+}
+
+// checkAudioFileExists returns an errPostAlreadyExists error if another track has the same file.
+func checkAudioFileExists(db *gorm.DB, cid string, postID int64) error {
+	var alreadyExists sgtmpb.Post
+	err := db.
+		Model(&sgtmpb.Post{}).
+		Where(sgtmpb.Post{IPFSCID: cid}).
+		Where("id != ?", postID).
+		First(&alreadyExists).
+		Error
+	if err == nil && alreadyExists.ID != 0 {
+		return errPostAlreadyExists{Post: &alreadyExists}
+	}
+	return nil
+}
+
+// importAudioURLJob downloads the file of a track imported from a link and pins it to IPFS;
+// the track is processed once its file is pinned.
+// The download and the pinning run outside of any transaction, only the result is saved in a short one.
+// The invalid files and the last failure are reported in the processing error of the track.
+func (svc *Service) importAudioURLJob(job *sgtmpb.Job, db *gorm.DB) error {
+	post, err := jobPost(job, db)
+	if err != nil {
+		return err
+	}
+	if !post.IsPendingImport() {
+		return nil
+	}
+	u, err := url.Parse(post.SourceURL)
+	if err != nil {
+		return fmt.Errorf("invalid source URL: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(svc.ctx, audioURLJobTimeout)
+	defer cancel()
+	file, err := downloadAudioURL(ctx, u, audioURLMaxSize)
+	var cid string
+	if err == nil {
+		defer os.Remove(file.Path)
+		cid, err = svc.pinAudioFile(db, file.Path, post.ID)
+	}
+	if err == nil {
+		// the same file may have been imported by another track during the download
+		err = db.Transaction(func(tx *gorm.DB) error {
+			if err := checkAudioFileExists(tx, cid, post.ID); err != nil {
+				return err
+			}
+			return tx.
+				Model(post).
+				Updates(sgtmpb.Post{
+					IPFSCID:   cid,
+					URL:       "https://ipfs.io/ipfs/" + cid,
+					MIMEType:  file.MIMEType,
+					SizeBytes: file.Size,
+				}).
+				Error
+		})
+	}
+	if err != nil {
+		var (
+			inputErr  postInputError
+			existsErr errPostAlreadyExists
+		)
+		if !errors.As(err, &inputErr) && !errors.As(err, &existsErr) && job.Attempts < job.MaxAttempts {
+			return err
+		}
+		svc.logger.Warn("audio import failed", zap.Int64("post", post.ID), zap.Error(err))
+		return db.Model(post).Update("processing_error", "import: "+err.Error()).Error
+	}
+	return nil
+}
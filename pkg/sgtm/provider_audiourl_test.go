@@ -0,0 +1,147 @@
+package sgtm
+
+import (
+	"bytes"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+func TestAudioURLImport(t *testing.T) {
+	svc := TestingService(t)
+	client := testingClient(t, &svc)
+	svc.processingWorker.trackMigrations = nil
+	// the CID of the stub is the hash of the file
+	testingBinary(t, "ipfs", `printf 'Qm%s\n' "$(sha256sum | cut -c1-44)"`)
+
+	wav := append([]byte("RIFF\x24\x00\x00\x00WAVEfmt "), make([]byte, 1024)...)
+	otherWav := append([]byte("RIFF\x24\x00\x00\x00WAVEfmt "), bytes.Repeat([]byte{1}, 1024)...)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/track.wav", "/copy.wav":
+			w.Header().Set("Content-Type", "audio/wav")
+			http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(wav))
+		case "/signed.wav": // GET only
+			if r.Method != http.MethodGet {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Header().Set("Content-Type", "binary/octet-stream")
+			_, _ = w.Write(otherWav)
+		case "/login.wav": // GET only, and not an audio file
+			if r.Method != http.MethodGet {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write([]byte("<html><body>Please log in</body></html>"))
+		case "/page.mp3":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte("<html></html>"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	authorCtx := testingAuthContext(t, &svc, author.ID)
+	reload := func(post *sgtmpb.Post) *sgtmpb.Post {
+		var ret sgtmpb.Post
+		require.NoError(t, svc.rodb().First(&ret, post.ID).Error)
+		return &ret
+	}
+
+	// the local addresses are rejected, the tests use a local server
+	_, err := client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: server.URL + "/track.wav"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, err.Error(), "not on a public network")
+	allowPrivateNetworks = true
+	t.Cleanup(func() { allowPrivateNetworks = false })
+
+	// invalid links
+	for _, link := range []string{server.URL + "/missing.wav", server.URL + "/page.mp3"} {
+		_, err := client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: link})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), link)
+	}
+
+	// small files are imported immediately
+	created, err := client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: server.URL + "/track.wav"})
+	require.NoError(t, err)
+	post := created.Post
+	require.Equal(t, sgtmpb.Provider_IPFS, post.Provider)
+	require.False(t, post.IsPendingImport())
+	require.True(t, strings.HasPrefix(post.IPFSCID, "Qm"))
+	require.Equal(t, "https://ipfs.io/ipfs/"+post.IPFSCID, post.URL)
+	require.Equal(t, server.URL+"/track.wav", post.SourceURL)
+	require.Equal(t, "track", post.ProviderTitle)
+	require.Equal(t, "audio/wav", post.MIMEType)
+	require.Equal(t, "wav", post.FileExtension)
+	require.Equal(t, int64(len(wav)), post.SizeBytes)
+
+	// dedupe on the link and on the file
+	for _, link := range []string{server.URL + "/track.wav", server.URL + "/copy.wav"} {
+		_, err := client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: link})
+		require.Equal(t, codes.AlreadyExists, status.Code(err), link)
+	}
+
+	// the other files are imported by the worker
+	created, err = client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: server.URL + "/signed.wav"})
+	require.NoError(t, err)
+	signed := created.Post
+	require.True(t, signed.IsPendingImport())
+	require.Empty(t, embedTrack(signed))
+	created, err = client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: server.URL + "/login.wav"})
+	require.NoError(t, err)
+	login := created.Post
+	require.True(t, login.IsPendingImport())
+
+	require.NoError(t, svc.processingLoop(0))
+	signed = reload(signed)
+	require.False(t, signed.IsPendingImport())
+	require.NotEqual(t, post.IPFSCID, signed.IPFSCID)
+	require.Equal(t, "audio/wav", signed.MIMEType)
+	require.Equal(t, int64(len(otherWav)), signed.SizeBytes)
+	require.Empty(t, signed.ProcessingError)
+	login = reload(login)
+	require.True(t, login.IsPendingImport())
+	require.Equal(t, "import: This link is not an audio file (text/html; charset=utf-8).", login.ProcessingError)
+}
+
+func TestIsPublicIP(t *testing.T) {
+	for _, tt := range []struct {
+		ip     string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.20.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false}, // cloud metadata
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:10.1.2.3", false},
+		{"::ffff:93.184.216.34", true},
+		{"192.0.0.170", false},
+		{"198.18.0.1", false},
+		{"240.0.0.1", false},
+		{"255.255.255.255", false},
+		{"64:ff9b::a01:203", false}, // NAT64 to 10.1.2.3
+	} {
+		require.Equal(t, tt.public, isPublicIP(net.ParseIP(tt.ip)), tt.ip)
+	}
+}
@@ -0,0 +1,86 @@
+package sgtm
+
+import (
+	"fmt"
+	"html/template"
+	"net/url"
+	"strings"
+
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// bandcampProvider imports the public Bandcamp tracks, played with the Bandcamp embedded player.
+type bandcampProvider struct{}
+
+func (bandcampProvider) Kind() sgtmpb.Provider { return sgtmpb.Provider_Bandcamp }
+
+// MatchURL matches the artist subdomains, i.e., https://artist.bandcamp.com/track/title.
+func (bandcampProvider) MatchURL(u *url.URL) bool { return strings.HasSuffix(u.Host, ".bandcamp.com") }
+
+func (bandcampProvider) Import(svc *Service, post *sgtmpb.Post, u *url.URL) error {
+	if !strings.HasPrefix(u.Path, "/track/") {
+		return postInputError("Invalid Bandcamp track link.")
+	}
+	track, err := svc.fetchBandcampTrack(u.String())
+	if err != nil {
+		return postInputError(fmt.Sprintf("Fetch track info from Bandcamp: %s.", err.Error()))
+	}
+
+	// check if track already exists
+	{
+		var alreadyExists sgtmpb.Post
+		err := svc.rodb().
+			Model(&sgtmpb.Post{}).
+			Where(sgtmpb.Post{BandcampID: track.ID}).
+			First(&alreadyExists).
+			Error
+		if err == nil && alreadyExists.ID != 0 {
+			return errPostAlreadyExists{Post: &alreadyExists}
+		}
+	}
+
+	applyBandcampTrack(post, track)
+	return nil
+}
+
+func (bandcampProvider) Refresh(svc *Service, post *sgtmpb.Post) error {
+	if post.BandcampID == 0 || post.URL == "" {
+		return fmt.Errorf("post %d is not a Bandcamp track", post.ID)
+	}
+	track, err := svc.fetchBandcampTrack(post.URL)
+	if err != nil {
+		return err
+	}
+	if track.ID != post.BandcampID {
+		return fmt.Errorf("bandcamp: track ID changed from %d to %d", post.BandcampID, track.ID)
+	}
+	applyBandcampTrack(post, track)
+	return nil
+}
+
+func (bandcampProvider) Stream(*Service, *sgtmpb.Post) (ReadSeekerCloser, error) {
+	return nil, fmt.Errorf("bandcamp: stream: %w", errProviderUnsupported)
+}
+
+func (bandcampProvider) LocalFile(_ *Service, post *sgtmpb.Post) (string, func(), error) {
+	dl, err := DownloadPost(post, false)
+	if err != nil {
+		return "", nil, err
+	}
+	return dl.Path, func() {}, nil // the downloads are cached in dl/
+}
+
+var bandcampEmbedTemplate = template.Must(template.New("bandcamp-embed").Parse(`<iframe id="bandcamp-player" style="border: 0; width: 100%; height: 120px;" seamless
+  src="https://bandcamp.com/EmbeddedPlayer/track={{.BandcampID}}/size=large/bgcol=ffffff/linkcol=0687f5/tracklist=false/artwork=small/transparent=true/"></iframe>`))
+
+func (bandcampProvider) Embed(post *sgtmpb.Post) template.HTML {
+	return executeEmbed(bandcampEmbedTemplate, post)
+}
+
+// OriginalLink links to the Bandcamp page of the track.
+func (bandcampProvider) OriginalLink(post *sgtmpb.Post) *ProviderLink {
+	return newProviderLink(post.URL, "fab fa-bandcamp")
+}
+
+// ApplyDefaults does nothing: the Bandcamp tracks always have an artwork.
+func (bandcampProvider) ApplyDefaults(*sgtmpb.Post) {}
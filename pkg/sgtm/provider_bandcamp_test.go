@@ -0,0 +1,124 @@
+package sgtm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// fixtureBandcampClient serves the pages saved in testdata/bandcamp, by link.
+type fixtureBandcampClient map[string]string
+
+func (c fixtureBandcampClient) Page(link string) ([]byte, error) {
+	fixture, found := c[link]
+	if !found {
+		return nil, fmt.Errorf("bandcamp: get %q: unexpected status 404", link)
+	}
+	return ioutil.ReadFile("testdata/bandcamp/" + fixture)
+}
+
+func TestParseBandcampTrackPage(t *testing.T) {
+	page, err := ioutil.ReadFile("testdata/bandcamp/track.html")
+	require.NoError(t, err)
+	track, err := parseBandcampTrackPage(page)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2345678901), track.ID)
+	require.Equal(t, "https://manfredtouron.bandcamp.com/track/nuit-blanche", track.URL)
+	require.Equal(t, "Nuit Blanche", track.Title)
+	require.Equal(t, "Manfred Touron", track.Artist)
+	require.Equal(t, "Recorded at night & mixed the next morning.", track.About)
+	require.Equal(t, "Sleepless in Paris,\r\nwaiting for the sun.", track.Lyrics)
+	require.Equal(t, "FRX202100042", track.ISRC)
+	require.Equal(t, uint64(215384), track.DurationMs)
+	require.Equal(t, "https://f4.bcbits.com/img/a0123456789_10.jpg", track.ArtworkURL)
+	require.Equal(t, []string{"Electronic", "ambient", "piano", "Paris"}, track.Tags)
+	require.Equal(t, time.Date(2021, 3, 6, 0, 0, 0, 0, time.UTC), track.ReleaseDate.UTC())
+
+	// albums are not supported
+	page, err = ioutil.ReadFile("testdata/bandcamp/album.html")
+	require.NoError(t, err)
+	_, err = parseBandcampTrackPage(page)
+	require.Error(t, err)
+
+	// the schema.org metadata is optional
+	track, err = parseBandcampTrackPage([]byte(`<script data-tralbum="{&quot;item_type&quot;:&quot;track&quot;,&quot;id&quot;:42,&quot;art_id&quot;:7,&quot;current&quot;:{&quot;title&quot;:&quot;t&quot;}}"></script>`))
+	require.NoError(t, err)
+	require.Equal(t, "t", track.Title)
+	require.Equal(t, "https://f4.bcbits.com/img/a0000000007_10.jpg", track.ArtworkURL)
+	require.Empty(t, track.Tags)
+	require.True(t, track.ReleaseDate.IsZero())
+
+	_, err = parseBandcampTrackPage([]byte(`<html></html>`))
+	require.Error(t, err)
+}
+
+func TestBandcampImport(t *testing.T) {
+	svc := TestingService(t)
+	client := testingClient(t, &svc)
+	svc.bandcamp = fixtureBandcampClient{
+		"https://manfredtouron.bandcamp.com/track/nuit-blanche":   "track.html",
+		"https://manfredtouron.bandcamp.com/album/insomnies":      "album.html",
+		"https://manfredtouron.bandcamp.com/track/insomnies":      "album.html",
+		"https://manfredtouron.bandcamp.com/track/nuit-blanche-2": "track.html", // renamed track
+	}
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	authorCtx := testingAuthContext(t, &svc, author.ID)
+
+	// invalid links
+	for _, link := range []string{
+		"https://manfredtouron.bandcamp.com/album/insomnies",
+		"https://manfredtouron.bandcamp.com/track/insomnies",
+		"https://manfredtouron.bandcamp.com/track/missing",
+	} {
+		_, err := client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: link})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), link)
+	}
+
+	created, err := client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: "https://manfredtouron.bandcamp.com/track/nuit-blanche"})
+	require.NoError(t, err)
+	post := created.Post
+	require.Equal(t, sgtmpb.Provider_Bandcamp, post.Provider)
+	require.Equal(t, uint64(2345678901), post.BandcampID)
+	require.Equal(t, "Nuit Blanche", post.ProviderTitle)
+	require.Equal(t, "Manfred Touron", post.Artist)
+	require.Equal(t, uint64(215384), post.Duration)
+	require.Equal(t, "Electronic, ambient, piano, Paris", post.Tags)
+	require.Equal(t, "Sleepless in Paris,\r\nwaiting for the sun.", post.Lyrics)
+	require.Equal(t, time.Date(2021, 3, 6, 0, 0, 0, 0, time.UTC).UnixNano(), post.SortDate)
+	require.Equal(t, "https://manfredtouron.bandcamp.com/track/nuit-blanche", post.URL)
+
+	// dedupe on the track ID
+	for _, link := range []string{
+		"https://manfredtouron.bandcamp.com/track/nuit-blanche",
+		"https://manfredtouron.bandcamp.com/track/nuit-blanche-2",
+	} {
+		_, err = client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: link})
+		require.Equal(t, codes.AlreadyExists, status.Code(err), link)
+	}
+
+	// resync keeps the lyrics edited by the author
+	require.NoError(t, svc.rwdb().Model(&sgtmpb.Post{ID: post.ID}).Updates(map[string]interface{}{
+		"lyrics":        "edited",
+		"edited_fields": "lyrics",
+		"tags":          "outdated",
+	}).Error)
+	synced, err := client.PostSync(authorCtx, &sgtmpb.PostSync_Request{ID: post.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"provider_updated_at", "tags"}, synced.UpdatedFields)
+	require.Equal(t, "edited", synced.Post.Lyrics)
+}
+
+func TestBandcampEmbed(t *testing.T) {
+	post := sgtmpb.Post{Provider: sgtmpb.Provider_Bandcamp, BandcampID: 2345678901}
+	embed := string(embedTrack(&post))
+	require.Contains(t, embed, `id="bandcamp-player"`)
+	require.Contains(t, embed, "https://bandcamp.com/EmbeddedPlayer/track=2345678901/size=large/")
+}
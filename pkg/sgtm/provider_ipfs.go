@@ -0,0 +1,79 @@
+package sgtm
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// ipfsProvider hosts the uploaded tracks; see newTrackFromUpload.
+type ipfsProvider struct{}
+
+func (ipfsProvider) Kind() sgtmpb.Provider { return sgtmpb.Provider_IPFS }
+
+// MatchURL returns false: the IPFS tracks are uploaded, not imported from a link.
+func (ipfsProvider) MatchURL(*url.URL) bool { return false }
+
+func (ipfsProvider) Import(*Service, *sgtmpb.Post, *url.URL) error {
+	return fmt.Errorf("ipfs: import: %w", errProviderUnsupported)
+}
+
+func (ipfsProvider) Refresh(*Service, *sgtmpb.Post) error {
+	return fmt.Errorf("ipfs: refresh: %w", errProviderUnsupported)
+}
+
+func (ipfsProvider) Stream(svc *Service, post *sgtmpb.Post) (ReadSeekerCloser, error) {
+	return svc.ipfs.cat(post.IPFSCID, post.SizeBytes), nil
+}
+
+func (p ipfsProvider) LocalFile(svc *Service, post *sgtmpb.Post) (string, func(), error) {
+	reader, err := p.Stream(svc, post)
+	if err != nil {
+		return "", nil, err
+	}
+	defer reader.Close()
+	f, err := ioutil.TempFile("", fmt.Sprintf("sgtm-%d-*.%s", post.ID, post.FileExtension))
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() { os.Remove(f.Name()) }
+	_, err = io.Copy(f, reader)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	return f.Name(), cleanup, nil
+}
+
+var ipfsEmbedTemplate = template.Must(template.New("ipfs-embed").Parse(`<audio controls>
+  {{range .Renditions}}
+    <source src="/post/{{.PostID}}/download?format={{.Codec}}" type="{{.MIMEType}}" />
+  {{end}}
+  <source src="/post/{{.ID}}/download?format=original" />
+  <source src="https://gateway.ipfs.io/ipfs/{{.IPFSCID}}" />
+  <source src="https://ipfs.io/ipfs/{{.IPFSCID}}" />
+  <source src="https://cloudflare-ipfs.com/ipfs/{{.IPFSCID}}" />
+  <source src="https://jorropo.ovh/ipfs/{{.IPFSCID}}" />
+  Your browser does not support the
+  <code>audio</code> element.
+</audio>`))
+
+func (ipfsProvider) Embed(post *sgtmpb.Post) template.HTML {
+	if post.IsPendingImport() {
+		return ""
+	}
+	return executeEmbed(ipfsEmbedTemplate, post)
+}
+
+// OriginalLink returns nil: the uploaded files have no other page.
+func (ipfsProvider) OriginalLink(*sgtmpb.Post) *ProviderLink { return nil }
+
+func (ipfsProvider) ApplyDefaults(*sgtmpb.Post) {}
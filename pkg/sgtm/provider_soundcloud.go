@@ -0,0 +1,110 @@
+package sgtm
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/url"
+
+	"github.com/yanatan16/golang-soundcloud/soundcloud"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// soundcloudProvider imports the public and the private SoundCloud tracks, played with the SoundCloud widget.
+type soundcloudProvider struct{}
+
+func (soundcloudProvider) Kind() sgtmpb.Provider { return sgtmpb.Provider_SoundCloud }
+
+func (soundcloudProvider) MatchURL(u *url.URL) bool { return u.Host == "soundcloud.com" }
+
+func (soundcloudProvider) Import(svc *Service, post *sgtmpb.Post, u *url.URL) error {
+	u, err := svc.soundcloud.Resolve(u.String())
+	if err != nil {
+		return postInputError("This URL does not exist on SoundCloud.com.")
+	}
+	if soundcloudPlaylistPathRegex.MatchString(u.Path) {
+		return svc.importSoundCloudPlaylist(post, u)
+	}
+	if !soundcloudTrackPathRegex.MatchString(u.Path) {
+		return postInputError("Invalid SoundCloud track link.")
+	}
+	post.SoundCloudKind = sgtmpb.Post_SoundCloudTrack
+	post.SoundCloudID, err = soundcloudTrackIDFromAPIURL(u)
+	if err != nil {
+		return postInputError(fmt.Sprintf("Parse track ID: %s.", err.Error()))
+	}
+
+	// check if track already exists
+	{
+		var alreadyExists sgtmpb.Post
+		err := svc.rodb().
+			Model(&sgtmpb.Post{}).
+			Where(sgtmpb.Post{SoundCloudKind: sgtmpb.Post_SoundCloudTrack, SoundCloudID: post.SoundCloudID}).
+			First(&alreadyExists).
+			Error
+		if err == nil && alreadyExists.ID != 0 {
+			return errPostAlreadyExists{Post: &alreadyExists}
+		}
+	}
+
+	post.SoundCloudSecretToken = u.Query().Get("secret_token")
+	track, err := svc.fetchSoundCloudTrack(post)
+	if err != nil {
+		return postInputError(fmt.Sprintf("Fetch track info from SoundCloud: %s.", err.Error()))
+	}
+	applySoundCloudTrack(post, track)
+	return nil
+}
+
+func (soundcloudProvider) Refresh(svc *Service, post *sgtmpb.Post) error {
+	if post.SoundCloudID == 0 || post.SoundCloudKind == sgtmpb.Post_SoundCloudPlaylist {
+		return fmt.Errorf("post %d is not a SoundCloud track", post.ID)
+	}
+	track, err := svc.fetchSoundCloudTrack(post)
+	if err != nil {
+		return err
+	}
+	applySoundCloudTrack(post, track)
+	return nil
+}
+
+func (soundcloudProvider) Stream(*Service, *sgtmpb.Post) (ReadSeekerCloser, error) {
+	return nil, fmt.Errorf("soundcloud: stream: %w", errProviderUnsupported)
+}
+
+func (soundcloudProvider) LocalFile(_ *Service, post *sgtmpb.Post) (string, func(), error) {
+	dl, err := DownloadPost(post, false)
+	if err != nil {
+		return "", nil, err
+	}
+	return dl.Path, func() {}, nil // the downloads are cached in dl/
+}
+
+var soundcloudEmbedTemplate = template.Must(template.New("soundcloud-embed").Parse(`<iframe id="soundcloud-player" width=100% height=166 scrolling=no frameborder=no allow=autoplay
+  src="https://w.soundcloud.com/player/?url=https%3A//api.soundcloud.com/tracks/{{.SoundCloudID}}{{with .SoundCloudSecretToken}}%3Fsecret_token%3D{{.}}{{end}}&color=%23ff5500&auto_play=false&hide_related=true&show_comments=false&show_user=true&show_reposts=false&show_teaser=false"></iframe>`))
+
+var soundcloudPlaylistEmbedTemplate = template.Must(template.New("soundcloud-playlist-embed").Parse(`<iframe id="soundcloud-player" width=100% height=450 scrolling=no frameborder=no allow=autoplay
+  src="https://w.soundcloud.com/player/?url=https%3A//api.soundcloud.com/playlists/{{.SoundCloudID}}{{with .SoundCloudSecretToken}}%3Fsecret_token%3D{{.}}{{end}}&color=%23ff5500&auto_play=false&hide_related=true&show_comments=false&show_user=true&show_reposts=false&show_teaser=false"></iframe>`))
+
+func (soundcloudProvider) Embed(post *sgtmpb.Post) template.HTML {
+	if post.SoundCloudKind == sgtmpb.Post_SoundCloudPlaylist {
+		return executeEmbed(soundcloudPlaylistEmbedTemplate, post)
+	}
+	return executeEmbed(soundcloudEmbedTemplate, post)
+}
+
+// OriginalLink links to the SoundCloud page of the track.
+func (soundcloudProvider) OriginalLink(post *sgtmpb.Post) *ProviderLink {
+	return newProviderLink(post.URL, "fab fa-soundcloud")
+}
+
+// ApplyDefaults uses the avatar of the SoundCloud user for the tracks without artwork.
+func (soundcloudProvider) ApplyDefaults(post *sgtmpb.Post) {
+	if post.ArtworkURL != "" {
+		return
+	}
+	var metadata soundcloud.Track
+	if err := json.Unmarshal([]byte(post.ProviderMetadata), &metadata); err == nil && metadata.User != nil {
+		post.ArtworkURL = metadata.User.AvatarUrl
+	}
+}
@@ -0,0 +1,100 @@
+package sgtm
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+func TestProviders(t *testing.T) {
+	// every provider is registered once
+	for kind := range sgtmpb.Provider_name {
+		post := sgtmpb.Post{Provider: sgtmpb.Provider(kind)}
+		if post.Provider == sgtmpb.Provider_UnknownProvider {
+			require.False(t, hasProvider(&post))
+			continue
+		}
+		provider, err := providerOf(&post)
+		require.NoError(t, err, post.Provider.String())
+		require.Equal(t, post.Provider, provider.Kind())
+	}
+
+	tests := []struct {
+		link     string
+		expected sgtmpb.Provider
+	}{
+		{"https://soundcloud.com/moul/sgtm", sgtmpb.Provider_SoundCloud},
+		{"https://soundcloud.com/moul/sgtm/s-secret", sgtmpb.Provider_SoundCloud},
+		{"https://artist.bandcamp.com/track/sgtm", sgtmpb.Provider_Bandcamp},
+		{"https://artist.bandcamp.com/album/sgtm", sgtmpb.Provider_Bandcamp},
+		{"https://www.youtube.com/watch?v=dQw4w9WgXcQ", sgtmpb.Provider_YouTube},
+		{"https://youtu.be/dQw4w9WgXcQ", sgtmpb.Provider_YouTube},
+		{"https://vimeo.com/76979871", sgtmpb.Provider_YoutubeDL},
+		{"https://player.vimeo.com/video/76979871", sgtmpb.Provider_YoutubeDL},
+		{"https://ipfs.io/ipfs/QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", sgtmpb.Provider_UnknownProvider},
+		{"https://notvimeo.com/76979871", sgtmpb.Provider_UnknownProvider},
+		{"http://169.254.169.254/latest/meta-data/", sgtmpb.Provider_UnknownProvider},
+		{"ftp://example.com/track.mp3", sgtmpb.Provider_UnknownProvider},
+		{"track.mp3", sgtmpb.Provider_UnknownProvider},
+	}
+	for _, tt := range tests {
+		u, err := url.Parse(tt.link)
+		require.NoError(t, err)
+		provider := providerForURL(u)
+		if tt.expected == sgtmpb.Provider_UnknownProvider {
+			require.Nil(t, provider, tt.link)
+			continue
+		}
+		require.NotNil(t, provider, tt.link)
+		require.Equal(t, tt.expected, provider.Kind(), tt.link)
+	}
+}
+
+func TestProviderEmbed(t *testing.T) {
+	soundcloud := sgtmpb.Post{Provider: sgtmpb.Provider_SoundCloud, SoundCloudID: 42, SoundCloudSecretToken: "s-secret"}
+	embed := string(embedTrack(&soundcloud))
+	require.Contains(t, embed, `id="soundcloud-player"`)
+	require.Contains(t, embed, "tracks/42%3Fsecret_token%3Ds-secret&color")
+
+	ipfs := sgtmpb.Post{
+		ID:         7,
+		Provider:   sgtmpb.Provider_IPFS,
+		IPFSCID:    "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+		Renditions: []*sgtmpb.Rendition{{PostID: 7, Codec: "opus", MIMEType: "audio/ogg"}},
+	}
+	embed = string(embedTrack(&ipfs))
+	require.Contains(t, embed, `<source src="/post/7/download?format=opus" type="audio/ogg" />`)
+	require.Contains(t, embed, `<source src="https://ipfs.io/ipfs/QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG" />`)
+
+	require.Empty(t, embedTrack(&sgtmpb.Post{}))
+}
+
+func TestProviderOriginalLink(t *testing.T) {
+	tests := []struct {
+		post     *sgtmpb.Post
+		expected *ProviderLink
+	}{
+		{&sgtmpb.Post{Provider: sgtmpb.Provider_SoundCloud, URL: "https://soundcloud.com/a/b"}, &ProviderLink{URL: "https://soundcloud.com/a/b", Icon: "fab fa-soundcloud"}},
+		{&sgtmpb.Post{Provider: sgtmpb.Provider_Bandcamp, URL: "https://a.bandcamp.com/track/b"}, &ProviderLink{URL: "https://a.bandcamp.com/track/b", Icon: "fab fa-bandcamp"}},
+		{&sgtmpb.Post{Provider: sgtmpb.Provider_YouTube, URL: "https://youtu.be/a"}, &ProviderLink{URL: "https://youtu.be/a", Icon: "fab fa-youtube"}},
+		{&sgtmpb.Post{Provider: sgtmpb.Provider_YoutubeDL, URL: "https://vimeo.com/1"}, &ProviderLink{URL: "https://vimeo.com/1", Icon: "fa fa-external-link-alt"}},
+		{&sgtmpb.Post{Provider: sgtmpb.Provider_SoundCloud}, nil},
+		{&sgtmpb.Post{Provider: sgtmpb.Provider_IPFS, URL: "https://example.com/a.mp3"}, nil},
+		{&sgtmpb.Post{URL: "https://example.com"}, nil},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.expected, originalLink(tt.post), tt.post.URL)
+	}
+}
+
+func TestProviderApplyDefaults(t *testing.T) {
+	post := sgtmpb.Post{Provider: sgtmpb.Provider_SoundCloud, ProviderMetadata: `{"user":{"avatar_url":"https://i1.sndcdn.com/avatar.jpg"}}`}
+	applyPostDefaults(&post)
+	require.Equal(t, "https://i1.sndcdn.com/avatar.jpg", post.ArtworkURL)
+
+	post = sgtmpb.Post{Provider: sgtmpb.Provider_SoundCloud, ArtworkURL: "https://i1.sndcdn.com/artwork.jpg", ProviderMetadata: `{"user":{"avatar_url":"https://i1.sndcdn.com/avatar.jpg"}}`}
+	applyPostDefaults(&post)
+	require.Equal(t, "https://i1.sndcdn.com/artwork.jpg", post.ArtworkURL)
+}
@@ -0,0 +1,148 @@
+package sgtm
+
+import (
+	"fmt"
+	"html/template"
+	"net/url"
+	"os"
+	"strings"
+
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// youtubeDLExtractors are the youtube-dl extractors allowed by domain, the subdomains included.
+// The generic extractor, which fetches any page, is not allowed.
+var youtubeDLExtractors = map[string]string{
+	"archive.org":     "archive.org",
+	"audiomack.com":   "audiomack",
+	"dailymotion.com": "dailymotion",
+	"hearthis.at":     "hearthis.at",
+	"mixcloud.com":    "mixcloud",
+	"vimeo.com":       "vimeo",
+}
+
+// youtubeDLExtractor returns the allowed extractor of a link, or an empty string.
+func youtubeDLExtractor(u *url.URL) string {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ""
+	}
+	host := strings.ToLower(u.Hostname())
+	for domain, extractor := range youtubeDLExtractors {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return extractor
+		}
+	}
+	return ""
+}
+
+// youtubeDLProvider imports the medias of the sites of youtubeDLExtractors; it is matched last.
+// The audio is downloaded by youtube-dl and played from the download route.
+type youtubeDLProvider struct{}
+
+func (youtubeDLProvider) Kind() sgtmpb.Provider { return sgtmpb.Provider_YoutubeDL }
+
+func (youtubeDLProvider) MatchURL(u *url.URL) bool {
+	return youtubeDLExtractor(u) != ""
+}
+
+func (youtubeDLProvider) Import(svc *Service, post *sgtmpb.Post, u *url.URL) error {
+	info, err := svc.fetchYoutubeDLInfo(u.String())
+	if err != nil {
+		return postInputError(fmt.Sprintf("Fetch media info: %s.", err.Error()))
+	}
+	if expected := youtubeDLExtractor(u); expected != "" && !strings.EqualFold(info.Extractor, expected) {
+		return postInputError(fmt.Sprintf("Unsupported media: %s.", info.Extractor))
+	}
+
+	// check if track already exists
+	{
+		var alreadyExists sgtmpb.Post
+		err := svc.rodb().
+			Model(&sgtmpb.Post{}).
+			Where(sgtmpb.Post{YoutubeDLExtractor: info.Extractor, YoutubeDLID: info.ID}).
+			First(&alreadyExists).
+			Error
+		if err == nil && alreadyExists.ID != 0 {
+			return errPostAlreadyExists{Post: &alreadyExists}
+		}
+	}
+
+	applyYoutubeDLInfo(post, info)
+	return nil
+}
+
+func (youtubeDLProvider) Refresh(svc *Service, post *sgtmpb.Post) error {
+	if post.YoutubeDLID == "" || post.URL == "" {
+		return fmt.Errorf("post %d is not a youtube-dl track", post.ID)
+	}
+	info, err := svc.fetchYoutubeDLInfo(post.URL)
+	if err != nil {
+		return err
+	}
+	applyYoutubeDLInfo(post, info)
+	return nil
+}
+
+// Stream returns the audio downloaded by the processing worker, or an error wrapping os.ErrNotExist
+// until the download is done.
+func (youtubeDLProvider) Stream(_ *Service, post *sgtmpb.Post) (ReadSeekerCloser, error) {
+	dl, err := DownloadedPost(post)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(dl.Path)
+}
+
+func (youtubeDLProvider) LocalFile(_ *Service, post *sgtmpb.Post) (string, func(), error) {
+	dl, err := DownloadPost(post, false)
+	if err != nil {
+		return "", nil, err
+	}
+	return dl.Path, func() {}, nil // the downloads are cached in dl/
+}
+
+var youtubeDLEmbedTemplate = template.Must(template.New("youtubedl-embed").Parse(`<audio controls preload=none src="/post/{{.ID}}/download?format=original">
+  Your browser does not support the
+  <code>audio</code> element.
+</audio>`))
+
+func (youtubeDLProvider) Embed(post *sgtmpb.Post) template.HTML {
+	return executeEmbed(youtubeDLEmbedTemplate, post)
+}
+
+func (youtubeDLProvider) OriginalLink(post *sgtmpb.Post) *ProviderLink {
+	return newProviderLink(post.URL, "fa fa-external-link-alt")
+}
+
+func (youtubeDLProvider) ApplyDefaults(*sgtmpb.Post) {}
+
+// youtubeProvider is the youtube-dl provider for the YouTube videos, played with the YouTube player.
+type youtubeProvider struct{ youtubeDLProvider }
+
+func (youtubeProvider) Kind() sgtmpb.Provider { return sgtmpb.Provider_YouTube }
+
+func (youtubeProvider) MatchURL(u *url.URL) bool {
+	switch u.Host {
+	case "youtube.com", "www.youtube.com", "m.youtube.com", "music.youtube.com", "youtu.be":
+		return true
+	}
+	return false
+}
+
+var youtubeEmbedTemplate = template.Must(template.New("youtube-embed").Parse(`<div class="embed-responsive embed-responsive-16by9">
+  <iframe id="youtube-player" class="embed-responsive-item" frameborder=0 allowfullscreen allow="encrypted-media; picture-in-picture"
+    src="https://www.youtube-nocookie.com/embed/{{.YoutubeDLID}}?rel=0"></iframe>
+</div>`))
+
+// Stream is not supported, the YouTube videos are only played with the YouTube player.
+func (youtubeProvider) Stream(*Service, *sgtmpb.Post) (ReadSeekerCloser, error) {
+	return nil, fmt.Errorf("youtube: stream: %w", errProviderUnsupported)
+}
+
+func (youtubeProvider) Embed(post *sgtmpb.Post) template.HTML {
+	return executeEmbed(youtubeEmbedTemplate, post)
+}
+
+func (youtubeProvider) OriginalLink(post *sgtmpb.Post) *ProviderLink {
+	return newProviderLink(post.URL, "fab fa-youtube")
+}
@@ -0,0 +1,149 @@
+package sgtm
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// testingYoutubeDL installs a stub youtube-dl in the $PATH, printing the fixtures of testdata/youtube-dl by link.
+func testingYoutubeDL(t *testing.T, fixtures map[string]string) {
+	t.Helper()
+	var script strings.Builder
+	script.WriteString("for link; do :; done\n")
+	script.WriteString("case \"$*\" in *\" -- $link\") ;; *) echo \"ERROR: the link is not after --\" >&2; exit 2 ;; esac\n")
+	script.WriteString("case \"$link\" in\n")
+	for link, fixture := range fixtures {
+		path, err := filepath.Abs(filepath.Join("testdata", "youtube-dl", fixture))
+		require.NoError(t, err)
+		fmt.Fprintf(&script, "'%s') cat '%s' ;;\n", link, path)
+	}
+	script.WriteString("*) echo \"ERROR: Unsupported URL: $link\" >&2; exit 1 ;;\nesac\n")
+	testingBinary(t, "youtube-dl", script.String())
+}
+
+func TestYoutubeDLImport(t *testing.T) {
+	svc := TestingService(t)
+	client := testingClient(t, &svc)
+	allowPrivateNetworks = true // no DNS in the tests
+	t.Cleanup(func() { allowPrivateNetworks = false })
+	testingYoutubeDL(t, map[string]string{
+		"https://www.youtube.com/watch?v=dQw4w9WgXcQ":  "youtube.json",
+		"https://youtu.be/dQw4w9WgXcQ":                 "youtube.json",
+		"https://www.youtube.com/playlist?list=PLmoul": "playlist.json",
+		"https://vimeo.com/76979871":                   "vimeo.json",
+		"https://vimeo.com/redirect":                   "youtube.json",
+	})
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	authorCtx := testingAuthContext(t, &svc, author.ID)
+
+	// youtube-dl gives up before the request
+	svc.opts.ServerRequestTimeout = 5 * time.Second
+	require.Equal(t, 4*time.Second, svc.youtubeDLInfoTimeout())
+
+	// invalid links
+	for _, link := range []string{
+		"https://www.youtube.com/playlist?list=PLmoul",
+		"https://www.youtube.com/watch?v=missing",
+		"https://example.com/not-a-media",
+		"https://vimeo.com/redirect", // not extracted by the vimeo extractor
+	} {
+		_, err := client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: link})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), link)
+	}
+
+	// youtube
+	created, err := client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: "https://www.youtube.com/watch?v=dQw4w9WgXcQ"})
+	require.NoError(t, err)
+	post := created.Post
+	require.Equal(t, sgtmpb.Provider_YouTube, post.Provider)
+	require.Equal(t, "youtube", post.YoutubeDLExtractor)
+	require.Equal(t, "dQw4w9WgXcQ", post.YoutubeDLID)
+	require.Equal(t, "Nuit Blanche (live session)", post.ProviderTitle)
+	require.Equal(t, "Manfred Touron", post.Artist)
+	require.Equal(t, "Recorded at night.\nhttps://sgtm.club", post.ProviderDescription)
+	require.Equal(t, uint64(215000), post.Duration)
+	require.Equal(t, "https://i.ytimg.com/vi/dQw4w9WgXcQ/maxresdefault.jpg", post.ArtworkURL)
+	require.Equal(t, "piano, ambient, live", post.Tags)
+	require.Equal(t, time.Date(2021, 3, 6, 0, 0, 0, 0, time.UTC).UnixNano(), post.SortDate)
+	require.Equal(t, "https://www.youtube.com/watch?v=dQw4w9WgXcQ", post.URL)
+	require.NotContains(t, post.ProviderMetadata, "googlevideo.com")
+	require.Contains(t, string(embedTrack(post)), "https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ?rel=0")
+
+	// dedupe on the extractor and the media ID
+	_, err = client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: "https://youtu.be/dQw4w9WgXcQ"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// any other site
+	created, err = client.PostCreate(authorCtx, &sgtmpb.PostCreate_Request{URL: "https://vimeo.com/76979871"})
+	require.NoError(t, err)
+	post = created.Post
+	require.Equal(t, sgtmpb.Provider_YoutubeDL, post.Provider)
+	require.Equal(t, "vimeo", post.YoutubeDLExtractor)
+	require.Equal(t, uint64(187500), post.Duration)
+	require.Equal(t, time.Unix(1615075200, 0).UnixNano(), post.ProviderCreatedAt)
+	require.Empty(t, post.Tags)
+	require.Contains(t, string(embedTrack(post)), fmt.Sprintf(`src="/post/%d/download?format=original"`, post.ID))
+
+	// resync
+	require.NoError(t, svc.rwdb().Model(&sgtmpb.Post{ID: post.ID}).Update("provider_title", "outdated").Error)
+	synced, err := client.PostSync(authorCtx, &sgtmpb.PostSync_Request{ID: post.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"provider_title", "provider_updated_at"}, synced.UpdatedFields)
+	require.Equal(t, "Aube", synced.Post.ProviderTitle)
+}
+
+func TestYoutubeDLStream(t *testing.T) {
+	svc := TestingService(t)
+	dir, err := ioutil.TempDir("", "sgtm-dl")
+	require.NoError(t, err)
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() {
+		_ = os.Chdir(wd)
+		os.RemoveAll(dir)
+	})
+	testingBinary(t, "youtube-dl", `echo "$@" >> args.log; mkdir -p dl; printf '{"ext":"m4a"}' > dl/42.info.json; printf 'audio' > dl/42.m4a`)
+
+	// nothing is downloaded during the requests
+	post := sgtmpb.Post{ID: 42, Provider: sgtmpb.Provider_YoutubeDL, URL: "https://vimeo.com/76979871"}
+	_, err = svc.streamTrack(&post)
+	require.True(t, errors.Is(err, os.ErrNotExist), err)
+	_, err = os.Stat("args.log")
+	require.True(t, os.IsNotExist(err))
+
+	// the worker downloads the audio once
+	for i := 0; i < 2; i++ {
+		path, cleanup, err := svc.localTrackFile(&post)
+		require.NoError(t, err)
+		cleanup()
+		require.Equal(t, "dl/42.m4a", path)
+	}
+	args, err := ioutil.ReadFile("args.log")
+	require.NoError(t, err)
+	require.Equal(t, "--write-info-json --no-playlist -f bestaudio -o dl/42.%(ext)s -- https://vimeo.com/76979871\n", string(args))
+	reader, err := svc.streamTrack(&post)
+	require.NoError(t, err)
+	content, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, "audio", string(content))
+
+	// the YouTube videos are only played with the YouTube player
+	post.Provider = sgtmpb.Provider_YouTube
+	_, err = svc.streamTrack(&post)
+	require.True(t, errors.Is(err, errProviderUnsupported), err)
+}
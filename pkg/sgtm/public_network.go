@@ -0,0 +1,124 @@
+package sgtm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// publicHTTPMaxRedirects is the maximum number of redirects followed when fetching a link submitted by a user.
+const publicHTTPMaxRedirects = 5
+
+// allowPrivateNetworks disables the checks of the links submitted by the users; it is only set by the tests,
+// which use local servers.
+var allowPrivateNetworks = false
+
+var errPrivateNetwork = errors.New("this address is not on a public network")
+
+// privateNetworks are the networks that cannot be reached from the links submitted by the users,
+// in addition to the loopback, link-local, multicast and unspecified addresses.
+var privateNetworks = func() []*net.IPNet {
+	var networks []*net.IPNet
+	for _, cidr := range []string{
+		"0.0.0.0/8",      // "this" network
+		"10.0.0.0/8",     // private
+		"100.64.0.0/10",  // carrier-grade NAT
+		"172.16.0.0/12",  // private
+		"192.0.0.0/24",   // IETF protocol assignments
+		"192.168.0.0/16", // private
+		"198.18.0.0/15",  // benchmarking
+		"240.0.0.0/4",    // reserved, and the broadcast address
+		"64:ff9b::/96",   // NAT64, which can reach the internal IPv4 addresses
+		"fc00::/7",       // unique local
+	} {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}()
+
+// isPublicIP returns false for the loopback, private and link-local addresses.
+// The IPv4-mapped IPv6 addresses are checked as IPv4 addresses.
+func isPublicIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range privateNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkPublicHost resolves a host and fails if any of its addresses is not public.
+func checkPublicHost(ctx context.Context, host string) error {
+	if allowPrivateNetworks {
+		return nil
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return fmt.Errorf("%s: %w", host, errPrivateNetwork)
+		}
+	}
+	return nil
+}
+
+// publicDialControl rejects the connections to the addresses that are not public; it runs after the resolution,
+// so the DNS records cannot be changed between the check and the connection.
+func publicDialControl(network, address string, _ syscall.RawConn) error {
+	if allowPrivateNetworks {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+		return fmt.Errorf("%s: %w", host, errPrivateNetwork)
+	}
+	return nil
+}
+
+// newPublicHTTPClient returns a client for the links submitted by the users: it only connects to public addresses,
+// ignores the proxy settings, and follows a limited number of http(s) redirects.
+func newPublicHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   publicDialControl,
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:           dialer.DialContext,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= publicHTTPMaxRedirects {
+				return fmt.Errorf("stopped after %d redirects", publicHTTPMaxRedirects)
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return fmt.Errorf("unsupported redirect to %q", req.URL.Scheme)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,450 @@
+package sgtm
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+const (
+	defaultLineageDepth       = 10
+	defaultCollaboratorsDepth = 1
+	maxGraphDepth             = 10
+	// maxGraphNodes stops the walk of a graph once it has this many tracks or users.
+	maxGraphNodes = 500
+)
+
+var (
+	trackToTrackKinds = []sgtmpb.Relationship_Kind{
+		sgtmpb.Relationship_RemixOfTrackKind,
+		sgtmpb.Relationship_NewVersionOfTrackKind,
+		sgtmpb.Relationship_InspiredByTrackKind,
+	}
+	// collaborationKinds are the kinds connecting two users through a track.
+	collaborationKinds = []sgtmpb.Relationship_Kind{
+		sgtmpb.Relationship_FeaturingUserKind,
+		sgtmpb.Relationship_RemixOfUserKind,
+		sgtmpb.Relationship_RemixOfTrackKind,
+	}
+)
+
+func graphDepth(maxDepth int32, defaultDepth int) int {
+	switch {
+	case maxDepth <= 0:
+		return defaultDepth
+	case maxDepth > maxGraphDepth:
+		return maxGraphDepth
+	default:
+		return int(maxDepth)
+	}
+}
+
+// relationshipOwner returns the author of the source track of a relationship targeted by a request.
+func relationshipOwner(svc *Service, req interface{}) ([]int64, error) {
+	var sourcePostID int64
+	switch req := req.(type) {
+	case *sgtmpb.RelationshipCreate_Request:
+		sourcePostID = req.GetSourcePostID()
+	case *sgtmpb.RelationshipDelete_Request:
+		var relationship sgtmpb.Relationship
+		if err := svc.rodb().First(&relationship, req.GetID()).Error; err != nil {
+			return nil, status.Error(codes.NotFound, "relationship not found")
+		}
+		sourcePostID = relationship.SourcePostID
+	default:
+		return nil, status.Errorf(codes.Internal, "cannot find the owner of %T", req)
+	}
+	return trackOwner(svc, &sgtmpb.PostGet_Request{ID: sourcePostID})
+}
+
+// listRelationships returns the relationships of a track or of a user, with their tracks and users.
+func (svc *Service) listRelationships(req *sgtmpb.RelationshipList_Request) ([]*sgtmpb.Relationship, error) {
+	query := svc.rodb().
+		Preload("SourcePost").
+		Preload("TargetPost").
+		Preload("TargetUser").
+		Order("id asc")
+	switch {
+	case req.GetPostID() != 0:
+		query = query.Where("source_post_id = ? OR target_post_id = ?", req.GetPostID(), req.GetPostID())
+	case req.GetUserID() != 0:
+		userPosts := svc.rodb().Model(&sgtmpb.Post{}).Select("id").Where(sgtmpb.Post{AuthorID: req.GetUserID(), Kind: sgtmpb.Post_TrackKind})
+		query = query.Where("target_user_id = ? OR source_post_id IN (?)", req.GetUserID(), userPosts)
+	default:
+		return nil, postInputError("Missing post ID or user ID.")
+	}
+	if kinds := req.GetKinds(); len(kinds) > 0 {
+		query = query.Where("kind IN (?)", kinds)
+	}
+	var relationships []*sgtmpb.Relationship
+	if err := query.Find(&relationships).Error; err != nil {
+		return nil, err
+	}
+	return relationships, nil
+}
+
+// createRelationship links a track to another track or to a user.
+func (svc *Service) createRelationship(user *sgtmpb.User, req *sgtmpb.RelationshipCreate_Request) (*sgtmpb.Relationship, error) {
+	relationship := sgtmpb.Relationship{
+		Kind:         req.GetKind(),
+		SourcePostID: req.GetSourcePostID(),
+	}
+	switch {
+	case req.GetKind().IsTrackToTrack():
+		if req.GetTargetPostID() == 0 {
+			return nil, postInputError("Missing target track.")
+		}
+		if req.GetTargetPostID() == req.GetSourcePostID() {
+			return nil, postInputError("A track cannot be related to itself.")
+		}
+		var target sgtmpb.Post
+		err := svc.rodb().Where(sgtmpb.Post{ID: req.GetTargetPostID(), Kind: sgtmpb.Post_TrackKind}).First(&target).Error
+		if err != nil || !canViewPost(user, &target) {
+			return nil, postInputError("Unknown target track.")
+		}
+		relationship.TargetPostID = target.ID
+	case req.GetKind().IsTrackToUser():
+		if req.GetTargetUserID() == 0 {
+			return nil, postInputError("Missing target user.")
+		}
+		var target sgtmpb.User
+		if err := svc.rodb().First(&target, req.GetTargetUserID()).Error; err != nil {
+			return nil, postInputError("Unknown target user.")
+		}
+		relationship.TargetUserID = target.ID
+	default:
+		return nil, postInputError(fmt.Sprintf("Unsupported relationship kind: %s.", req.GetKind()))
+	}
+
+	var existing sgtmpb.Relationship
+	err := svc.rodb().
+		Where(sgtmpb.Relationship{
+			Kind:         relationship.Kind,
+			SourcePostID: relationship.SourcePostID,
+			TargetPostID: relationship.TargetPostID,
+			TargetUserID: relationship.TargetUserID,
+		}).
+		First(&existing).
+		Error
+	switch {
+	case err == nil && existing.Suggested:
+		// creating a suggested relationship confirms it
+		return svc.confirmRelationship(&existing)
+	case err == nil:
+		return nil, status.Error(codes.AlreadyExists, "this relationship already exists")
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, err
+	}
+
+	if err := svc.rwdb().Create(&relationship).Error; err != nil {
+		return nil, err
+	}
+	svc.logger.Debug("relationship created", zap.Any("relationship", &relationship))
+	return &relationship, nil
+}
+
+// confirmRelationship turns a suggested relationship into a regular one.
+func (svc *Service) confirmRelationship(relationship *sgtmpb.Relationship) (*sgtmpb.Relationship, error) {
+	if err := svc.rwdb().Model(relationship).Update("suggested", false).Error; err != nil {
+		return nil, err
+	}
+	relationship.Suggested = false
+	svc.logger.Debug("relationship confirmed", zap.Int64("id", relationship.ID))
+	return relationship, nil
+}
+
+func (svc *Service) deleteRelationship(id int64) error {
+	if err := svc.rwdb().Delete(&sgtmpb.Relationship{}, id).Error; err != nil {
+		return err
+	}
+	svc.logger.Debug("relationship deleted", zap.Int64("id", id))
+	return nil
+}
+
+// relationshipGraph accumulates the nodes and edges found while walking the relationships.
+type relationshipGraph struct {
+	posts         map[int64]*sgtmpb.Post
+	users         map[int64]*sgtmpb.User
+	relationships map[int64]*sgtmpb.Relationship
+}
+
+func newRelationshipGraph() *relationshipGraph {
+	return &relationshipGraph{
+		posts:         map[int64]*sgtmpb.Post{},
+		users:         map[int64]*sgtmpb.User{},
+		relationships: map[int64]*sgtmpb.Relationship{},
+	}
+}
+
+// postLineage walks the remixes, new versions and inspirations of a track, in both directions.
+// The walk stops at the tracks the viewer cannot see, so the tracks only related through them stay hidden.
+func (svc *Service) postLineage(viewer *sgtmpb.User, postID int64, maxDepth int) (*relationshipGraph, error) {
+	graph := newRelationshipGraph()
+	if err := svc.loadGraphPosts(graph, viewer, []int64{postID}); err != nil {
+		return nil, err
+	}
+	seen := map[int64]bool{postID: true}
+	var frontier []int64
+	if _, found := graph.posts[postID]; found {
+		frontier = append(frontier, postID)
+	}
+	for depth := 0; depth < maxDepth && len(frontier) > 0 && len(seen) < maxGraphNodes; depth++ {
+		var edges []*sgtmpb.Relationship
+		err := svc.rodb().
+			Where("kind IN (?) AND suggested = ?", trackToTrackKinds, false).
+			Where("source_post_id IN (?) OR target_post_id IN (?)", frontier, frontier).
+			Find(&edges).
+			Error
+		if err != nil {
+			return nil, err
+		}
+		var ids []int64
+		for _, edge := range edges {
+			graph.relationships[edge.ID] = edge
+			for _, id := range []int64{edge.SourcePostID, edge.TargetPostID} {
+				if !seen[id] {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if err := svc.loadGraphPosts(graph, viewer, ids); err != nil {
+			return nil, err
+		}
+		frontier = nil
+		for _, id := range ids {
+			if _, found := graph.posts[id]; found {
+				frontier = append(frontier, id)
+			}
+		}
+	}
+	graph.prune()
+	return graph, nil
+}
+
+// userCollaborators walks the users sharing tracks with a user: the featured artists,
+// the remixed artists, and the authors of the remixes and of the remixed tracks.
+func (svc *Service) userCollaborators(viewer *sgtmpb.User, userID int64, maxDepth int) (*relationshipGraph, error) {
+	graph := newRelationshipGraph()
+	seenUsers := map[int64]bool{userID: true}
+	frontier := []int64{userID}
+	for depth := 0; depth < maxDepth && len(frontier) > 0 && len(seenUsers) < maxGraphNodes; depth++ {
+		userPosts := svc.rodb().
+			Model(&sgtmpb.Post{}).
+			Select("id").
+			Where("kind = ? AND author_id IN (?)", sgtmpb.Post_TrackKind, frontier)
+		var edges []*sgtmpb.Relationship
+		err := svc.rodb().
+			Where("kind IN (?) AND suggested = ?", collaborationKinds, false).
+			Where("target_user_id IN (?) OR source_post_id IN (?) OR target_post_id IN (?)", frontier, userPosts, userPosts).
+			Find(&edges).
+			Error
+		if err != nil {
+			return nil, err
+		}
+
+		var postIDs []int64
+		for _, edge := range edges {
+			if _, found := graph.relationships[edge.ID]; found {
+				continue
+			}
+			graph.relationships[edge.ID] = edge
+			postIDs = append(postIDs, edge.SourcePostID)
+			if edge.TargetPostID != 0 {
+				postIDs = append(postIDs, edge.TargetPostID)
+			}
+		}
+		if err := svc.loadGraphPosts(graph, viewer, postIDs); err != nil {
+			return nil, err
+		}
+
+		frontier = nil
+		for _, edge := range edges {
+			participants := []int64{edge.TargetUserID}
+			for _, postID := range []int64{edge.SourcePostID, edge.TargetPostID} {
+				if post, found := graph.posts[postID]; found {
+					participants = append(participants, post.AuthorID)
+				} else if postID != 0 {
+					participants = nil // the viewer cannot see this collaboration
+					break
+				}
+			}
+			for _, id := range participants {
+				if id != 0 && !seenUsers[id] {
+					seenUsers[id] = true
+					frontier = append(frontier, id)
+				}
+			}
+		}
+	}
+
+	ids := make([]int64, 0, len(seenUsers))
+	for id := range seenUsers {
+		ids = append(ids, id)
+	}
+	var users []*sgtmpb.User
+	if err := svc.rodb().Where("id IN (?)", ids).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		graph.users[user.ID] = user
+	}
+	graph.prune()
+	return graph, nil
+}
+
+// loadGraphPosts adds the tracks the viewer can see to the graph.
+func (svc *Service) loadGraphPosts(graph *relationshipGraph, viewer *sgtmpb.User, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	var posts []*sgtmpb.Post
+	err := svc.rodb().
+		Preload("Author").
+		Where("kind = ? AND id IN (?)", sgtmpb.Post_TrackKind, ids).
+		Find(&posts).
+		Error
+	if err != nil {
+		return err
+	}
+	for _, post := range posts {
+		if canViewPost(viewer, post) {
+			graph.posts[post.ID] = post
+		}
+	}
+	return nil
+}
+
+// prune removes the relationships pointing to a track or a user missing from the graph.
+func (g *relationshipGraph) prune() {
+	for id, edge := range g.relationships {
+		_, sourceFound := g.posts[edge.SourcePostID]
+		targetFound := true
+		switch {
+		case edge.TargetPostID != 0:
+			_, targetFound = g.posts[edge.TargetPostID]
+		case edge.TargetUserID != 0 && len(g.users) > 0:
+			_, targetFound = g.users[edge.TargetUserID]
+		}
+		if !sourceFound || !targetFound {
+			delete(g.relationships, id)
+		}
+	}
+}
+
+// toProto returns the graph sorted by IDs and filtered for the API.
+func (g *relationshipGraph) toProto() *sgtmpb.RelationshipGraph_Response {
+	var ret sgtmpb.RelationshipGraph_Response
+	for _, post := range g.posts {
+		post.Filter()
+		if post.Author != nil {
+			post.Author.Filter()
+		}
+		ret.Posts = append(ret.Posts, post)
+	}
+	for _, user := range g.users {
+		user.Filter()
+		ret.Users = append(ret.Users, user)
+	}
+	for _, relationship := range g.relationships {
+		ret.Relationships = append(ret.Relationships, relationship)
+	}
+	sort.Slice(ret.Posts, func(i, j int) bool { return ret.Posts[i].ID < ret.Posts[j].ID })
+	sort.Slice(ret.Users, func(i, j int) bool { return ret.Users[i].ID < ret.Users[j].ID })
+	sort.Slice(ret.Relationships, func(i, j int) bool { return ret.Relationships[i].ID < ret.Relationships[j].ID })
+	return &ret
+}
+
+// graphToDOT renders a graph in the Graphviz DOT format, with an edge from each relationship's
+// source to its target, and a dashed edge from the users to the tracks they authored.
+func graphToDOT(name string, graph *sgtmpb.RelationshipGraph_Response) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", strconv.Quote(name))
+	b.WriteString("  rankdir=BT;\n")
+	users := map[int64]bool{}
+	for _, user := range graph.Users {
+		users[user.ID] = true
+		fmt.Fprintf(&b, "  user_%d [shape=ellipse, label=%s, URL=%s];\n", user.ID, strconv.Quote("@"+user.Slug), strconv.Quote(user.CanonicalURL()))
+	}
+	for _, post := range graph.Posts {
+		label := post.SafeTitle()
+		if post.Author != nil {
+			label += "\nby @" + post.Author.Slug
+		}
+		fmt.Fprintf(&b, "  post_%d [shape=box, label=%s, URL=%s];\n", post.ID, strconv.Quote(label), strconv.Quote(post.CanonicalURL()))
+	}
+	for _, post := range graph.Posts {
+		if users[post.AuthorID] {
+			fmt.Fprintf(&b, "  user_%d -> post_%d [style=dashed, label=\"author\"];\n", post.AuthorID, post.ID)
+		}
+	}
+	for _, edge := range graph.Relationships {
+		target := fmt.Sprintf("post_%d", edge.TargetPostID)
+		if edge.TargetPostID == 0 {
+			target = fmt.Sprintf("user_%d", edge.TargetUserID)
+		}
+		fmt.Fprintf(&b, "  post_%d -> %s [label=%s];\n", edge.SourcePostID, target, strconv.Quote(edge.Kind.Verb()))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// lineageNode is a track of a lineage tree, with the tracks derived from it.
+type lineageNode struct {
+	Post     *sgtmpb.Post
+	Kind     sgtmpb.Relationship_Kind // relationship with the parent node
+	Current  bool
+	Children []*lineageNode
+}
+
+// lineageTree converts a lineage graph to trees rooted at the original tracks,
+// i.e., the tracks that are not a remix, a new version or inspired by another track of the graph.
+func lineageTree(graph *relationshipGraph, currentID int64) []*lineageNode {
+	children := map[int64][]*sgtmpb.Relationship{}
+	isDerived := map[int64]bool{}
+	for _, edge := range graph.relationships {
+		children[edge.TargetPostID] = append(children[edge.TargetPostID], edge)
+		isDerived[edge.SourcePostID] = true
+	}
+	for _, edges := range children {
+		sort.Slice(edges, func(i, j int) bool { return edges[i].SourcePostID < edges[j].SourcePostID })
+	}
+
+	visited := map[int64]bool{}
+	var build func(postID int64, kind sgtmpb.Relationship_Kind) *lineageNode
+	build = func(postID int64, kind sgtmpb.Relationship_Kind) *lineageNode {
+		visited[postID] = true
+		node := &lineageNode{Post: graph.posts[postID], Kind: kind, Current: postID == currentID}
+		for _, edge := range children[postID] {
+			if !visited[edge.SourcePostID] {
+				node.Children = append(node.Children, build(edge.SourcePostID, edge.Kind))
+			}
+		}
+		return node
+	}
+
+	ids := make([]int64, 0, len(graph.posts))
+	for id := range graph.posts {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	var roots []*lineageNode
+	for _, id := range ids {
+		if !isDerived[id] && !visited[id] {
+			roots = append(roots, build(id, sgtmpb.Relationship_UnknownKind))
+		}
+	}
+	// cycles have no original track, start from the current one
+	if !visited[currentID] && graph.posts[currentID] != nil {
+		roots = append(roots, build(currentID, sgtmpb.Relationship_UnknownKind))
+	}
+	return roots
+}
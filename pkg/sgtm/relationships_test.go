@@ -0,0 +1,191 @@
+package sgtm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+func TestRelationshipGraph(t *testing.T) {
+	svc := TestingService(t)
+	client := testingClient(t, &svc)
+
+	var users []*sgtmpb.User
+	for _, slug := range []string{"alice", "bob", "charlie", "dave"} {
+		user := sgtmpb.User{Email: slug + "@example.com", Slug: slug}
+		require.NoError(t, svc.rwdb().Create(&user).Error)
+		users = append(users, &user)
+	}
+	alice, bob, charlie, dave := users[0], users[1], users[2], users[3]
+	newTrack := func(author *sgtmpb.User, title string, visibility sgtmpb.Visibility) *sgtmpb.Post {
+		track := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Visibility: visibility, Title: title}
+		require.NoError(t, svc.rwdb().Create(&track).Error)
+		return &track
+	}
+	original := newTrack(alice, "original", sgtmpb.Visibility_Public)
+	remix := newTrack(bob, "remix", sgtmpb.Visibility_Public)
+	remixOfRemix := newTrack(charlie, "remix of remix", sgtmpb.Visibility_Public)
+	version := newTrack(alice, "v2", sgtmpb.Visibility_Public)
+	draft := newTrack(charlie, "draft", sgtmpb.Visibility_Draft)
+	unrelated := newTrack(dave, "unrelated", sgtmpb.Visibility_Public)
+
+	link := func(userID int64, kind sgtmpb.Relationship_Kind, source *sgtmpb.Post, targetPostID int64, targetUserID int64) (*sgtmpb.RelationshipCreate_Response, error) {
+		return client.RelationshipCreate(testingAuthContext(t, &svc, userID), &sgtmpb.RelationshipCreate_Request{
+			Kind:         kind,
+			SourcePostID: source.ID,
+			TargetPostID: targetPostID,
+			TargetUserID: targetUserID,
+		})
+	}
+
+	// create
+	_, err := link(alice.ID, sgtmpb.Relationship_RemixOfTrackKind, remix, original.ID, 0)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = link(bob.ID, sgtmpb.Relationship_RemixOfTrackKind, remix, remix.ID, 0)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = link(bob.ID, sgtmpb.Relationship_RemixOfTrackKind, remix, 0, alice.ID)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = link(bob.ID, sgtmpb.Relationship_RemixOfTrackKind, remix, draft.ID, 0)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = link(bob.ID, sgtmpb.Relationship_RemixOfTrackKind, remix, original.ID, 0)
+	require.NoError(t, err)
+	_, err = link(bob.ID, sgtmpb.Relationship_RemixOfTrackKind, remix, original.ID, 0)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = link(charlie.ID, sgtmpb.Relationship_RemixOfTrackKind, remixOfRemix, remix.ID, 0)
+	require.NoError(t, err)
+	_, err = link(alice.ID, sgtmpb.Relationship_NewVersionOfTrackKind, version, original.ID, 0)
+	require.NoError(t, err)
+	_, err = link(charlie.ID, sgtmpb.Relationship_RemixOfTrackKind, draft, original.ID, 0)
+	require.NoError(t, err)
+	feat, err := link(alice.ID, sgtmpb.Relationship_FeaturingUserKind, original, 0, dave.ID)
+	require.NoError(t, err)
+
+	// list
+	list, err := client.RelationshipList(context.Background(), &sgtmpb.RelationshipList_Request{PostID: original.ID})
+	require.NoError(t, err)
+	require.Len(t, list.Relationships, 3) // the draft is hidden
+	list, err = client.RelationshipList(testingAuthContext(t, &svc, charlie.ID), &sgtmpb.RelationshipList_Request{PostID: original.ID})
+	require.NoError(t, err)
+	require.Len(t, list.Relationships, 4)
+
+	// only related through the draft
+	remixOfDraft := newTrack(charlie, "remix of draft", sgtmpb.Visibility_Public)
+	_, err = link(charlie.ID, sgtmpb.Relationship_RemixOfTrackKind, remixOfDraft, draft.ID, 0)
+	require.NoError(t, err)
+
+	// lineage
+	postTitles := func(posts []*sgtmpb.Post) []string {
+		titles := []string{}
+		for _, post := range posts {
+			titles = append(titles, post.Title)
+		}
+		return titles
+	}
+	graph, err := client.RelationshipGraph(context.Background(), &sgtmpb.RelationshipGraph_Request{PostID: remixOfRemix.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"original", "remix", "remix of remix", "v2"}, postTitles(graph.Posts))
+	require.Len(t, graph.Relationships, 3)
+	graph, err = client.RelationshipGraph(context.Background(), &sgtmpb.RelationshipGraph_Request{PostID: remixOfRemix.ID, MaxDepth: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{"remix", "remix of remix"}, postTitles(graph.Posts))
+	graph, err = client.RelationshipGraph(testingAuthContext(t, &svc, charlie.ID), &sgtmpb.RelationshipGraph_Request{PostID: original.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"original", "remix", "remix of remix", "v2", "draft", "remix of draft"}, postTitles(graph.Posts))
+	graph, err = client.RelationshipGraph(context.Background(), &sgtmpb.RelationshipGraph_Request{PostID: original.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"original", "remix", "remix of remix", "v2"}, postTitles(graph.Posts))
+	graph, err = client.RelationshipGraph(context.Background(), &sgtmpb.RelationshipGraph_Request{PostID: remixOfDraft.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"remix of draft"}, postTitles(graph.Posts))
+	require.Empty(t, graph.Relationships)
+	_, err = client.RelationshipGraph(context.Background(), &sgtmpb.RelationshipGraph_Request{PostID: draft.ID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// collaborators
+	userSlugs := func(users []*sgtmpb.User) []string {
+		slugs := []string{}
+		for _, user := range users {
+			slugs = append(slugs, user.Slug)
+		}
+		return slugs
+	}
+	graph, err = client.RelationshipGraph(context.Background(), &sgtmpb.RelationshipGraph_Request{UserID: alice.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "bob", "dave"}, userSlugs(graph.Users))
+	graph, err = client.RelationshipGraph(context.Background(), &sgtmpb.RelationshipGraph_Request{UserID: bob.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "bob", "charlie"}, userSlugs(graph.Users))
+	graph, err = client.RelationshipGraph(context.Background(), &sgtmpb.RelationshipGraph_Request{UserID: bob.ID, MaxDepth: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "bob", "charlie", "dave"}, userSlugs(graph.Users))
+	graph, err = client.RelationshipGraph(context.Background(), &sgtmpb.RelationshipGraph_Request{UserID: dave.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "dave"}, userSlugs(graph.Users))
+	require.NotContains(t, postTitles(graph.Posts), unrelated.Title)
+
+	// DOT
+	dot := graphToDOT("collaborators", graph)
+	require.True(t, strings.HasPrefix(dot, "digraph \"collaborators\" {\n"))
+	require.Contains(t, dot, fmt.Sprintf("post_%d -> user_%d [label=\"feat.\"];", original.ID, dave.ID))
+	require.Contains(t, dot, fmt.Sprintf("user_%d -> post_%d [style=dashed, label=\"author\"];", alice.ID, original.ID))
+
+	// delete
+	_, err = client.RelationshipDelete(testingAuthContext(t, &svc, dave.ID), &sgtmpb.RelationshipDelete_Request{ID: feat.Relationship.ID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.RelationshipDelete(testingAuthContext(t, &svc, alice.ID), &sgtmpb.RelationshipDelete_Request{ID: feat.Relationship.ID})
+	require.NoError(t, err)
+	_, err = client.RelationshipDelete(testingAuthContext(t, &svc, alice.ID), &sgtmpb.RelationshipDelete_Request{ID: feat.Relationship.ID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestLineageTree(t *testing.T) {
+	graph := newRelationshipGraph()
+	for id := int64(1); id <= 4; id++ {
+		graph.posts[id] = &sgtmpb.Post{ID: id}
+	}
+	graph.relationships[1] = &sgtmpb.Relationship{ID: 1, Kind: sgtmpb.Relationship_RemixOfTrackKind, SourcePostID: 2, TargetPostID: 1}
+	graph.relationships[2] = &sgtmpb.Relationship{ID: 2, Kind: sgtmpb.Relationship_RemixOfTrackKind, SourcePostID: 3, TargetPostID: 2}
+	graph.relationships[3] = &sgtmpb.Relationship{ID: 3, Kind: sgtmpb.Relationship_NewVersionOfTrackKind, SourcePostID: 4, TargetPostID: 1}
+
+	roots := lineageTree(graph, 3)
+	require.Len(t, roots, 1)
+	require.Equal(t, int64(1), roots[0].Post.ID)
+	require.Len(t, roots[0].Children, 2)
+	require.Equal(t, int64(2), roots[0].Children[0].Post.ID)
+	require.Equal(t, sgtmpb.Relationship_RemixOfTrackKind, roots[0].Children[0].Kind)
+	require.True(t, roots[0].Children[0].Children[0].Current)
+	require.Equal(t, sgtmpb.Relationship_NewVersionOfTrackKind, roots[0].Children[1].Kind)
+
+	// cycles
+	graph.relationships[4] = &sgtmpb.Relationship{ID: 4, Kind: sgtmpb.Relationship_InspiredByTrackKind, SourcePostID: 1, TargetPostID: 3}
+	roots = lineageTree(graph, 3)
+	require.Len(t, roots, 1)
+	require.Equal(t, int64(3), roots[0].Post.ID)
+}
+
+func TestUserCollaboratorsPage(t *testing.T) {
+	svc := TestingService(t)
+	alice := sgtmpb.User{Email: "alice@example.com", Slug: "alice"}
+	require.NoError(t, svc.rwdb().Create(&alice).Error)
+
+	router := chi.NewRouter()
+	router.Get("/@{user_slug}/collaborators", svc.userCollaboratorsPage(nil))
+	for format, contentType := range map[string]string{
+		"":    "application/json; charset=utf-8",
+		"dot": "text/vnd.graphviz; charset=utf-8",
+	} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest("GET", "/@alice/collaborators?format="+format, nil))
+		require.Equal(t, http.StatusOK, rec.Code, format)
+		require.Equal(t, contentType, rec.Header().Get("Content-Type"), format)
+	}
+}
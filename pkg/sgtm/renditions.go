@@ -0,0 +1,256 @@
+package sgtm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+const (
+	renditionTimeout = 10 * time.Minute
+	// originalFormat is the format query parameter of the uploaded file.
+	originalFormat = "original"
+)
+
+type renditionSpec struct {
+	Codec         string
+	BitrateKbps   int64
+	MIMEType      string
+	FileExtension string
+	Encoder       string // ffmpeg audio encoder
+}
+
+// renditionSpecs are the renditions created for the lossless uploads, by order of preference
+// when the client accepts any of them.
+var renditionSpecs = []renditionSpec{
+	{Codec: "mp3", BitrateKbps: 192, MIMEType: "audio/mpeg", FileExtension: "mp3", Encoder: "libmp3lame"},
+	{Codec: "opus", BitrateKbps: 128, MIMEType: "audio/ogg; codecs=opus", FileExtension: "opus", Encoder: "libopus"},
+}
+
+// renditionsMigration transcodes the lossless uploads and adds the renditions to IPFS.
+func (svc *Service) renditionsMigration(post *sgtmpb.Post, db *gorm.DB) (func(*gorm.DB) error, error) {
+	if !post.IsLossless() {
+		return nil, nil
+	}
+	var existing []*sgtmpb.Rendition
+	if err := db.Where(sgtmpb.Rendition{PostID: post.ID}).Find(&existing).Error; err != nil {
+		return nil, err
+	}
+	var missing []renditionSpec
+	for _, spec := range renditionSpecs {
+		found := false
+		for _, rendition := range existing {
+			found = found || rendition.Codec == spec.Codec
+		}
+		if !found {
+			missing = append(missing, spec)
+		}
+	}
+	if len(missing) == 0 {
+		return nil, nil
+	}
+
+	path, cleanup, err := svc.localTrackFile(post)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the audio file: %w", err)
+	}
+	defer cleanup()
+	renditions := make([]*sgtmpb.Rendition, 0, len(missing))
+	for _, spec := range missing {
+		rendition, err := svc.createRendition(path, spec)
+		if err != nil {
+			return nil, fmt.Errorf("%s rendition: %w", spec.Codec, err)
+		}
+		rendition.PostID = post.ID
+		renditions = append(renditions, rendition)
+		svc.logger.Debug("rendition created",
+			zap.Int64("post", post.ID),
+			zap.String("codec", rendition.Codec),
+			zap.String("cid", rendition.IPFSCID),
+			zap.Int64("size", rendition.SizeBytes),
+		)
+	}
+	return func(tx *gorm.DB) error {
+		return tx.Create(&renditions).Error
+	}, nil
+}
+
+// createRendition transcodes a file with ffmpeg and adds the result to IPFS.
+func (svc *Service) createRendition(path string, spec renditionSpec) (*sgtmpb.Rendition, error) {
+	out, err := ioutil.TempFile("", "sgtm-rendition-*."+spec.FileExtension)
+	if err != nil {
+		return nil, err
+	}
+	out.Close()
+	defer os.Remove(out.Name())
+
+	ctx, cancel := context.WithTimeout(svc.ctx, renditionTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(
+		ctx,
+		"ffmpeg",
+		"-v", "error",
+		"-y",
+		"-i", path,
+		"-vn",
+		"-map_metadata", "0",
+		"-c:a", spec.Encoder,
+		"-b:a", fmt.Sprintf("%dk", spec.BitrateKbps),
+		out.Name(),
+	)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("ffmpeg: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	f, err := os.Open(out.Name())
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	cid, err := svc.ipfs.add(f)
+	if err != nil {
+		return nil, fmt.Errorf("ipfs add: %w", err)
+	}
+	return &sgtmpb.Rendition{
+		Codec:         spec.Codec,
+		BitrateKbps:   spec.BitrateKbps,
+		IPFSCID:       cid,
+		MIMEType:      spec.MIMEType,
+		SizeBytes:     stat.Size(),
+		FileExtension: spec.FileExtension,
+	}, nil
+}
+
+// trackSource is a file that can be streamed for a track: the original upload or a rendition.
+type trackSource struct {
+	Format        string
+	IPFSCID       string
+	MIMEType      string
+	SizeBytes     int64
+	FileExtension string
+}
+
+func originalTrackSource(post *sgtmpb.Post) trackSource {
+	return trackSource{
+		Format:        originalFormat,
+		IPFSCID:       post.IPFSCID,
+		MIMEType:      post.MIMEType,
+		SizeBytes:     post.SizeBytes,
+		FileExtension: post.FileExtension,
+	}
+}
+
+func renditionTrackSource(rendition *sgtmpb.Rendition) trackSource {
+	return trackSource{
+		Format:        rendition.Codec,
+		IPFSCID:       rendition.IPFSCID,
+		MIMEType:      rendition.MIMEType,
+		SizeBytes:     rendition.SizeBytes,
+		FileExtension: rendition.FileExtension,
+	}
+}
+
+// selectTrackSource picks the file to stream, from the format query parameter if any,
+// or else from the Accept header. It returns false if the requested format does not exist.
+func selectTrackSource(post *sgtmpb.Post, format string, accept string) (trackSource, bool) {
+	switch format {
+	case "":
+	case originalFormat:
+		return originalTrackSource(post), true
+	default:
+		if rendition := post.Rendition(format); rendition != nil {
+			return renditionTrackSource(rendition), true
+		}
+		return trackSource{}, false
+	}
+
+	candidates := []trackSource{}
+	for _, spec := range renditionSpecs {
+		if rendition := post.Rendition(spec.Codec); rendition != nil {
+			candidates = append(candidates, renditionTrackSource(rendition))
+		}
+	}
+	candidates = append(candidates, originalTrackSource(post))
+
+	ranges := parseAccept(accept)
+	best, bestQ, bestSpecificity := candidates[len(candidates)-1], 0.0, -1
+	for _, candidate := range candidates {
+		q, specificity := ranges.match(candidate.MIMEType)
+		if q > bestQ || (q == bestQ && q > 0 && specificity > bestSpecificity) {
+			best, bestQ, bestSpecificity = candidate, q, specificity
+		}
+	}
+	return best, true
+}
+
+type acceptRange struct {
+	mediaType string
+	q         float64
+}
+
+type acceptRanges []acceptRange
+
+// parseAccept parses an Accept header; an empty header accepts everything.
+func parseAccept(header string) acceptRanges {
+	if strings.TrimSpace(header) == "" {
+		return acceptRanges{{mediaType: "*/*", q: 1}}
+	}
+	ranges := acceptRanges{}
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		ret := acceptRange{mediaType: strings.ToLower(strings.TrimSpace(fields[0])), q: 1}
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					ret.q = q
+				}
+			}
+		}
+		if ret.mediaType != "" {
+			ranges = append(ranges, ret)
+		}
+	}
+	return ranges
+}
+
+// match returns the quality of a MIME type, and the specificity of the matching range:
+// 2 for an exact match, 1 for "type/*" and 0 for "*/*".
+func (ranges acceptRanges) match(mimeType string) (float64, int) {
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(mimeType, ";")[0]))
+	mainType := strings.Split(mediaType, "/")[0]
+	q, specificity := 0.0, -1
+	for _, r := range ranges {
+		var s int
+		switch r.mediaType {
+		case mediaType:
+			s = 2
+		case mainType + "/*":
+			s = 1
+		case "*/*":
+			s = 0
+		default:
+			continue
+		}
+		if s > specificity {
+			q, specificity = r.q, s
+		}
+	}
+	return q, specificity
+}
@@ -0,0 +1,124 @@
+package sgtm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+func TestSelectTrackSource(t *testing.T) {
+	post := &sgtmpb.Post{
+		Provider:      sgtmpb.Provider_IPFS,
+		MIMEType:      "audio/wav",
+		FileExtension: "wav",
+		IPFSCID:       "original-cid",
+		Renditions: []*sgtmpb.Rendition{
+			{Codec: "opus", MIMEType: "audio/ogg; codecs=opus", IPFSCID: "opus-cid", FileExtension: "opus"},
+			{Codec: "mp3", MIMEType: "audio/mpeg", IPFSCID: "mp3-cid", FileExtension: "mp3"},
+		},
+	}
+	tests := []struct {
+		format   string
+		accept   string
+		expected string
+	}{
+		{"", "", "mp3"},
+		{"", "*/*", "mp3"},
+		{"", "audio/*", "mp3"},
+		{"", "audio/ogg", "opus"},
+		{"", "audio/ogg;q=0.9, audio/mpeg;q=0.5", "opus"},
+		{"", "audio/wav", "original"},
+		{"", "audio/wav, audio/*;q=0.1", "original"},
+		{"", "audio/*, audio/mpeg;q=0", "opus"},
+		{"", "text/html", "original"},
+		{"original", "audio/mpeg", "original"},
+		{"opus", "", "opus"},
+		{"mp3", "audio/wav", "mp3"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s-%s", tt.format, tt.accept), func(t *testing.T) {
+			source, ok := selectTrackSource(post, tt.format, tt.accept)
+			require.True(t, ok)
+			require.Equal(t, tt.expected, source.Format)
+		})
+	}
+
+	_, ok := selectTrackSource(post, "flac", "")
+	require.False(t, ok)
+
+	// without renditions
+	source, ok := selectTrackSource(&sgtmpb.Post{MIMEType: "audio/mpeg"}, "", "audio/ogg")
+	require.True(t, ok)
+	require.Equal(t, originalFormat, source.Format)
+}
+
+func TestRenditionsMigration(t *testing.T) {
+	require.True(t, (&sgtmpb.Post{Provider: sgtmpb.Provider_IPFS, FileExtension: "WAV"}).IsLossless())
+	require.True(t, (&sgtmpb.Post{Provider: sgtmpb.Provider_IPFS, MIMEType: "audio/x-flac"}).IsLossless())
+	require.False(t, (&sgtmpb.Post{Provider: sgtmpb.Provider_IPFS, FileExtension: "mp3", MIMEType: "audio/mpeg"}).IsLossless())
+	require.False(t, (&sgtmpb.Post{Provider: sgtmpb.Provider_SoundCloud}).IsLossless())
+
+	svc := TestingService(t)
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+
+	// lossy uploads are skipped
+	track := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Provider: sgtmpb.Provider_IPFS, FileExtension: "mp3", MIMEType: "audio/mpeg"}
+	require.NoError(t, svc.rwdb().Create(&track).Error)
+	save, err := svc.renditionsMigration(&track, svc.rwdb())
+	require.NoError(t, err)
+	require.Nil(t, save)
+
+	// the renditions of the lossless uploads are transcoded and added to IPFS
+	testingBinary(t, "ipfs", `case "$1" in cat) printf 'RIFF-original' ;; add) printf 'Qm%s\n' "$(sha256sum | cut -c1-44)" ;; esac`)
+	testingBinary(t, "ffmpeg", `for last; do :; done; echo "$@" > "$last"`)
+	lossless := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Provider: sgtmpb.Provider_IPFS, FileExtension: "wav", IPFSCID: "QmOriginal"}
+	require.NoError(t, svc.rwdb().Create(&lossless).Error)
+	save, err = svc.renditionsMigration(&lossless, svc.rwdb())
+	require.NoError(t, err)
+	require.NotNil(t, save)
+	var count int64
+	require.NoError(t, svc.rodb().Model(&sgtmpb.Rendition{}).Count(&count).Error)
+	require.Zero(t, count) // nothing is saved before the transaction
+	require.NoError(t, svc.rwdb().Transaction(save))
+
+	var loaded sgtmpb.Post
+	require.NoError(t, svc.rodb().Preload("Renditions").First(&loaded, lossless.ID).Error)
+	require.Len(t, loaded.Renditions, len(renditionSpecs))
+	mp3, opus := loaded.Rendition("mp3"), loaded.Rendition("opus")
+	require.NotNil(t, mp3)
+	require.NotNil(t, opus)
+	require.Equal(t, "audio/mpeg", mp3.MIMEType)
+	require.Equal(t, int64(192), mp3.BitrateKbps)
+	require.Equal(t, "opus", opus.FileExtension)
+	require.True(t, strings.HasPrefix(mp3.IPFSCID, "Qm"))
+	require.NotEqual(t, mp3.IPFSCID, opus.IPFSCID)
+	require.NotZero(t, mp3.SizeBytes)
+
+	// lossless uploads with all the renditions are skipped
+	save, err = svc.renditionsMigration(&lossless, svc.rwdb())
+	require.NoError(t, err)
+	require.Nil(t, save)
+
+	// only the missing renditions are created
+	partial := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Provider: sgtmpb.Provider_IPFS, FileExtension: "flac", IPFSCID: "QmPartial"}
+	require.NoError(t, svc.rwdb().Create(&partial).Error)
+	require.NoError(t, svc.rwdb().Create(&sgtmpb.Rendition{PostID: partial.ID, Codec: "mp3"}).Error)
+	save, err = svc.renditionsMigration(&partial, svc.rwdb())
+	require.NoError(t, err)
+	require.NoError(t, svc.rwdb().Transaction(save))
+	var completed sgtmpb.Post
+	require.NoError(t, svc.rodb().Preload("Renditions").First(&completed, partial.ID).Error)
+	require.Len(t, completed.Renditions, len(renditionSpecs))
+	require.NotEmpty(t, completed.Rendition("opus").IPFSCID)
+
+	// the transcoding errors fail the migration
+	testingBinary(t, "ffmpeg", `echo "unknown encoder" >&2; exit 1`)
+	failing := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Provider: sgtmpb.Provider_IPFS, FileExtension: "wav", IPFSCID: "QmFailing"}
+	require.NoError(t, svc.rwdb().Create(&failing).Error)
+	_, err = svc.renditionsMigration(&failing, svc.rwdb())
+	require.EqualError(t, err, "mp3 rendition: ffmpeg: exit status 1: unknown encoder")
+}
@@ -26,28 +26,46 @@ type Service struct {
 
 	// drivers
 
-	discord          discordDriver
+	discord          *discordDriver
 	server           serverDriver
 	processingWorker processingWorkerDriver
 	ipfs             ipfsWrapper
+	soundcloud       SoundCloudClient
+	bandcamp         BandcampClient
+	activities       *activityBus
+	health           *healthCache
+	sitemap          *sitemapCache
+	bpm              bpmAnalyzer
 }
 
 func New(db *gorm.DB, opts Opts) (Service, error) {
 	if err := opts.applyDefaults(); err != nil {
 		return Service{}, err
 	}
+	bpm, err := newBPMAnalyzer(opts.BPMAnalyzer)
+	if err != nil {
+		return Service{}, err
+	}
 	fmt.Fprintln(os.Stderr, banner.Inline("sgtm"))
 	ctx, cancel := context.WithCancel(opts.Context)
 	svc := Service{
-		_db:       db,
-		logger:    opts.Logger,
-		opts:      opts,
-		ctx:       ctx,
-		cancel:    cancel,
-		StartedAt: time.Now(),
-		ipfs:      ipfsWrapper{api: opts.IPFSAPI},
+		_db:              db,
+		logger:           opts.Logger,
+		opts:             opts,
+		ctx:              ctx,
+		cancel:           cancel,
+		StartedAt:        time.Now(),
+		ipfs:             ipfsWrapper{api: opts.IPFSAPI},
+		soundcloud:       opts.SoundCloudClient,
+		bandcamp:         opts.BandcampClient,
+		discord:          newDiscordDriver(),
+		activities:       newActivityBus(),
+		health:           newHealthCache(),
+		sitemap:          newSitemapCache(),
+		processingWorker: newProcessingWorkerDriver(),
+		bpm:              bpm,
 	}
-	svc.logger.Info("service initialized", zap.Bool("dev-mode", opts.DevMode))
+	svc.logger.Info("service initialized", zap.Bool("dev-mode", opts.DevMode), zap.String("bpm-analyzer", bpm.Name()))
 	return svc, nil
 }
 
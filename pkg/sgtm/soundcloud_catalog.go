@@ -0,0 +1,207 @@
+package sgtm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+// soundcloudCatalogPayload is the payload of the ImportSoundCloudCatalog jobs.
+// Each job imports a page of the public tracks of a user and enqueues the next page with the totals so far,
+// so an interrupted import resumes from its last page.
+type soundcloudCatalogPayload struct {
+	SoundCloudUserID uint64 `json:"soundcloud_user_id"`
+	Cursor           string `json:"cursor,omitempty"` // params of the page, empty for the first page
+	Imported         int64  `json:"imported"`         // totals of the previous pages, including this page once done
+	Skipped          int64  `json:"skipped"`
+}
+
+// soundcloudCatalogImport is the progress of the import of the SoundCloud tracks of a user, from its last job.
+type soundcloudCatalogImport struct {
+	Job     *sgtmpb.Job
+	Payload soundcloudCatalogPayload
+}
+
+func (i *soundcloudCatalogImport) InProgress() bool {
+	return i.Job.State == sgtmpb.Job_PendingState || i.Job.State == sgtmpb.Job_RunningState
+}
+
+func (i *soundcloudCatalogImport) Failed() bool { return i.Job.State == sgtmpb.Job_FailedState }
+func (i *soundcloudCatalogImport) Done() bool   { return i.Job.State == sgtmpb.Job_DoneState }
+
+func newSoundCloudCatalogJob(userID int64, payload soundcloudCatalogPayload) (*sgtmpb.Job, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid job payload: %w", err)
+	}
+	return &sgtmpb.Job{
+		Kind:        sgtmpb.Job_ImportSoundCloudCatalogKind,
+		State:       sgtmpb.Job_PendingState,
+		UserID:      userID,
+		Payload:     string(raw),
+		MaxAttempts: jobDefaultMaxAttempts,
+		NextRunAt:   time.Now().UnixNano(),
+	}, nil
+}
+
+// soundcloudCatalogImport returns the progress of the last import of the SoundCloud tracks of a user,
+// or nil if the user never started one.
+func (svc *Service) soundcloudCatalogImport(userID int64) (*soundcloudCatalogImport, error) {
+	var job sgtmpb.Job
+	err := svc.rodb().
+		Where(sgtmpb.Job{Kind: sgtmpb.Job_ImportSoundCloudCatalogKind, UserID: userID}).
+		Order("id desc").
+		First(&job).
+		Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	ret := soundcloudCatalogImport{Job: &job}
+	if err := json.Unmarshal([]byte(job.Payload), &ret.Payload); err != nil {
+		return nil, fmt.Errorf("invalid job payload: %w", err)
+	}
+	return &ret, nil
+}
+
+// importSoundCloudCatalog starts the import of the public tracks of the SoundCloud profile of a user.
+// A failed import is resumed from its last page, and an import in progress is left untouched.
+func (svc *Service) importSoundCloudCatalog(user *sgtmpb.User) (*soundcloudCatalogImport, error) {
+	current, err := svc.soundcloudCatalogImport(user.ID)
+	if err != nil {
+		return nil, err
+	}
+	var payload soundcloudCatalogPayload
+	switch {
+	case current != nil && current.InProgress():
+		return current, nil
+	case current != nil && current.Failed():
+		payload = current.Payload
+	default:
+		if user.SoundcloudUsername == "" {
+			return nil, postInputError("Set your SoundCloud profile first.")
+		}
+		u, err := svc.soundcloud.Resolve("https://soundcloud.com/" + user.SoundcloudUsername)
+		if err != nil {
+			return nil, postInputError("This profile does not exist on SoundCloud.com.")
+		}
+		payload.SoundCloudUserID, err = soundcloudUserIDFromAPIURL(u)
+		if err != nil {
+			return nil, postInputError(fmt.Sprintf("Parse user ID: %s.", err.Error()))
+		}
+	}
+
+	job, err := newSoundCloudCatalogJob(user.ID, payload)
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.rwdb().Create(job).Error; err != nil {
+		return nil, err
+	}
+	svc.logger.Debug("soundcloud catalog import enqueued", zap.Int64("user", user.ID), zap.Int64("job", job.ID))
+	svc.wakeProcessingWorker()
+	return &soundcloudCatalogImport{Job: job, Payload: payload}, nil
+}
+
+// importSoundCloudCatalogJob imports a page of the public tracks of a SoundCloud user, skips the tracks that were
+// already imported, and enqueues the next page.
+// The page is fetched outside of any transaction, then the tracks and the next job are saved in a short one.
+// The tracks keep their SoundCloud creation date as sort date, and are processed by the next processing loop.
+func (svc *Service) importSoundCloudCatalogJob(job *sgtmpb.Job, db *gorm.DB) error {
+	if job.UserID == 0 {
+		return fmt.Errorf("job %d has no user", job.ID)
+	}
+	var payload soundcloudCatalogPayload
+	if err := json.Unmarshal([]byte(job.Payload), &payload); err != nil {
+		return fmt.Errorf("invalid job payload: %w", err)
+	}
+	params, err := url.ParseQuery(payload.Cursor)
+	if err != nil {
+		return fmt.Errorf("invalid cursor: %w", err)
+	}
+	page, err := svc.soundcloud.UserTracks(payload.SoundCloudUserID, params)
+	if err != nil {
+		return err
+	}
+	next, err := page.NextParams()
+	if err != nil {
+		return err
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		for _, track := range page.Collection {
+			if track.Sharing != "" && track.Sharing != "public" {
+				payload.Skipped++
+				continue
+			}
+			var count int64
+			err := tx.
+				Model(&sgtmpb.Post{}).
+				Where(sgtmpb.Post{SoundCloudKind: sgtmpb.Post_SoundCloudTrack, SoundCloudID: track.Id}).
+				Count(&count).
+				Error
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				payload.Skipped++
+				continue
+			}
+
+			// the imported tracks are not published in the activity stream, to not flood it
+			post := sgtmpb.Post{
+				Kind:           sgtmpb.Post_TrackKind,
+				Visibility:     sgtmpb.Visibility_Public,
+				AuthorID:       job.UserID,
+				SortDate:       time.Now().UnixNano(),
+				SoundCloudKind: sgtmpb.Post_SoundCloudTrack,
+				SoundCloudID:   track.Id,
+			}
+			applySoundCloudTrack(&post, track)
+			if post.ProviderCreatedAt != 0 {
+				post.SortDate = post.ProviderCreatedAt
+			}
+			if err := tx.Create(&post).Error; err != nil {
+				return err
+			}
+			payload.Imported++
+		}
+
+		raw, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("invalid job payload: %w", err)
+		}
+		if err := tx.Model(job).Update("payload", string(raw)).Error; err != nil {
+			return err
+		}
+		if next != nil && len(page.Collection) > 0 {
+			payload.Cursor = next.Encode()
+			nextJob, err := newSoundCloudCatalogJob(job.UserID, payload)
+			if err != nil {
+				return err
+			}
+			if err := tx.Create(nextJob).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	svc.logger.Debug("soundcloud catalog page imported",
+		zap.Int64("user", job.UserID),
+		zap.Int64("imported", payload.Imported),
+		zap.Int64("skipped", payload.Skipped),
+		zap.Bool("last", next == nil),
+	)
+	return nil
+}
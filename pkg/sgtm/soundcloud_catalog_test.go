@@ -0,0 +1,123 @@
+package sgtm
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yanatan16/golang-soundcloud/soundcloud"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+func TestSoundCloudCatalogImport(t *testing.T) {
+	svc := TestingService(t)
+	svc.processingWorker.trackMigrations = nil
+
+	secondPageDown := true
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/resolve.json":
+			if r.URL.Query().Get("url") != "https://soundcloud.com/author" {
+				http.NotFound(w, r)
+				return
+			}
+			http.Redirect(w, r, "https://api.soundcloud.com/users/5.json", http.StatusFound)
+		case "/users/5/tracks":
+			switch r.URL.Query().Get("cursor") {
+			case "":
+				_ = json.NewEncoder(w).Encode(SoundCloudTrackPage{
+					Collection: []*soundcloud.Track{
+						{Id: 21, Title: "first", Sharing: "public", CreatedAt: "2019/05/01 10:00:00 +0000"},
+						{Id: 22, Title: "already imported", Sharing: "public"},
+					},
+					NextHref: server.URL + "/users/5/tracks?client_id=test&cursor=page2&limit=50&linked_partitioning=true",
+				})
+			case "page2":
+				if secondPageDown {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_ = json.NewEncoder(w).Encode(SoundCloudTrackPage{
+					Collection: []*soundcloud.Track{
+						{Id: 23, Title: "private", Sharing: "private"},
+						{Id: 24, Title: "last", Sharing: "public", CreatedAt: "2020/02/02 20:00:00 +0000"},
+					},
+				})
+			default:
+				http.NotFound(w, r)
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	svc.soundcloud = NewSoundCloudClient("test", server.URL)
+
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author", SoundcloudUsername: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	other := sgtmpb.User{Email: "other@example.com", Slug: "other"}
+	require.NoError(t, svc.rwdb().Create(&other).Error)
+	existing := sgtmpb.Post{AuthorID: other.ID, Kind: sgtmpb.Post_TrackKind, Provider: sgtmpb.Provider_SoundCloud, SoundCloudKind: sgtmpb.Post_SoundCloudTrack, SoundCloudID: 22}
+	require.NoError(t, svc.rwdb().Create(&existing).Error)
+
+	// a SoundCloud profile is required
+	_, err := svc.importSoundCloudCatalog(&other)
+	require.Error(t, err)
+	progress, err := svc.soundcloudCatalogImport(author.ID)
+	require.NoError(t, err)
+	require.Nil(t, progress)
+
+	progress, err = svc.importSoundCloudCatalog(&author)
+	require.NoError(t, err)
+	require.True(t, progress.InProgress())
+	require.Equal(t, uint64(5), progress.Payload.SoundCloudUserID)
+	again, err := svc.importSoundCloudCatalog(&author)
+	require.NoError(t, err)
+	require.Equal(t, progress.Job.ID, again.Job.ID)
+
+	// the first page is imported, the second page is retried later
+	require.NoError(t, svc.processingLoop(0))
+	progress, err = svc.soundcloudCatalogImport(author.ID)
+	require.NoError(t, err)
+	require.True(t, progress.InProgress())
+	require.Equal(t, "cursor=page2&limit=50&linked_partitioning=true", progress.Payload.Cursor)
+	require.Equal(t, int64(1), progress.Payload.Imported)
+	require.Equal(t, int64(1), progress.Payload.Skipped)
+	require.NotEmpty(t, progress.Job.LastError)
+
+	// a failed import resumes from its last page
+	require.NoError(t, svc.rwdb().Model(progress.Job).Update("state", sgtmpb.Job_FailedState).Error)
+	secondPageDown = false
+	progress, err = svc.importSoundCloudCatalog(&author)
+	require.NoError(t, err)
+	require.Equal(t, "cursor=page2&limit=50&linked_partitioning=true", progress.Payload.Cursor)
+	require.NoError(t, svc.processingLoop(0))
+	progress, err = svc.soundcloudCatalogImport(author.ID)
+	require.NoError(t, err)
+	require.True(t, progress.Done())
+	require.Equal(t, int64(2), progress.Payload.Imported)
+	require.Equal(t, int64(2), progress.Payload.Skipped)
+
+	// the tracks keep their SoundCloud date
+	var tracks []*sgtmpb.Post
+	require.NoError(t, svc.rodb().Where(sgtmpb.Post{AuthorID: author.ID}).Order("sort_date").Find(&tracks).Error)
+	require.Len(t, tracks, 2)
+	require.Equal(t, uint64(21), tracks[0].SoundCloudID)
+	require.Equal(t, time.Date(2019, 5, 1, 10, 0, 0, 0, time.UTC).UnixNano(), tracks[0].SortDate)
+	require.Equal(t, uint64(24), tracks[1].SoundCloudID)
+	require.Equal(t, sgtmpb.Visibility_Public, tracks[1].Visibility)
+
+	// importing again only skips the known tracks
+	_, err = svc.importSoundCloudCatalog(&author)
+	require.NoError(t, err)
+	require.NoError(t, svc.processingLoop(0))
+	progress, err = svc.soundcloudCatalogImport(author.ID)
+	require.NoError(t, err)
+	require.True(t, progress.Done())
+	require.Equal(t, int64(0), progress.Payload.Imported)
+	require.Equal(t, int64(4), progress.Payload.Skipped)
+}
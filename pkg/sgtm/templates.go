@@ -17,7 +17,6 @@ import (
 	"github.com/microcosm-cc/bluemonday"
 	blackfriday "github.com/russross/blackfriday/v2"
 	"go.uber.org/zap"
-	"gorm.io/gorm"
 	"moul.io/sgtm/internal/sgtmversion"
 	"moul.io/sgtm/pkg/sgtmpb"
 )
@@ -39,28 +38,27 @@ func (svc *Service) newTemplateData(w http.ResponseWriter, r *http.Request) (*te
 		data.Title += " (dev)"
 	}
 
-	if cookie, err := r.Cookie(oauthTokenCookie); err == nil {
-		data.JWTToken = cookie.Value
-		var err error
-		data.Claims, err = svc.parseJWTToken(data.JWTToken)
-		if err != nil {
-			return nil, fmt.Errorf("parse jwt token: %w", err)
+	identity, ok := authIdentityFromContext(r.Context())
+	if !ok { // pages served without the httpAuthenticate middleware, i.e., 404
+		identity = svc.httpIdentity(r)
+	}
+	if user := identity.User; user != nil {
+		if cookie, err := r.Cookie(oauthTokenCookie); err == nil {
+			data.JWTToken = cookie.Value
 		}
-		var user sgtmpb.User
+		data.Claims = identity.Claims
 		if err := svc.rodb().
-			Preload("RecentPosts", func(db *gorm.DB) *gorm.DB {
-				return db.
-					Where("kind IN (?)", []sgtmpb.Post_Kind{sgtmpb.Post_TrackKind}).
-					Order("created_at desc").
-					Limit(3)
-			}).
-			First(&user, data.Claims.Session.UserID).
+			Where("author_id = ? AND kind IN (?)", user.ID, []sgtmpb.Post_Kind{sgtmpb.Post_TrackKind}).
+			Order("created_at desc").
+			Limit(3).
+			Find(&user.RecentPosts).
 			Error; err != nil {
-			svc.logger.Warn("load user from DB", zap.Error(err))
+			svc.logger.Warn("load recent posts from DB", zap.Error(err))
 		}
-		data.User = &user
+		data.User = user
 		data.UserID = user.ID
-		data.IsAdmin = user.Role == "admin"
+		data.IsAdmin = isAdmin(user)
+		data.IsModerator = hasRole(user, roleModerator)
 		// w.Header().Set("SGTM-User-ID", fmt.Sprintf("%d", user.ID))
 		w.Header().Set("SGTM-User-Slug", user.Slug)
 	} else {
@@ -158,6 +156,7 @@ type templateData struct {
 	Opts             Opts
 	Lang             string
 	IsAdmin          bool
+	IsModerator      bool
 	User             *sgtmpb.User
 	UserID           int64
 	Error            string
@@ -174,13 +173,19 @@ type templateData struct {
 	}
 	Home struct {
 		LastTracks []*sgtmpb.Post
+		NextCursor string
 		LastUsers  []*sgtmpb.User
 	} `json:"Home,omitempty"`
 	Settings struct {
+		APITokens         []*sgtmpb.APIToken
+		APITokenScopes    []string
+		NewAPITokenSecret string
+		SoundCloudImport  *soundcloudCatalogImport // last import of the SoundCloud tracks, if any
 	} `json:"Settings,omitempty"`
 	Profile struct {
 		User       *sgtmpb.User
 		LastTracks []*sgtmpb.Post
+		NextCursor string
 		Stats      struct {
 			Tracks int64
 			// Drafts int64
@@ -208,10 +213,27 @@ type templateData struct {
 		URLInvalidMsg string
 	} `json:"New,omitempty"`
 	Post struct {
-		Post     *sgtmpb.Post
-		Comments []*sgtmpb.Post
+		Post               *sgtmpb.Post
+		Comments           []*sgtmpb.Post
+		PossibleDuplicates []*sgtmpb.Post // only for the author
+		ProcessingError    string         // only for the author and the admins
+		Embed              template.HTML  // player of the provider
+		Original           *ProviderLink  // page of the track on the provider
 	} `json:"Post,omitempty"`
+	Album struct {
+		Album    *sgtmpb.Post
+		Tracks   []*sgtmpb.Post
+		Embed    template.HTML // player of the provider
+		Original *ProviderLink // page of the album on the provider
+	} `json:"Album,omitempty"`
 	PostEdit struct {
 		Post *sgtmpb.Post
 	} `json:"PostEdit,omitempty"`
+	Lineage struct {
+		Post  *sgtmpb.Post
+		Roots []*lineageNode
+	} `json:"Lineage,omitempty"`
+	AdminProcessing struct {
+		Failures []*sgtmpb.ProcessingFailure
+	} `json:"AdminProcessing,omitempty"`
 }
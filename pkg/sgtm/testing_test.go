@@ -3,15 +3,24 @@ package sgtm
 import (
 	"context"
 	"flag"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/bwmarrin/snowflake"
+	jwt "github.com/dgrijalva/jwt-go"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	gormlogger "gorm.io/gorm/logger"
 	"gorm.io/gorm/schema"
+	"moul.io/sgtm/pkg/sgtmpb"
 	"moul.io/zapconfig"
 	"moul.io/zapgorm2"
 )
@@ -26,12 +35,20 @@ func TestingService(t *testing.T) Service {
 	opts.applyDefaults()
 	ctx, cancel := context.WithCancel(opts.Context)
 	svc := Service{
-		_db:       db,
-		logger:    opts.Logger,
-		opts:      opts,
-		ctx:       ctx,
-		cancel:    cancel,
-		StartedAt: time.Now(),
+		_db:              db,
+		logger:           opts.Logger,
+		opts:             opts,
+		ctx:              ctx,
+		cancel:           cancel,
+		StartedAt:        time.Now(),
+		soundcloud:       opts.SoundCloudClient,
+		bandcamp:         opts.BandcampClient,
+		discord:          newDiscordDriver(),
+		activities:       newActivityBus(),
+		health:           newHealthCache(),
+		sitemap:          newSitemapCache(),
+		processingWorker: newProcessingWorkerDriver(),
+		bpm:              goBPMAnalyzer{},
 	}
 	return svc
 }
@@ -52,6 +69,12 @@ func TestingDB(t *testing.T) *gorm.DB {
 	if err != nil {
 		t.Fatalf("gorm.Open")
 	}
+	// each connection opens another in-memory database
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("db.DB")
+	}
+	sqlDB.SetMaxOpenConns(1)
 
 	sfn, err := snowflake.NewNode(1)
 	if err != nil {
@@ -72,3 +95,68 @@ func TestingLogger(t *testing.T) *zap.Logger {
 	}
 	return zap.NewNop()
 }
+
+func testingAuthContext(t *testing.T, svc *Service, userID int64) context.Context {
+	t.Helper()
+
+	claims := jwtClaims{
+		Session: &sgtmpb.Session{UserID: userID},
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: time.Now().Add(time.Hour).Unix(),
+			Audience:  "sgtm",
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(svc.opts.JWTSigningKey))
+	if err != nil {
+		t.Fatalf("jwt.SignedString")
+	}
+	return metadata.NewOutgoingContext(context.Background(), metadata.Pairs(oauthTokenCookie, token))
+}
+
+// testingClient returns a client calling the service through an in-memory gRPC server, with its interceptors.
+func testingClient(t *testing.T, svc *Service) sgtmpb.WebAPIClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := svc.grpcServer()
+	go func() { _ = server.Serve(listener) }()
+	dialer := func(context.Context, string) (net.Conn, error) { return listener.Dial() }
+	conn, err := grpc.Dial("bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("grpc.Dial")
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+	})
+	return sgtmpb.NewWebAPIClient(conn)
+}
+
+// testingEmptyPath removes every binary from $PATH, until the end of the test.
+func testingEmptyPath(t *testing.T) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "sgtm-empty")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir")
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := os.Getenv("PATH")
+	os.Setenv("PATH", dir)
+	t.Cleanup(func() { os.Setenv("PATH", path) })
+}
+
+// testingBinary installs a stub of an external binary, i.e., youtube-dl, with the given shell script in the $PATH.
+func testingBinary(t *testing.T, name string, script string) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "sgtm-bin")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir")
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	if err := ioutil.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script), 0o755); err != nil {
+		t.Fatalf("ioutil.WriteFile")
+	}
+	path := os.Getenv("PATH")
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+path)
+	t.Cleanup(func() { os.Setenv("PATH", path) })
+}
@@ -0,0 +1,308 @@
+package sgtm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi"
+	packr "github.com/gobuffalo/packr/v2"
+	"gorm.io/gorm"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+const (
+	// waveformVersion should be incremented when the algorithm changes,
+	// the tracks with an older waveform are then processed again.
+	waveformVersion    = 2
+	waveformWidth      = 1800 // number of min/max pairs for a long track
+	waveformMaxSeconds = 2 * 60 * 60
+	waveformPNGWidth   = 1200
+	waveformPNGHeight  = 630
+	waveformSVGHeight  = 256
+	waveformPeakScale  = 127 // the peaks are signed 8-bit values in [-127, 127]
+)
+
+var (
+	waveformBackground = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	waveformForeground = color.RGBA{R: 0xff, G: 0x55, B: 0x00, A: 0xff}
+)
+
+// waveformMigrationName changes with the version, so the existing tracks are processed again.
+func waveformMigrationName() string { return fmt.Sprintf("waveform-v%d", waveformVersion) }
+
+// waveformMigration computes the peaks of the track and replaces the previous waveform.
+func (svc *Service) waveformMigration(post *sgtmpb.Post, _ *gorm.DB) (func(*gorm.DB) error, error) {
+	if post.Kind != sgtmpb.Post_TrackKind || !hasProvider(post) {
+		return nil, nil
+	}
+
+	path, cleanup, err := svc.localTrackFile(post)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the audio file: %w", err)
+	}
+	defer cleanup()
+	ctx, cancel := context.WithTimeout(svc.ctx, renditionTimeout)
+	defer cancel()
+	builder := newWaveformBuilder(waveformWidth)
+	rate, err := streamMonoPCM(ctx, path, waveformMaxSeconds, builder.add)
+	if err != nil {
+		return nil, err
+	}
+	waveform := builder.waveform(rate)
+	if waveform == nil {
+		return nil, fmt.Errorf("empty audio file")
+	}
+	waveform.PostID = post.ID
+
+	return func(tx *gorm.DB) error {
+		if err := tx.Where(sgtmpb.Waveform{PostID: post.ID}).Delete(&sgtmpb.Waveform{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(waveform).Error; err != nil {
+			return err
+		}
+		return tx.Model(post).Update("waveform_version", waveform.Version).Error
+	}, nil
+}
+
+// computeWaveform returns the min/max peaks of samples in [-1, 1], using at most width pairs.
+func computeWaveform(samples []float64, rate int, width int) *sgtmpb.Waveform {
+	builder := newWaveformBuilder(width)
+	builder.add(samples)
+	return builder.waveform(rate)
+}
+
+// waveformBuilder computes the min/max peaks of a stream of samples without knowing its length:
+// it starts with a pair per sample, and merges the pairs two by two each time there are twice as
+// many as needed, so its memory does not depend on the duration of the track.
+type waveformBuilder struct {
+	width           int
+	samplesPerPixel int
+	peaks           []float64 // min/max pairs of the complete pixels
+	min, max        float64   // peaks of the current pixel
+	count           int       // number of samples in the current pixel
+}
+
+func newWaveformBuilder(width int) *waveformBuilder {
+	return &waveformBuilder{width: width, samplesPerPixel: 1}
+}
+
+func (b *waveformBuilder) add(samples []float64) {
+	if b.width < 1 {
+		return
+	}
+	for _, sample := range samples {
+		if b.count == 0 {
+			b.min, b.max = sample, sample
+		} else {
+			b.min = math.Min(b.min, sample)
+			b.max = math.Max(b.max, sample)
+		}
+		b.count++
+		if b.count == b.samplesPerPixel {
+			b.peaks = append(b.peaks, b.min, b.max)
+			b.count = 0
+			if len(b.peaks) == 4*b.width {
+				b.merge()
+			}
+		}
+	}
+}
+
+// merge halves the number of pixels; the last pixel can be incomplete.
+func (b *waveformBuilder) merge() {
+	length := len(b.peaks) / 2
+	for i := 0; i < length; i += 2 {
+		min, max := b.peaks[i*2], b.peaks[i*2+1]
+		if i+1 < length {
+			min = math.Min(min, b.peaks[i*2+2])
+			max = math.Max(max, b.peaks[i*2+3])
+		}
+		b.peaks[i], b.peaks[i+1] = min, max
+	}
+	b.peaks = b.peaks[:(length+1)/2*2]
+	b.samplesPerPixel *= 2
+}
+
+// waveform returns the peaks of the samples added so far, or nil if there are none.
+func (b *waveformBuilder) waveform(rate int) *sgtmpb.Waveform {
+	if b.count > 0 {
+		b.peaks = append(b.peaks, b.min, b.max)
+		b.count = 0
+	}
+	for len(b.peaks)/2 > b.width {
+		b.merge()
+	}
+	if len(b.peaks) == 0 {
+		return nil
+	}
+	peaks := make([]byte, len(b.peaks))
+	for i, peak := range b.peaks {
+		peaks[i] = byte(quantizePeak(peak))
+	}
+	return &sgtmpb.Waveform{
+		Version:         waveformVersion,
+		SampleRate:      int64(rate),
+		SamplesPerPixel: int64(b.samplesPerPixel),
+		Length:          int64(len(peaks) / 2),
+		Peaks:           peaks,
+	}
+}
+
+func quantizePeak(value float64) int8 {
+	value = math.Round(value * waveformPeakScale)
+	switch {
+	case value > waveformPeakScale:
+		return waveformPeakScale
+	case value < -waveformPeakScale:
+		return -waveformPeakScale
+	}
+	return int8(value)
+}
+
+// waveformPeak returns the min and max of the i-th pair, in [-1, 1].
+func waveformPeak(waveform *sgtmpb.Waveform, i int) (float64, float64) {
+	min := math.Max(float64(int8(waveform.Peaks[i*2]))/waveformPeakScale, -1)
+	max := math.Min(float64(int8(waveform.Peaks[i*2+1]))/waveformPeakScale, 1)
+	return min, max
+}
+
+// waveformColumn returns the min and max of the pairs covered by the x-th of width columns.
+func waveformColumn(waveform *sgtmpb.Waveform, x int, width int) (float64, float64) {
+	length := len(waveform.Peaks) / 2
+	start := x * length / width
+	end := (x + 1) * length / width
+	if end <= start {
+		end = start + 1
+	}
+	min, max := waveformPeak(waveform, start)
+	for i := start + 1; i < end && i < length; i++ {
+		lo, hi := waveformPeak(waveform, i)
+		min = math.Min(min, lo)
+		max = math.Max(max, hi)
+	}
+	return min, max
+}
+
+// waveformJSON is compatible with the JSON output of audiowaveform, used by peaks.js and wavesurfer.js.
+type waveformJSON struct {
+	Version         int     `json:"version"`
+	Channels        int     `json:"channels"`
+	SampleRate      int64   `json:"sample_rate"`
+	SamplesPerPixel int64   `json:"samples_per_pixel"`
+	Bits            int     `json:"bits"`
+	Length          int64   `json:"length"`
+	Data            []int64 `json:"data"`
+}
+
+func writeWaveformJSON(w io.Writer, waveform *sgtmpb.Waveform) error {
+	ret := waveformJSON{
+		Version:         2,
+		Channels:        1,
+		SampleRate:      waveform.SampleRate,
+		SamplesPerPixel: waveform.SamplesPerPixel,
+		Bits:            8,
+		Length:          waveform.Length,
+		Data:            make([]int64, len(waveform.Peaks)),
+	}
+	for i, peak := range waveform.Peaks {
+		ret.Data[i] = int64(int8(peak))
+	}
+	return json.NewEncoder(w).Encode(ret)
+}
+
+func renderWaveformPNG(w io.Writer, waveform *sgtmpb.Waveform, width int, height int) error {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = waveformBackground.R, waveformBackground.G, waveformBackground.B, waveformBackground.A
+	}
+	if waveform.Length > 0 {
+		center := float64(height) / 2
+		for x := 0; x < width; x++ {
+			min, max := waveformColumn(waveform, x, width)
+			top := int(math.Floor(center - max*center))
+			bottom := int(math.Ceil(center - min*center))
+			for y := top; y <= bottom && y < height; y++ {
+				if y >= 0 {
+					img.SetRGBA(x, y, waveformForeground)
+				}
+			}
+		}
+	}
+	return png.Encode(w, img)
+}
+
+func renderWaveformSVG(w io.Writer, waveform *sgtmpb.Waveform) error {
+	var path strings.Builder
+	center := float64(waveformSVGHeight) / 2
+	for i := 0; i < int(waveform.Length); i++ {
+		min, max := waveformPeak(waveform, i)
+		fmt.Fprintf(&path, "M%d.5 %.1fV%.1f", i, center-max*center, center-min*center+1)
+	}
+	_, err := fmt.Fprintf(w,
+		`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" preserveAspectRatio="none"><path d="%s" stroke="#%02x%02x%02x" stroke-width="1"/></svg>`,
+		waveform.Length, waveformSVGHeight, path.String(),
+		waveformForeground.R, waveformForeground.G, waveformForeground.B,
+	)
+	return err
+}
+
+// postWaveformPage serves the waveform of a track as JSON, PNG or SVG.
+func (svc *Service) postWaveformPage(box *packr.Box, format string) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var user *sgtmpb.User
+		if identity, ok := authIdentityFromContext(r.Context()); ok {
+			user = identity.User
+		}
+		query := whereTrackSlug(svc.rodb(), chi.URLParam(r, "post_slug"))
+		var post sgtmpb.Post
+		if err := query.First(&post).Error; err != nil || !canViewPost(user, &post) {
+			svc.error404Page(box)(w, r)
+			return
+		}
+		var waveform sgtmpb.Waveform
+		if err := svc.rodb().Where(sgtmpb.Waveform{PostID: post.ID}).First(&waveform).Error; err != nil {
+			svc.error404Page(box)(w, r)
+			return
+		}
+
+		var (
+			buf         bytes.Buffer
+			err         error
+			contentType string
+		)
+		switch format {
+		case "json":
+			contentType = "application/json"
+			err = writeWaveformJSON(&buf, &waveform)
+		case "png":
+			contentType = "image/png"
+			err = renderWaveformPNG(&buf, &waveform, waveformPNGWidth, waveformPNGHeight)
+		case "svg":
+			contentType = "image/svg+xml"
+			err = renderWaveformSVG(&buf, &waveform)
+		default:
+			err = fmt.Errorf("unsupported waveform format: %q", format)
+		}
+		if err != nil {
+			svc.errRenderHTML(w, r, err, http.StatusUnprocessableEntity)
+			return
+		}
+		w.Header().Set("Content-Type", contentType)
+		if post.Visibility == sgtmpb.Visibility_Public {
+			w.Header().Set("Cache-Control", "public, max-age=3600")
+		} else { // only served to the author, the shared caches must not keep it
+			w.Header().Set("Cache-Control", "private, max-age=3600")
+		}
+		_, _ = w.Write(buf.Bytes())
+	}
+}
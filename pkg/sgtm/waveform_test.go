@@ -0,0 +1,151 @@
+package sgtm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"moul.io/sgtm/pkg/sgtmpb"
+)
+
+func TestComputeWaveform(t *testing.T) {
+	require.Nil(t, computeWaveform(nil, 44100, 10))
+
+	samples := make([]float64, 1000)
+	for i := range samples {
+		samples[i] = float64(i%100)/50 - 1 // saw in [-1, 0.98]
+	}
+	samples[150] = 2 // clipped
+	waveform := computeWaveform(samples, 44100, 7)
+	require.Equal(t, int64(waveformVersion), waveform.Version)
+	require.Equal(t, int64(256), waveform.SamplesPerPixel)
+	require.Equal(t, int64(4), waveform.Length)
+	require.Len(t, waveform.Peaks, 8)
+	require.Equal(t, int8(-127), int8(waveform.Peaks[0]))
+	require.Equal(t, int8(127), int8(waveform.Peaks[1]))
+	require.Equal(t, int8(124), int8(waveform.Peaks[3]))
+	min, max := waveformPeak(waveform, 0)
+	require.Equal(t, []float64{-1, 1}, []float64{min, max})
+
+	// the samples can be streamed by chunks, the memory only depends on the width
+	builder := newWaveformBuilder(7)
+	for i := 0; i < len(samples); i += 33 {
+		end := i + 33
+		if end > len(samples) {
+			end = len(samples)
+		}
+		builder.add(samples[i:end])
+		require.LessOrEqual(t, len(builder.peaks), 4*7)
+	}
+	require.Equal(t, waveform, builder.waveform(44100))
+
+	// short tracks keep one pair per sample
+	waveform = computeWaveform([]float64{0.5, -0.5}, 44100, 1800)
+	require.Equal(t, int64(2), waveform.Length)
+	require.Equal(t, []byte{64, 64, 192, 192}, waveform.Peaks)
+}
+
+func TestStreamMonoPCM(t *testing.T) {
+	// 10000 s16le samples alternating between 0.5 and -0.5, in chunks of an odd number of bytes
+	testingBinary(t, "ffmpeg", `i=0; while [ $i -lt 5000 ]; do printf '\000\100\000\300'; i=$((i+1)); done | dd bs=999 2>/dev/null`)
+	f, err := ioutil.TempFile("", "sgtm-audio")
+	require.NoError(t, err)
+	f.Close()
+	defer os.Remove(f.Name())
+
+	builder := newWaveformBuilder(10)
+	total := 0
+	rate, err := streamMonoPCM(context.Background(), f.Name(), 60, func(samples []float64) {
+		total += len(samples)
+		builder.add(samples)
+	})
+	require.NoError(t, err)
+	require.Equal(t, bpmDecodeRate, rate)
+	require.Equal(t, 10000, total)
+	waveform := builder.waveform(rate)
+	require.Equal(t, int64(1024), waveform.SamplesPerPixel)
+	require.Equal(t, int64(10), waveform.Length)
+	require.Equal(t, int8(-64), int8(waveform.Peaks[0]))
+	require.Equal(t, int8(64), int8(waveform.Peaks[1]))
+
+	testingBinary(t, "ffmpeg", `echo "invalid data" >&2; exit 1`)
+	_, err = streamMonoPCM(context.Background(), f.Name(), 60, func([]float64) {})
+	require.EqualError(t, err, "ffmpeg: exit status 1: invalid data")
+}
+
+func TestPostWaveformPage(t *testing.T) {
+	svc := TestingService(t)
+	author := sgtmpb.User{Email: "author@example.com", Slug: "author"}
+	require.NoError(t, svc.rwdb().Create(&author).Error)
+	track := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Public, Slug: "track"}
+	require.NoError(t, svc.rwdb().Create(&track).Error)
+	samples := make([]float64, 6400)
+	for i := range samples {
+		samples[i] = 0.8 * float64(i%40-20) / 20
+	}
+	waveform := computeWaveform(samples, 11025, 100)
+	waveform.PostID = track.ID
+	require.NoError(t, svc.rwdb().Create(waveform).Error)
+
+	router := chi.NewRouter()
+	router.Use(svc.httpAuthenticate)
+	for _, format := range []string{"json", "png", "svg"} {
+		router.Get("/post/{post_slug}/waveform."+format, svc.postWaveformPage(nil, format))
+	}
+	get := func(format string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest("GET", fmt.Sprintf("/post/%s/waveform.%s", track.Slug, format), nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		return rec
+	}
+
+	var body waveformJSON
+	rec := get("json")
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	require.Equal(t, "public, max-age=3600", rec.Header().Get("Cache-Control"))
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, int64(100), body.Length)
+	require.Equal(t, int64(64), body.SamplesPerPixel)
+	require.Len(t, body.Data, 200)
+	require.Equal(t, int64(-102), body.Data[0])
+	require.Equal(t, int64(97), body.Data[1])
+
+	rec = get("png")
+	img, err := png.Decode(rec.Body)
+	require.NoError(t, err)
+	require.Equal(t, waveformPNGWidth, img.Bounds().Dx())
+	require.Equal(t, waveformPNGHeight, img.Bounds().Dy())
+	r, g, b, _ := img.At(waveformPNGWidth/2, waveformPNGHeight/2).RGBA()
+	require.Equal(t, []uint32{0xffff, 0x5555, 0}, []uint32{r, g, b})
+	r, g, b, _ = img.At(0, 0).RGBA()
+	require.Equal(t, []uint32{0xffff, 0xffff, 0xffff}, []uint32{r, g, b})
+
+	rec = get("svg")
+	require.Equal(t, "image/svg+xml", rec.Header().Get("Content-Type"))
+	require.True(t, strings.HasPrefix(rec.Body.String(), `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 256"`))
+	require.Equal(t, 100, strings.Count(rec.Body.String(), "M"))
+
+	// the drafts are only served to their author, and not kept by the shared caches
+	draft := sgtmpb.Post{AuthorID: author.ID, Kind: sgtmpb.Post_TrackKind, Visibility: sgtmpb.Visibility_Draft, Slug: "draft"}
+	require.NoError(t, svc.rwdb().Create(&draft).Error)
+	waveform = computeWaveform(samples, 11025, 100)
+	waveform.PostID = draft.ID
+	require.NoError(t, svc.rwdb().Create(waveform).Error)
+	req := httptest.NewRequest("GET", "/post/draft/waveform.svg", nil)
+	md, _ := metadata.FromOutgoingContext(testingAuthContext(t, &svc, author.ID))
+	req.AddCookie(&http.Cookie{Name: oauthTokenCookie, Value: md.Get(oauthTokenCookie)[0]})
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "private, max-age=3600", rec.Header().Get("Cache-Control"))
+}
@@ -1,30 +1,21 @@
 package sgtmpb
 
 import (
-	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
-
-	"ultre.me/calcbiz/pkg/soundcloud"
 )
 
 // Post
 
-func (p *Post) ApplyDefaults() {
-	if p.ArtworkURL == "" && p.Provider == Provider_SoundCloud {
-		var metadata soundcloud.Track
-		err := json.Unmarshal([]byte(p.ProviderMetadata), &metadata)
-		if err == nil {
-			p.ArtworkURL = metadata.User.AvatarURL
-		}
-	}
-}
-
 func (p *Post) CanonicalURL() string {
 	if p == nil {
 		return "#"
 	}
+	if p.Kind == Post_AlbumKind {
+		return fmt.Sprintf("/album/%d", p.ID)
+	}
 	return fmt.Sprintf("/post/%d", p.ID)
 }
 
@@ -49,6 +40,14 @@ func (p *Post) SafeTitle() string {
 	return "noname"
 }
 
+// SafeKeySignature returns the key set on the provider, or the key detected by the analysis.
+func (p *Post) SafeKeySignature() string {
+	if p.KeySignature != "" {
+		return p.KeySignature
+	}
+	return p.DetectedKey
+}
+
 func (p *Post) SafeLyrics() string {
 	return strings.TrimSpace(p.Lyrics)
 }
@@ -56,11 +55,58 @@ func (p *Post) SafeLyrics() string {
 func (p *Post) Filter() {
 	p.ProviderMetadata = ""
 	p.DownloadURL = ""
+	p.PossibleDuplicates = ""
+	if p.IsDeleted() {
+		p.Body = ""
+	}
+	for _, track := range p.AlbumTracks {
+		track.Filter()
+	}
 }
 
+func (p *Post) IsDeleted() bool { return p.GetDeletedAt() != 0 }
+
 func (p *Post) IsSoundCloud() bool { return p.GetProvider() == Provider_SoundCloud }
 func (p *Post) IsIPFS() bool       { return p.GetProvider() == Provider_IPFS }
 
+// IsPendingImport returns true if the audio file of a track imported from a link is not pinned to IPFS yet.
+func (p *Post) IsPendingImport() bool { return p.IsIPFS() && p.GetIPFSCID() == "" }
+
+// IsUnavailable returns true if the track was deleted or made private on its provider.
+func (p *Post) IsUnavailable() bool {
+	switch p.GetProviderAvailability() {
+	case Post_ProviderDeleted, Post_ProviderPrivate, Post_ProviderTokenRevoked:
+		return true
+	}
+	return false
+}
+
+// IsLossless returns true for the uploads in a lossless format.
+func (p *Post) IsLossless() bool {
+	if !p.IsIPFS() {
+		return false
+	}
+	switch strings.ToLower(p.FileExtension) {
+	case "wav", "wave", "flac", "aif", "aiff":
+		return true
+	}
+	switch strings.ToLower(p.MIMEType) {
+	case "audio/wav", "audio/wave", "audio/x-wav", "audio/flac", "audio/x-flac", "audio/aiff", "audio/x-aiff":
+		return true
+	}
+	return false
+}
+
+// Rendition returns the rendition with the given codec, or nil.
+func (p *Post) Rendition(codec string) *Rendition {
+	for _, rendition := range p.GetRenditions() {
+		if rendition.Codec == codec {
+			return rendition
+		}
+	}
+	return nil
+}
+
 func (p *Post) TagList() []string {
 	if strings.TrimSpace(p.Tags) == "" {
 		return nil
@@ -72,6 +118,27 @@ func (p *Post) TagList() []string {
 	return tags
 }
 
+// PossibleDuplicateIDs returns the IDs of the tracks with a similar fingerprint.
+func (p *Post) PossibleDuplicateIDs() []int64 {
+	ids := []int64{}
+	for _, value := range strings.Split(p.PossibleDuplicates, ",") {
+		if id, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// IsEdited returns true if the author has edited the field, by its proto field name.
+func (p *Post) IsEdited(field string) bool {
+	for _, edited := range strings.Split(p.EditedFields, ",") {
+		if edited == field {
+			return true
+		}
+	}
+	return false
+}
+
 // User
 
 func (u *User) ApplyDefaults() {
@@ -117,3 +184,64 @@ func (u *User) Filter() {
 	u.DiscordUsername = ""
 	u.DiscordID = ""
 }
+
+// APIToken
+
+func (t *APIToken) ScopeList() []string {
+	if strings.TrimSpace(t.Scopes) == "" {
+		return nil
+	}
+	scopes := strings.Split(t.Scopes, ",")
+	for idx, scope := range scopes {
+		scopes[idx] = strings.TrimSpace(scope)
+	}
+	return scopes
+}
+
+func (t *APIToken) HasScope(scope string) bool {
+	for _, candidate := range t.ScopeList() {
+		if candidate == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *APIToken) Filter() {
+	t.TokenHash = ""
+}
+
+// Relationship
+
+// IsTrackToTrack returns true for the kinds linking two tracks, i.e., a remix and its original.
+func (k Relationship_Kind) IsTrackToTrack() bool {
+	switch k {
+	case Relationship_RemixOfTrackKind, Relationship_NewVersionOfTrackKind, Relationship_InspiredByTrackKind:
+		return true
+	}
+	return false
+}
+
+// IsTrackToUser returns true for the kinds linking a track to a user, i.e., a featuring.
+func (k Relationship_Kind) IsTrackToUser() bool {
+	switch k {
+	case Relationship_FeaturingUserKind, Relationship_RemixOfUserKind:
+		return true
+	}
+	return false
+}
+
+// Verb returns a human-readable description of the kind, i.e., "remix of".
+func (k Relationship_Kind) Verb() string {
+	switch k {
+	case Relationship_FeaturingUserKind:
+		return "feat."
+	case Relationship_RemixOfTrackKind, Relationship_RemixOfUserKind:
+		return "remix of"
+	case Relationship_NewVersionOfTrackKind:
+		return "new version of"
+	case Relationship_InspiredByTrackKind:
+		return "inspired by"
+	}
+	return "related to"
+}
@@ -15,6 +15,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 const (
@@ -83,6 +84,9 @@ const (
 	Provider_UnknownProvider Provider = 0
 	Provider_SoundCloud      Provider = 1
 	Provider_IPFS            Provider = 2
+	Provider_Bandcamp        Provider = 3
+	Provider_YouTube         Provider = 4
+	Provider_YoutubeDL       Provider = 5 // any other site supported by youtube-dl
 )
 
 // Enum value maps for Provider.
@@ -91,11 +95,17 @@ var (
 		0: "UnknownProvider",
 		1: "SoundCloud",
 		2: "IPFS",
+		3: "Bandcamp",
+		4: "YouTube",
+		5: "YoutubeDL",
 	}
 	Provider_value = map[string]int32{
 		"UnknownProvider": 0,
 		"SoundCloud":      1,
 		"IPFS":            2,
+		"Bandcamp":        3,
+		"YouTube":         4,
+		"YoutubeDL":       5,
 	}
 )
 
@@ -126,11 +136,122 @@ func (Provider) EnumDescriptor() ([]byte, []int) {
 	return file_sgtm_proto_rawDescGZIP(), []int{1}
 }
 
+type Status_State int32
+
+const (
+	Status_UnknownState  Status_State = 0
+	Status_OKState       Status_State = 1
+	Status_DegradedState Status_State = 2
+	Status_DownState     Status_State = 3
+	Status_DisabledState Status_State = 4
+)
+
+// Enum value maps for Status_State.
+var (
+	Status_State_name = map[int32]string{
+		0: "UnknownState",
+		1: "OKState",
+		2: "DegradedState",
+		3: "DownState",
+		4: "DisabledState",
+	}
+	Status_State_value = map[string]int32{
+		"UnknownState":  0,
+		"OKState":       1,
+		"DegradedState": 2,
+		"DownState":     3,
+		"DisabledState": 4,
+	}
+)
+
+func (x Status_State) Enum() *Status_State {
+	p := new(Status_State)
+	*p = x
+	return p
+}
+
+func (x Status_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_sgtm_proto_enumTypes[2].Descriptor()
+}
+
+func (Status_State) Type() protoreflect.EnumType {
+	return &file_sgtm_proto_enumTypes[2]
+}
+
+func (x Status_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status_State.Descriptor instead.
+func (Status_State) EnumDescriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type Post_ProviderAvailability int32
+
+const (
+	Post_UnknownProviderAvailability Post_ProviderAvailability = 0
+	Post_ProviderAvailable           Post_ProviderAvailability = 1
+	Post_ProviderDeleted             Post_ProviderAvailability = 2
+	Post_ProviderPrivate             Post_ProviderAvailability = 3
+	Post_ProviderTokenRevoked        Post_ProviderAvailability = 4 // the secret token of a private track was reset
+)
+
+// Enum value maps for Post_ProviderAvailability.
+var (
+	Post_ProviderAvailability_name = map[int32]string{
+		0: "UnknownProviderAvailability",
+		1: "ProviderAvailable",
+		2: "ProviderDeleted",
+		3: "ProviderPrivate",
+		4: "ProviderTokenRevoked",
+	}
+	Post_ProviderAvailability_value = map[string]int32{
+		"UnknownProviderAvailability": 0,
+		"ProviderAvailable":           1,
+		"ProviderDeleted":             2,
+		"ProviderPrivate":             3,
+		"ProviderTokenRevoked":        4,
+	}
+)
+
+func (x Post_ProviderAvailability) Enum() *Post_ProviderAvailability {
+	p := new(Post_ProviderAvailability)
+	*p = x
+	return p
+}
+
+func (x Post_ProviderAvailability) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Post_ProviderAvailability) Descriptor() protoreflect.EnumDescriptor {
+	return file_sgtm_proto_enumTypes[3].Descriptor()
+}
+
+func (Post_ProviderAvailability) Type() protoreflect.EnumType {
+	return &file_sgtm_proto_enumTypes[3]
+}
+
+func (x Post_ProviderAvailability) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Post_ProviderAvailability.Descriptor instead.
+func (Post_ProviderAvailability) EnumDescriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{29, 0}
+}
+
 type Post_SoundCloudKind int32
 
 const (
 	Post_UnknownSoundCloudKind Post_SoundCloudKind = 0
-	Post_SoundCloudTrack       Post_SoundCloudKind = 1 //SoundCloudPlaylist = 2;
+	Post_SoundCloudTrack       Post_SoundCloudKind = 1
+	Post_SoundCloudPlaylist    Post_SoundCloudKind = 2
 )
 
 // Enum value maps for Post_SoundCloudKind.
@@ -138,10 +259,12 @@ var (
 	Post_SoundCloudKind_name = map[int32]string{
 		0: "UnknownSoundCloudKind",
 		1: "SoundCloudTrack",
+		2: "SoundCloudPlaylist",
 	}
 	Post_SoundCloudKind_value = map[string]int32{
 		"UnknownSoundCloudKind": 0,
 		"SoundCloudTrack":       1,
+		"SoundCloudPlaylist":    2,
 	}
 )
 
@@ -156,11 +279,11 @@ func (x Post_SoundCloudKind) String() string {
 }
 
 func (Post_SoundCloudKind) Descriptor() protoreflect.EnumDescriptor {
-	return file_sgtm_proto_enumTypes[2].Descriptor()
+	return file_sgtm_proto_enumTypes[4].Descriptor()
 }
 
 func (Post_SoundCloudKind) Type() protoreflect.EnumType {
-	return &file_sgtm_proto_enumTypes[2]
+	return &file_sgtm_proto_enumTypes[4]
 }
 
 func (x Post_SoundCloudKind) Number() protoreflect.EnumNumber {
@@ -169,7 +292,7 @@ func (x Post_SoundCloudKind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Post_SoundCloudKind.Descriptor instead.
 func (Post_SoundCloudKind) EnumDescriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{8, 0}
+	return file_sgtm_proto_rawDescGZIP(), []int{29, 1}
 }
 
 type Post_Kind int32
@@ -185,21 +308,23 @@ const (
 	Post_ViewOpenKind           Post_Kind = 7
 	Post_ViewHomeKind           Post_Kind = 8
 	Post_CommentKind            Post_Kind = 9
+	Post_AlbumKind              Post_Kind = 10 // ordered group of tracks, i.e., an album or an EP imported from a SoundCloud set
 )
 
 // Enum value maps for Post_Kind.
 var (
 	Post_Kind_name = map[int32]string{
-		0: "UnknownKind",
-		1: "TrackKind",
-		2: "LoginKind",
-		3: "RegisterKind",
-		4: "LinkDiscordAccountKind",
-		5: "ViewProfileKind",
-		6: "ViewPostKind",
-		7: "ViewOpenKind",
-		8: "ViewHomeKind",
-		9: "CommentKind",
+		0:  "UnknownKind",
+		1:  "TrackKind",
+		2:  "LoginKind",
+		3:  "RegisterKind",
+		4:  "LinkDiscordAccountKind",
+		5:  "ViewProfileKind",
+		6:  "ViewPostKind",
+		7:  "ViewOpenKind",
+		8:  "ViewHomeKind",
+		9:  "CommentKind",
+		10: "AlbumKind",
 	}
 	Post_Kind_value = map[string]int32{
 		"UnknownKind":            0,
@@ -212,6 +337,7 @@ var (
 		"ViewOpenKind":           7,
 		"ViewHomeKind":           8,
 		"CommentKind":            9,
+		"AlbumKind":              10,
 	}
 )
 
@@ -226,11 +352,11 @@ func (x Post_Kind) String() string {
 }
 
 func (Post_Kind) Descriptor() protoreflect.EnumDescriptor {
-	return file_sgtm_proto_enumTypes[3].Descriptor()
+	return file_sgtm_proto_enumTypes[5].Descriptor()
 }
 
 func (Post_Kind) Type() protoreflect.EnumType {
-	return &file_sgtm_proto_enumTypes[3]
+	return &file_sgtm_proto_enumTypes[5]
 }
 
 func (x Post_Kind) Number() protoreflect.EnumNumber {
@@ -239,7 +365,7 @@ func (x Post_Kind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Post_Kind.Descriptor instead.
 func (Post_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{8, 1}
+	return file_sgtm_proto_rawDescGZIP(), []int{29, 2}
 }
 
 type Relationship_Kind int32
@@ -284,11 +410,11 @@ func (x Relationship_Kind) String() string {
 }
 
 func (Relationship_Kind) Descriptor() protoreflect.EnumDescriptor {
-	return file_sgtm_proto_enumTypes[4].Descriptor()
+	return file_sgtm_proto_enumTypes[6].Descriptor()
 }
 
 func (Relationship_Kind) Type() protoreflect.EnumType {
-	return &file_sgtm_proto_enumTypes[4]
+	return &file_sgtm_proto_enumTypes[6]
 }
 
 func (x Relationship_Kind) Number() protoreflect.EnumNumber {
@@ -297,7 +423,178 @@ func (x Relationship_Kind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Relationship_Kind.Descriptor instead.
 func (Relationship_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{9, 0}
+	return file_sgtm_proto_rawDescGZIP(), []int{30, 0}
+}
+
+type Job_Kind int32
+
+const (
+	Job_UnknownKind                  Job_Kind = 0
+	Job_ProcessTrackKind             Job_Kind = 1
+	Job_SyncSoundCloudKind           Job_Kind = 2
+	Job_ExtractBPMKind               Job_Kind = 3
+	Job_DetectRelationshipsKind      Job_Kind = 4
+	Job_CheckAvailabilityKind        Job_Kind = 5
+	Job_ImportAudioURLKind           Job_Kind = 6
+	Job_ImportSoundCloudCatalogKind  Job_Kind = 7 // one page of the public tracks of a user, see payload
+	Job_NotifyPossibleDuplicatesKind Job_Kind = 8 // sent once the fingerprint is saved, see payload
+)
+
+// Enum value maps for Job_Kind.
+var (
+	Job_Kind_name = map[int32]string{
+		0: "UnknownKind",
+		1: "ProcessTrackKind",
+		2: "SyncSoundCloudKind",
+		3: "ExtractBPMKind",
+		4: "DetectRelationshipsKind",
+		5: "CheckAvailabilityKind",
+		6: "ImportAudioURLKind",
+		7: "ImportSoundCloudCatalogKind",
+		8: "NotifyPossibleDuplicatesKind",
+	}
+	Job_Kind_value = map[string]int32{
+		"UnknownKind":                  0,
+		"ProcessTrackKind":             1,
+		"SyncSoundCloudKind":           2,
+		"ExtractBPMKind":               3,
+		"DetectRelationshipsKind":      4,
+		"CheckAvailabilityKind":        5,
+		"ImportAudioURLKind":           6,
+		"ImportSoundCloudCatalogKind":  7,
+		"NotifyPossibleDuplicatesKind": 8,
+	}
+)
+
+func (x Job_Kind) Enum() *Job_Kind {
+	p := new(Job_Kind)
+	*p = x
+	return p
+}
+
+func (x Job_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Job_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_sgtm_proto_enumTypes[7].Descriptor()
+}
+
+func (Job_Kind) Type() protoreflect.EnumType {
+	return &file_sgtm_proto_enumTypes[7]
+}
+
+func (x Job_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Job_Kind.Descriptor instead.
+func (Job_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{32, 0}
+}
+
+type Job_State int32
+
+const (
+	Job_UnknownState Job_State = 0
+	Job_PendingState Job_State = 1
+	Job_RunningState Job_State = 2
+	Job_DoneState    Job_State = 3
+	Job_FailedState  Job_State = 4
+)
+
+// Enum value maps for Job_State.
+var (
+	Job_State_name = map[int32]string{
+		0: "UnknownState",
+		1: "PendingState",
+		2: "RunningState",
+		3: "DoneState",
+		4: "FailedState",
+	}
+	Job_State_value = map[string]int32{
+		"UnknownState": 0,
+		"PendingState": 1,
+		"RunningState": 2,
+		"DoneState":    3,
+		"FailedState":  4,
+	}
+)
+
+func (x Job_State) Enum() *Job_State {
+	p := new(Job_State)
+	*p = x
+	return p
+}
+
+func (x Job_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Job_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_sgtm_proto_enumTypes[8].Descriptor()
+}
+
+func (Job_State) Type() protoreflect.EnumType {
+	return &file_sgtm_proto_enumTypes[8]
+}
+
+func (x Job_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Job_State.Descriptor instead.
+func (Job_State) EnumDescriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{32, 1}
+}
+
+type MigrationRun_State int32
+
+const (
+	MigrationRun_UnknownState MigrationRun_State = 0
+	MigrationRun_DoneState    MigrationRun_State = 1
+	MigrationRun_FailedState  MigrationRun_State = 2
+)
+
+// Enum value maps for MigrationRun_State.
+var (
+	MigrationRun_State_name = map[int32]string{
+		0: "UnknownState",
+		1: "DoneState",
+		2: "FailedState",
+	}
+	MigrationRun_State_value = map[string]int32{
+		"UnknownState": 0,
+		"DoneState":    1,
+		"FailedState":  2,
+	}
+)
+
+func (x MigrationRun_State) Enum() *MigrationRun_State {
+	p := new(MigrationRun_State)
+	*p = x
+	return p
+}
+
+func (x MigrationRun_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MigrationRun_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_sgtm_proto_enumTypes[9].Descriptor()
+}
+
+func (MigrationRun_State) Type() protoreflect.EnumType {
+	return &file_sgtm_proto_enumTypes[9]
+}
+
+func (x MigrationRun_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MigrationRun_State.Descriptor instead.
+func (MigrationRun_State) EnumDescriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{34, 0}
 }
 
 type Ping struct {
@@ -490,14 +787,14 @@ func (*PostList) Descriptor() ([]byte, []int) {
 	return file_sgtm_proto_rawDescGZIP(), []int{4}
 }
 
-type PostSync struct {
+type PostGet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *PostSync) Reset() {
-	*x = PostSync{}
+func (x *PostGet) Reset() {
+	*x = PostGet{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sgtm_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -505,13 +802,13 @@ func (x *PostSync) Reset() {
 	}
 }
 
-func (x *PostSync) String() string {
+func (x *PostGet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostSync) ProtoMessage() {}
+func (*PostGet) ProtoMessage() {}
 
-func (x *PostSync) ProtoReflect() protoreflect.Message {
+func (x *PostGet) ProtoReflect() protoreflect.Message {
 	mi := &file_sgtm_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -523,19 +820,19 @@ func (x *PostSync) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostSync.ProtoReflect.Descriptor instead.
-func (*PostSync) Descriptor() ([]byte, []int) {
+// Deprecated: Use PostGet.ProtoReflect.Descriptor instead.
+func (*PostGet) Descriptor() ([]byte, []int) {
 	return file_sgtm_proto_rawDescGZIP(), []int{5}
 }
 
-type Me struct {
+type PostCreate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Me) Reset() {
-	*x = Me{}
+func (x *PostCreate) Reset() {
+	*x = PostCreate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sgtm_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -543,13 +840,13 @@ func (x *Me) Reset() {
 	}
 }
 
-func (x *Me) String() string {
+func (x *PostCreate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Me) ProtoMessage() {}
+func (*PostCreate) ProtoMessage() {}
 
-func (x *Me) ProtoReflect() protoreflect.Message {
+func (x *PostCreate) ProtoReflect() protoreflect.Message {
 	mi := &file_sgtm_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -561,49 +858,19 @@ func (x *Me) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Me.ProtoReflect.Descriptor instead.
-func (*Me) Descriptor() ([]byte, []int) {
+// Deprecated: Use PostCreate.ProtoReflect.Descriptor instead.
+func (*PostCreate) Descriptor() ([]byte, []int) {
 	return file_sgtm_proto_rawDescGZIP(), []int{6}
 }
 
-type User struct {
+type PostUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ID                    int64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
-	CreatedAt             int64           `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"autocreatetime:nano"`
-	UpdatedAt             int64           `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"autoupdatetime:nano"`
-	DeletedAt             int64           `protobuf:"varint,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
-	Email                 string          `protobuf:"bytes,10,opt,name=email,proto3" json:"email,omitempty" gorm:"size:255;not null;index:,unique"`
-	Slug                  string          `protobuf:"bytes,11,opt,name=slug,proto3" json:"slug,omitempty" gorm:"size:32;not null;default:''"`
-	Firstname             string          `protobuf:"bytes,12,opt,name=firstname,proto3" json:"firstname,omitempty" gorm:"size:255;not null;default:''"`
-	Lastname              string          `protobuf:"bytes,13,opt,name=lastname,proto3" json:"lastname,omitempty" gorm:"size:255;not null;default:''"`
-	Locale                string          `protobuf:"bytes,14,opt,name=locale,proto3" json:"locale,omitempty" gorm:"size:32;not null;default:''"`
-	Avatar                string          `protobuf:"bytes,15,opt,name=avatar,proto3" json:"avatar,omitempty" gorm:"size:255;not null;default:''"`
-	DiscordID             string          `protobuf:"bytes,16,opt,name=discord_id,json=discordId,proto3" json:"discord_id,omitempty" gorm:"size:255;not null;default:''"`
-	DiscordUsername       string          `protobuf:"bytes,17,opt,name=discord_username,json=discordUsername,proto3" json:"discord_username,omitempty" gorm:"size:255;not null;default:''"`
-	Headline              string          `protobuf:"bytes,18,opt,name=headline,proto3" json:"headline,omitempty"`
-	Bio                   string          `protobuf:"bytes,19,opt,name=bio,proto3" json:"bio,omitempty"`
-	Inspirations          string          `protobuf:"bytes,20,opt,name=inspirations,proto3" json:"inspirations,omitempty"`
-	Gears                 string          `protobuf:"bytes,21,opt,name=gears,proto3" json:"gears,omitempty"`
-	Genres                string          `protobuf:"bytes,22,opt,name=genres,proto3" json:"genres,omitempty"`
-	Location              string          `protobuf:"bytes,23,opt,name=location,proto3" json:"location,omitempty"`
-	TwitterUsername       string          `protobuf:"bytes,24,opt,name=twitter_username,json=twitterUsername,proto3" json:"twitter_username,omitempty"`
-	Homepage              string          `protobuf:"bytes,25,opt,name=homepage,proto3" json:"homepage,omitempty"`
-	OtherLinks            string          `protobuf:"bytes,26,opt,name=other_links,json=otherLinks,proto3" json:"other_links,omitempty"`
-	Goals                 string          `protobuf:"bytes,27,opt,name=goals,proto3" json:"goals,omitempty"`
-	SoundcloudUsername    string          `protobuf:"bytes,28,opt,name=soundcloud_username,json=soundcloudUsername,proto3" json:"soundcloud_username,omitempty"`
-	Role                  string          `protobuf:"bytes,29,opt,name=role,proto3" json:"role,omitempty"`
-	ProcessingVersion     int64           `protobuf:"varint,30,opt,name=processing_version,json=processingVersion,proto3" json:"processing_version,omitempty"`
-	ProcessingError       string          `protobuf:"bytes,31,opt,name=processing_error,json=processingError,proto3" json:"processing_error,omitempty"`
-	RecentPosts           []*Post         `protobuf:"bytes,50,rep,name=recent_posts,json=recentPosts,proto3" json:"recent_posts,omitempty" gorm:"foreignkey:AuthorID;PRELOAD:false"`
-	RelationshipsAsSource []*Relationship `protobuf:"bytes,51,rep,name=relationships_as_source,json=relationshipsAsSource,proto3" json:"relationships_as_source,omitempty" gorm:"foreignKey:SourceUserID"`
-	RelationshipsAsTarget []*Relationship `protobuf:"bytes,52,rep,name=relationships_as_target,json=relationshipsAsTarget,proto3" json:"relationships_as_target,omitempty" gorm:"foreignKey:TargetUserID"`
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *PostUpdate) Reset() {
+	*x = PostUpdate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sgtm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -611,13 +878,13 @@ func (x *User) Reset() {
 	}
 }
 
-func (x *User) String() string {
+func (x *PostUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*PostUpdate) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
+func (x *PostUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_sgtm_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -629,284 +896,262 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
+// Deprecated: Use PostUpdate.ProtoReflect.Descriptor instead.
+func (*PostUpdate) Descriptor() ([]byte, []int) {
 	return file_sgtm_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *User) GetID() int64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
+type PostDelete struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *User) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
+func (x *PostDelete) Reset() {
+	*x = PostDelete{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *User) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
+func (x *PostDelete) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *User) GetDeletedAt() int64 {
-	if x != nil {
-		return x.DeletedAt
+func (*PostDelete) ProtoMessage() {}
+
+func (x *PostDelete) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+// Deprecated: Use PostDelete.ProtoReflect.Descriptor instead.
+func (*PostDelete) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *User) GetSlug() string {
-	if x != nil {
-		return x.Slug
-	}
-	return ""
+type PostSync struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *User) GetFirstname() string {
-	if x != nil {
-		return x.Firstname
+func (x *PostSync) Reset() {
+	*x = PostSync{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *User) GetLastname() string {
-	if x != nil {
-		return x.Lastname
-	}
-	return ""
+func (x *PostSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *User) GetLocale() string {
-	if x != nil {
-		return x.Locale
+func (*PostSync) ProtoMessage() {}
+
+func (x *PostSync) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetAvatar() string {
-	if x != nil {
-		return x.Avatar
-	}
-	return ""
+// Deprecated: Use PostSync.ProtoReflect.Descriptor instead.
+func (*PostSync) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *User) GetDiscordID() string {
-	if x != nil {
-		return x.DiscordID
-	}
-	return ""
+type CommentList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *User) GetDiscordUsername() string {
-	if x != nil {
-		return x.DiscordUsername
+func (x *CommentList) Reset() {
+	*x = CommentList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *User) GetHeadline() string {
-	if x != nil {
-		return x.Headline
-	}
-	return ""
+func (x *CommentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *User) GetBio() string {
-	if x != nil {
-		return x.Bio
+func (*CommentList) ProtoMessage() {}
+
+func (x *CommentList) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetInspirations() string {
-	if x != nil {
-		return x.Inspirations
-	}
-	return ""
+// Deprecated: Use CommentList.ProtoReflect.Descriptor instead.
+func (*CommentList) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *User) GetGears() string {
-	if x != nil {
-		return x.Gears
-	}
-	return ""
+type CommentCreate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *User) GetGenres() string {
-	if x != nil {
-		return x.Genres
+func (x *CommentCreate) Reset() {
+	*x = CommentCreate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *User) GetLocation() string {
-	if x != nil {
-		return x.Location
-	}
-	return ""
+func (x *CommentCreate) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *User) GetTwitterUsername() string {
-	if x != nil {
-		return x.TwitterUsername
+func (*CommentCreate) ProtoMessage() {}
+
+func (x *CommentCreate) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetHomepage() string {
-	if x != nil {
-		return x.Homepage
-	}
-	return ""
+// Deprecated: Use CommentCreate.ProtoReflect.Descriptor instead.
+func (*CommentCreate) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *User) GetOtherLinks() string {
-	if x != nil {
-		return x.OtherLinks
-	}
-	return ""
+type CommentUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *User) GetGoals() string {
-	if x != nil {
-		return x.Goals
+func (x *CommentUpdate) Reset() {
+	*x = CommentUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *User) GetSoundcloudUsername() string {
-	if x != nil {
-		return x.SoundcloudUsername
-	}
-	return ""
+func (x *CommentUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *User) GetRole() string {
-	if x != nil {
-		return x.Role
+func (*CommentUpdate) ProtoMessage() {}
+
+func (x *CommentUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetProcessingVersion() int64 {
-	if x != nil {
-		return x.ProcessingVersion
-	}
-	return 0
+// Deprecated: Use CommentUpdate.ProtoReflect.Descriptor instead.
+func (*CommentUpdate) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *User) GetProcessingError() string {
-	if x != nil {
-		return x.ProcessingError
-	}
-	return ""
+type CommentDelete struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *User) GetRecentPosts() []*Post {
-	if x != nil {
-		return x.RecentPosts
+func (x *CommentDelete) Reset() {
+	*x = CommentDelete{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *User) GetRelationshipsAsSource() []*Relationship {
-	if x != nil {
-		return x.RelationshipsAsSource
-	}
-	return nil
+func (x *CommentDelete) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *User) GetRelationshipsAsTarget() []*Relationship {
-	if x != nil {
-		return x.RelationshipsAsTarget
+func (*CommentDelete) ProtoMessage() {}
+
+func (x *CommentDelete) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type Post struct {
+// Deprecated: Use CommentDelete.ProtoReflect.Descriptor instead.
+func (*CommentDelete) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{13}
+}
+
+type ActivityStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ID                int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
-	CreatedAt         int64      `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"autocreatetime:nano"`
-	UpdatedAt         int64      `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"autoupdatetime:nano"`
-	DeletedAt         int64      `protobuf:"varint,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
-	Author            *User      `protobuf:"bytes,10,opt,name=author,proto3" json:"author,omitempty"`
-	AuthorID          int64      `protobuf:"varint,11,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	Title             string     `protobuf:"bytes,12,opt,name=title,proto3" json:"title,omitempty"`
-	Slug              string     `protobuf:"bytes,13,opt,name=slug,proto3" json:"slug,omitempty"`
-	Kind              Post_Kind  `protobuf:"varint,14,opt,name=kind,proto3,enum=sgtm.Post_Kind" json:"kind,omitempty"`
-	Visibility        Visibility `protobuf:"varint,15,opt,name=visibility,proto3,enum=sgtm.Visibility" json:"visibility,omitempty"`
-	URL               string     `protobuf:"bytes,16,opt,name=url,proto3" json:"url,omitempty"`
-	Provider          Provider   `protobuf:"varint,17,opt,name=provider,proto3,enum=sgtm.Provider" json:"provider,omitempty"`
-	Body              string     `protobuf:"bytes,18,opt,name=body,proto3" json:"body,omitempty"`
-	SortDate          int64      `protobuf:"varint,19,opt,name=sort_date,json=sortDate,proto3" json:"sort_date,omitempty"`
-	ProcessingVersion int64      `protobuf:"varint,20,opt,name=processing_version,json=processingVersion,proto3" json:"processing_version,omitempty"`
-	ProcessingError   string     `protobuf:"bytes,21,opt,name=processing_error,json=processingError,proto3" json:"processing_error,omitempty"`
-	// Deprecated: Do not use.
-	Genre                 string              `protobuf:"bytes,40,opt,name=genre,proto3" json:"genre,omitempty"` // replaced by 'tags'
-	Duration              uint64              `protobuf:"varint,41,opt,name=duration,proto3" json:"duration,omitempty"`
-	ArtworkURL            string              `protobuf:"bytes,42,opt,name=artwork_url,json=artworkUrl,proto3" json:"artwork_url,omitempty"`
-	BPM                   float64             `protobuf:"fixed64,43,opt,name=bpm,proto3" json:"bpm,omitempty"`
-	KeySignature          string              `protobuf:"bytes,44,opt,name=key_signature,json=keySignature,proto3" json:"key_signature,omitempty"`
-	ISRC                  string              `protobuf:"bytes,45,opt,name=isrc,proto3" json:"isrc,omitempty"`
-	ProviderTitle         string              `protobuf:"bytes,53,opt,name=provider_title,json=providerTitle,proto3" json:"provider_title,omitempty"`
-	ProviderDescription   string              `protobuf:"bytes,46,opt,name=provider_description,json=providerDescription,proto3" json:"provider_description,omitempty"`
-	DownloadURL           string              `protobuf:"bytes,47,opt,name=provider_download_url,json=providerDownloadUrl,proto3" json:"provider_download_url,omitempty"`
-	ProviderCreatedAt     int64               `protobuf:"varint,48,opt,name=provider_created_at,json=providerCreatedAt,proto3" json:"provider_created_at,omitempty"`
-	ProviderUpdatedAt     int64               `protobuf:"varint,49,opt,name=provider_updated_at,json=providerUpdatedAt,proto3" json:"provider_updated_at,omitempty"`
-	ProviderMetadata      string              `protobuf:"bytes,50,opt,name=provider_metadata,json=providerMetadata,proto3" json:"provider_metadata,omitempty"`
-	Tags                  string              `protobuf:"bytes,51,opt,name=tags,proto3" json:"tags,omitempty"` // comma separated list of tags
-	Lyrics                string              `protobuf:"bytes,52,opt,name=lyrics,proto3" json:"lyrics,omitempty"`
-	SoundCloudSecretToken string              `protobuf:"bytes,80,opt,name=soundcloud_secret_token,json=soundcloudSecretToken,proto3" json:"soundcloud_secret_token,omitempty"`
-	SoundCloudID          uint64              `protobuf:"varint,81,opt,name=soundcloud_id,json=soundcloudId,proto3" json:"soundcloud_id,omitempty"`
-	SoundCloudKind        Post_SoundCloudKind `protobuf:"varint,83,opt,name=soundcloud_kind,json=soundcloudKind,proto3,enum=sgtm.Post_SoundCloudKind" json:"soundcloud_kind,omitempty"`
-	IPFSCID               string              `protobuf:"bytes,90,opt,name=ipfs_cid,json=ipfsCid,proto3" json:"ipfs_cid,omitempty"`
-	MIMEType              string              `protobuf:"bytes,91,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	SizeBytes             int64               `protobuf:"varint,92,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	FileExtension         string              `protobuf:"bytes,93,opt,name=file_extension,json=fileExtension,proto3" json:"file_extension,omitempty"`
-	AttachmentFilename    string              `protobuf:"bytes,94,opt,name=attachment_filename,json=attachmentFilename,proto3" json:"attachment_filename,omitempty"`
-	TargetUserID          int64               `protobuf:"varint,101,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
-	TargetUser            *User               `protobuf:"bytes,102,opt,name=target_user,json=targetUser,proto3" json:"target_user,omitempty"`
-	TargetPostID          int64               `protobuf:"varint,103,opt,name=target_post_id,json=targetPostId,proto3" json:"target_post_id,omitempty"`
-	TargetPost            *Post               `protobuf:"bytes,104,opt,name=target_post,json=targetPost,proto3" json:"target_post,omitempty"`
-	TargetMetadata        string              `protobuf:"bytes,105,opt,name=target_metadata,json=targetMetadata,proto3" json:"target_metadata,omitempty"`
-	RelationshipsAsSource []*Relationship     `protobuf:"bytes,110,rep,name=relationships_as_source,json=relationshipsAsSource,proto3" json:"relationships_as_source,omitempty" gorm:"foreignKey:SourcePostID"`
-	RelationshipsAsTarget []*Relationship     `protobuf:"bytes,111,rep,name=relationships_as_target,json=relationshipsAsTarget,proto3" json:"relationships_as_target,omitempty" gorm:"foreignKey:TargetPostID"`
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
+func (x *ActivityStream) Reset() {
+	*x = ActivityStream{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[8]
+		mi := &file_sgtm_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Post) String() string {
+func (x *ActivityStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*ActivityStream) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[8]
+func (x *ActivityStream) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -917,367 +1162,300 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ActivityStream.ProtoReflect.Descriptor instead.
+func (*ActivityStream) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Post) GetID() int64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
+type RelationshipList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Post) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
+func (x *RelationshipList) Reset() {
+	*x = RelationshipList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Post) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
+func (x *RelationshipList) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Post) GetDeletedAt() int64 {
-	if x != nil {
-		return x.DeletedAt
+func (*RelationshipList) ProtoMessage() {}
+
+func (x *RelationshipList) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetAuthor() *User {
-	if x != nil {
-		return x.Author
-	}
-	return nil
+// Deprecated: Use RelationshipList.ProtoReflect.Descriptor instead.
+func (*RelationshipList) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Post) GetAuthorID() int64 {
-	if x != nil {
-		return x.AuthorID
-	}
-	return 0
+type RelationshipCreate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Post) GetTitle() string {
-	if x != nil {
-		return x.Title
+func (x *RelationshipCreate) Reset() {
+	*x = RelationshipCreate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Post) GetSlug() string {
-	if x != nil {
-		return x.Slug
-	}
-	return ""
+func (x *RelationshipCreate) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Post) GetKind() Post_Kind {
-	if x != nil {
-		return x.Kind
-	}
-	return Post_UnknownKind
-}
+func (*RelationshipCreate) ProtoMessage() {}
 
-func (x *Post) GetVisibility() Visibility {
-	if x != nil {
-		return x.Visibility
+func (x *RelationshipCreate) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return Visibility_UnknownVisibility
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetURL() string {
-	if x != nil {
-		return x.URL
-	}
-	return ""
+// Deprecated: Use RelationshipCreate.ProtoReflect.Descriptor instead.
+func (*RelationshipCreate) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Post) GetProvider() Provider {
-	if x != nil {
-		return x.Provider
-	}
-	return Provider_UnknownProvider
+type RelationshipDelete struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Post) GetBody() string {
-	if x != nil {
-		return x.Body
+func (x *RelationshipDelete) Reset() {
+	*x = RelationshipDelete{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Post) GetSortDate() int64 {
-	if x != nil {
-		return x.SortDate
-	}
-	return 0
+func (x *RelationshipDelete) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Post) GetProcessingVersion() int64 {
-	if x != nil {
-		return x.ProcessingVersion
-	}
-	return 0
-}
+func (*RelationshipDelete) ProtoMessage() {}
 
-func (x *Post) GetProcessingError() string {
-	if x != nil {
-		return x.ProcessingError
+func (x *RelationshipDelete) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-// Deprecated: Do not use.
-func (x *Post) GetGenre() string {
-	if x != nil {
-		return x.Genre
-	}
-	return ""
+// Deprecated: Use RelationshipDelete.ProtoReflect.Descriptor instead.
+func (*RelationshipDelete) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Post) GetDuration() uint64 {
-	if x != nil {
-		return x.Duration
-	}
-	return 0
+type RelationshipGraph struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Post) GetArtworkURL() string {
-	if x != nil {
-		return x.ArtworkURL
+func (x *RelationshipGraph) Reset() {
+	*x = RelationshipGraph{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Post) GetBPM() float64 {
-	if x != nil {
-		return x.BPM
-	}
-	return 0
+func (x *RelationshipGraph) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Post) GetKeySignature() string {
-	if x != nil {
-		return x.KeySignature
-	}
-	return ""
-}
+func (*RelationshipGraph) ProtoMessage() {}
 
-func (x *Post) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+func (x *RelationshipGraph) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetProviderTitle() string {
-	if x != nil {
-		return x.ProviderTitle
-	}
-	return ""
+// Deprecated: Use RelationshipGraph.ProtoReflect.Descriptor instead.
+func (*RelationshipGraph) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Post) GetProviderDescription() string {
-	if x != nil {
-		return x.ProviderDescription
-	}
-	return ""
+type APITokenList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Post) GetDownloadURL() string {
-	if x != nil {
-		return x.DownloadURL
+func (x *APITokenList) Reset() {
+	*x = APITokenList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Post) GetProviderCreatedAt() int64 {
-	if x != nil {
-		return x.ProviderCreatedAt
-	}
-	return 0
+func (x *APITokenList) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Post) GetProviderUpdatedAt() int64 {
-	if x != nil {
-		return x.ProviderUpdatedAt
+func (*APITokenList) ProtoMessage() {}
+
+func (x *APITokenList) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetProviderMetadata() string {
-	if x != nil {
-		return x.ProviderMetadata
-	}
-	return ""
+// Deprecated: Use APITokenList.ProtoReflect.Descriptor instead.
+func (*APITokenList) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Post) GetTags() string {
-	if x != nil {
-		return x.Tags
-	}
-	return ""
+type APITokenCreate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Post) GetLyrics() string {
-	if x != nil {
-		return x.Lyrics
+func (x *APITokenCreate) Reset() {
+	*x = APITokenCreate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Post) GetSoundCloudSecretToken() string {
-	if x != nil {
-		return x.SoundCloudSecretToken
-	}
-	return ""
+func (x *APITokenCreate) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Post) GetSoundCloudID() uint64 {
-	if x != nil {
-		return x.SoundCloudID
+func (*APITokenCreate) ProtoMessage() {}
+
+func (x *APITokenCreate) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetSoundCloudKind() Post_SoundCloudKind {
-	if x != nil {
-		return x.SoundCloudKind
-	}
-	return Post_UnknownSoundCloudKind
+// Deprecated: Use APITokenCreate.ProtoReflect.Descriptor instead.
+func (*APITokenCreate) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Post) GetIPFSCID() string {
-	if x != nil {
-		return x.IPFSCID
-	}
-	return ""
+type APITokenRevoke struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Post) GetMIMEType() string {
-	if x != nil {
-		return x.MIMEType
+func (x *APITokenRevoke) Reset() {
+	*x = APITokenRevoke{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Post) GetSizeBytes() int64 {
-	if x != nil {
-		return x.SizeBytes
-	}
-	return 0
+func (x *APITokenRevoke) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Post) GetFileExtension() string {
-	if x != nil {
-		return x.FileExtension
+func (*APITokenRevoke) ProtoMessage() {}
+
+func (x *APITokenRevoke) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetAttachmentFilename() string {
-	if x != nil {
-		return x.AttachmentFilename
-	}
-	return ""
+// Deprecated: Use APITokenRevoke.ProtoReflect.Descriptor instead.
+func (*APITokenRevoke) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Post) GetTargetUserID() int64 {
-	if x != nil {
-		return x.TargetUserID
-	}
-	return 0
-}
-
-func (x *Post) GetTargetUser() *User {
-	if x != nil {
-		return x.TargetUser
-	}
-	return nil
-}
-
-func (x *Post) GetTargetPostID() int64 {
-	if x != nil {
-		return x.TargetPostID
-	}
-	return 0
-}
-
-func (x *Post) GetTargetPost() *Post {
-	if x != nil {
-		return x.TargetPost
-	}
-	return nil
-}
-
-func (x *Post) GetTargetMetadata() string {
-	if x != nil {
-		return x.TargetMetadata
-	}
-	return ""
-}
-
-func (x *Post) GetRelationshipsAsSource() []*Relationship {
-	if x != nil {
-		return x.RelationshipsAsSource
-	}
-	return nil
-}
-
-func (x *Post) GetRelationshipsAsTarget() []*Relationship {
-	if x != nil {
-		return x.RelationshipsAsTarget
-	}
-	return nil
-}
-
-type Relationship struct {
+type MigrationList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ID           int64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
-	CreatedAt    int64             `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"autocreatetime:nano"`
-	UpdatedAt    int64             `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"autoupdatetime:nano"`
-	DeletedAt    int64             `protobuf:"varint,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
-	Kind         Relationship_Kind `protobuf:"varint,10,opt,name=kind,proto3,enum=sgtm.Relationship_Kind" json:"kind,omitempty"`
-	SourcePostID int64             `protobuf:"varint,11,opt,name=source_post_id,json=sourcePostId,proto3" json:"source_post_id,omitempty"`
-	SourcePost   *Post             `protobuf:"bytes,12,opt,name=source_post,json=sourcePost,proto3" json:"source_post,omitempty"`
-	TargetPostID int64             `protobuf:"varint,13,opt,name=target_post_id,json=targetPostId,proto3" json:"target_post_id,omitempty"`
-	TargetPost   *Post             `protobuf:"bytes,14,opt,name=target_post,json=targetPost,proto3" json:"target_post,omitempty"`
-	SourceUserID int64             `protobuf:"varint,15,opt,name=source_user_id,json=sourceUserId,proto3" json:"source_user_id,omitempty"`
-	SourceUser   *User             `protobuf:"bytes,16,opt,name=source_user,json=sourceUser,proto3" json:"source_user,omitempty"`
-	TargetUserID int64             `protobuf:"varint,17,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
-	TargetUser   *User             `protobuf:"bytes,18,opt,name=target_user,json=targetUser,proto3" json:"target_user,omitempty"`
-	SourceRaw    string            `protobuf:"bytes,19,opt,name=source_raw,json=sourceRaw,proto3" json:"source_raw,omitempty"`
-	TargetRaw    string            `protobuf:"bytes,20,opt,name=target_raw,json=targetRaw,proto3" json:"target_raw,omitempty"`
-	Metadata     string            `protobuf:"bytes,21,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *Relationship) Reset() {
-	*x = Relationship{}
+func (x *MigrationList) Reset() {
+	*x = MigrationList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[9]
+		mi := &file_sgtm_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Relationship) String() string {
+func (x *MigrationList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Relationship) ProtoMessage() {}
+func (*MigrationList) ProtoMessage() {}
 
-func (x *Relationship) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[9]
+func (x *MigrationList) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,149 +1466,34 @@ func (x *Relationship) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
-func (*Relationship) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *Relationship) GetID() int64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
-}
-
-func (x *Relationship) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-func (x *Relationship) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
-}
-
-func (x *Relationship) GetDeletedAt() int64 {
-	if x != nil {
-		return x.DeletedAt
-	}
-	return 0
-}
-
-func (x *Relationship) GetKind() Relationship_Kind {
-	if x != nil {
-		return x.Kind
-	}
-	return Relationship_UnknownKind
-}
-
-func (x *Relationship) GetSourcePostID() int64 {
-	if x != nil {
-		return x.SourcePostID
-	}
-	return 0
-}
-
-func (x *Relationship) GetSourcePost() *Post {
-	if x != nil {
-		return x.SourcePost
-	}
-	return nil
-}
-
-func (x *Relationship) GetTargetPostID() int64 {
-	if x != nil {
-		return x.TargetPostID
-	}
-	return 0
-}
-
-func (x *Relationship) GetTargetPost() *Post {
-	if x != nil {
-		return x.TargetPost
-	}
-	return nil
-}
-
-func (x *Relationship) GetSourceUserID() int64 {
-	if x != nil {
-		return x.SourceUserID
-	}
-	return 0
-}
-
-func (x *Relationship) GetSourceUser() *User {
-	if x != nil {
-		return x.SourceUser
-	}
-	return nil
-}
-
-func (x *Relationship) GetTargetUserID() int64 {
-	if x != nil {
-		return x.TargetUserID
-	}
-	return 0
-}
-
-func (x *Relationship) GetTargetUser() *User {
-	if x != nil {
-		return x.TargetUser
-	}
-	return nil
-}
-
-func (x *Relationship) GetSourceRaw() string {
-	if x != nil {
-		return x.SourceRaw
-	}
-	return ""
-}
-
-func (x *Relationship) GetTargetRaw() string {
-	if x != nil {
-		return x.TargetRaw
-	}
-	return ""
-}
-
-func (x *Relationship) GetMetadata() string {
-	if x != nil {
-		return x.Metadata
-	}
-	return ""
+// Deprecated: Use MigrationList.ProtoReflect.Descriptor instead.
+func (*MigrationList) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{22}
 }
 
-type Session struct {
+type MigrationReplay struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserID             int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DiscordAccessToken string `protobuf:"bytes,2,opt,name=discord_access_token,json=discordAccessToken,proto3" json:"discord_access_token,omitempty"`
 }
 
-func (x *Session) Reset() {
-	*x = Session{}
+func (x *MigrationReplay) Reset() {
+	*x = MigrationReplay{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[10]
+		mi := &file_sgtm_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Session) String() string {
+func (x *MigrationReplay) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Session) ProtoMessage() {}
+func (*MigrationReplay) ProtoMessage() {}
 
-func (x *Session) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[10]
+func (x *MigrationReplay) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1441,48 +1504,34 @@ func (x *Session) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Session.ProtoReflect.Descriptor instead.
-func (*Session) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *Session) GetUserID() int64 {
-	if x != nil {
-		return x.UserID
-	}
-	return 0
-}
-
-func (x *Session) GetDiscordAccessToken() string {
-	if x != nil {
-		return x.DiscordAccessToken
-	}
-	return ""
+// Deprecated: Use MigrationReplay.ProtoReflect.Descriptor instead.
+func (*MigrationReplay) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{23}
 }
 
-type Ping_Request struct {
+type ProcessingFailureList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Ping_Request) Reset() {
-	*x = Ping_Request{}
+func (x *ProcessingFailureList) Reset() {
+	*x = ProcessingFailureList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[11]
+		mi := &file_sgtm_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Ping_Request) String() string {
+func (x *ProcessingFailureList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ping_Request) ProtoMessage() {}
+func (*ProcessingFailureList) ProtoMessage() {}
 
-func (x *Ping_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[11]
+func (x *ProcessingFailureList) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1493,34 +1542,34 @@ func (x *Ping_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ping_Request.ProtoReflect.Descriptor instead.
-func (*Ping_Request) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use ProcessingFailureList.ProtoReflect.Descriptor instead.
+func (*ProcessingFailureList) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{24}
 }
 
-type Ping_Response struct {
+type ProcessingFailureRetry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Ping_Response) Reset() {
-	*x = Ping_Response{}
+func (x *ProcessingFailureRetry) Reset() {
+	*x = ProcessingFailureRetry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[12]
+		mi := &file_sgtm_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Ping_Response) String() string {
+func (x *ProcessingFailureRetry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ping_Response) ProtoMessage() {}
+func (*ProcessingFailureRetry) ProtoMessage() {}
 
-func (x *Ping_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[12]
+func (x *ProcessingFailureRetry) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,34 +1580,34 @@ func (x *Ping_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ping_Response.ProtoReflect.Descriptor instead.
-func (*Ping_Response) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{0, 1}
+// Deprecated: Use ProcessingFailureRetry.ProtoReflect.Descriptor instead.
+func (*ProcessingFailureRetry) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{25}
 }
 
-type Status_Request struct {
+type ProcessingFailureClear struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Status_Request) Reset() {
-	*x = Status_Request{}
+func (x *ProcessingFailureClear) Reset() {
+	*x = ProcessingFailureClear{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[13]
+		mi := &file_sgtm_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Status_Request) String() string {
+func (x *ProcessingFailureClear) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Status_Request) ProtoMessage() {}
+func (*ProcessingFailureClear) ProtoMessage() {}
 
-func (x *Status_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[13]
+func (x *ProcessingFailureClear) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1569,38 +1618,34 @@ func (x *Status_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Status_Request.ProtoReflect.Descriptor instead.
-func (*Status_Request) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use ProcessingFailureClear.ProtoReflect.Descriptor instead.
+func (*ProcessingFailureClear) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{26}
 }
 
-type Status_Response struct {
+type Me struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Uptime         int32  `protobuf:"varint,1,opt,name=uptime,proto3" json:"uptime,omitempty"`
-	Hostname       string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	EverythingIsOk bool   `protobuf:"varint,3,opt,name=everything_is_ok,json=everythingIsOk,proto3" json:"everything_is_ok,omitempty"`
 }
 
-func (x *Status_Response) Reset() {
-	*x = Status_Response{}
+func (x *Me) Reset() {
+	*x = Me{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[14]
+		mi := &file_sgtm_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Status_Response) String() string {
+func (x *Me) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Status_Response) ProtoMessage() {}
+func (*Me) ProtoMessage() {}
 
-func (x *Status_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[14]
+func (x *Me) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1611,60 +1656,64 @@ func (x *Status_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Status_Response.ProtoReflect.Descriptor instead.
-func (*Status_Response) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{1, 1}
-}
-
-func (x *Status_Response) GetUptime() int32 {
-	if x != nil {
-		return x.Uptime
-	}
-	return 0
-}
-
-func (x *Status_Response) GetHostname() string {
-	if x != nil {
-		return x.Hostname
-	}
-	return ""
-}
-
-func (x *Status_Response) GetEverythingIsOk() bool {
-	if x != nil {
-		return x.EverythingIsOk
-	}
-	return false
+// Deprecated: Use Me.ProtoReflect.Descriptor instead.
+func (*Me) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{27}
 }
 
-type Register_Request struct {
+type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email     string `protobuf:"bytes,10,opt,name=email,proto3" json:"email,omitempty"`
-	Slug      string `protobuf:"bytes,11,opt,name=slug,proto3" json:"slug,omitempty"`
-	Firstname string `protobuf:"bytes,12,opt,name=firstname,proto3" json:"firstname,omitempty"`
-	Lastname  string `protobuf:"bytes,13,opt,name=lastname,proto3" json:"lastname,omitempty"`
+	ID                    int64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	CreatedAt             int64           `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"autocreatetime:nano"`
+	UpdatedAt             int64           `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"autoupdatetime:nano"`
+	DeletedAt             int64           `protobuf:"varint,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Email                 string          `protobuf:"bytes,10,opt,name=email,proto3" json:"email,omitempty" gorm:"size:255;not null;index:,unique"`
+	Slug                  string          `protobuf:"bytes,11,opt,name=slug,proto3" json:"slug,omitempty" gorm:"size:32;not null;default:''"`
+	Firstname             string          `protobuf:"bytes,12,opt,name=firstname,proto3" json:"firstname,omitempty" gorm:"size:255;not null;default:''"`
+	Lastname              string          `protobuf:"bytes,13,opt,name=lastname,proto3" json:"lastname,omitempty" gorm:"size:255;not null;default:''"`
+	Locale                string          `protobuf:"bytes,14,opt,name=locale,proto3" json:"locale,omitempty" gorm:"size:32;not null;default:''"`
+	Avatar                string          `protobuf:"bytes,15,opt,name=avatar,proto3" json:"avatar,omitempty" gorm:"size:255;not null;default:''"`
+	DiscordID             string          `protobuf:"bytes,16,opt,name=discord_id,json=discordId,proto3" json:"discord_id,omitempty" gorm:"size:255;not null;default:''"`
+	DiscordUsername       string          `protobuf:"bytes,17,opt,name=discord_username,json=discordUsername,proto3" json:"discord_username,omitempty" gorm:"size:255;not null;default:''"`
+	Headline              string          `protobuf:"bytes,18,opt,name=headline,proto3" json:"headline,omitempty"`
+	Bio                   string          `protobuf:"bytes,19,opt,name=bio,proto3" json:"bio,omitempty"`
+	Inspirations          string          `protobuf:"bytes,20,opt,name=inspirations,proto3" json:"inspirations,omitempty"`
+	Gears                 string          `protobuf:"bytes,21,opt,name=gears,proto3" json:"gears,omitempty"`
+	Genres                string          `protobuf:"bytes,22,opt,name=genres,proto3" json:"genres,omitempty"`
+	Location              string          `protobuf:"bytes,23,opt,name=location,proto3" json:"location,omitempty"`
+	TwitterUsername       string          `protobuf:"bytes,24,opt,name=twitter_username,json=twitterUsername,proto3" json:"twitter_username,omitempty"`
+	Homepage              string          `protobuf:"bytes,25,opt,name=homepage,proto3" json:"homepage,omitempty"`
+	OtherLinks            string          `protobuf:"bytes,26,opt,name=other_links,json=otherLinks,proto3" json:"other_links,omitempty"`
+	Goals                 string          `protobuf:"bytes,27,opt,name=goals,proto3" json:"goals,omitempty"`
+	SoundcloudUsername    string          `protobuf:"bytes,28,opt,name=soundcloud_username,json=soundcloudUsername,proto3" json:"soundcloud_username,omitempty"`
+	Role                  string          `protobuf:"bytes,29,opt,name=role,proto3" json:"role,omitempty"`
+	ProcessingVersion     int64           `protobuf:"varint,30,opt,name=processing_version,json=processingVersion,proto3" json:"processing_version,omitempty"`
+	ProcessingError       string          `protobuf:"bytes,31,opt,name=processing_error,json=processingError,proto3" json:"processing_error,omitempty"`
+	RecentPosts           []*Post         `protobuf:"bytes,50,rep,name=recent_posts,json=recentPosts,proto3" json:"recent_posts,omitempty" gorm:"foreignkey:AuthorID;PRELOAD:false"`
+	RelationshipsAsSource []*Relationship `protobuf:"bytes,51,rep,name=relationships_as_source,json=relationshipsAsSource,proto3" json:"relationships_as_source,omitempty" gorm:"foreignKey:SourceUserID"`
+	RelationshipsAsTarget []*Relationship `protobuf:"bytes,52,rep,name=relationships_as_target,json=relationshipsAsTarget,proto3" json:"relationships_as_target,omitempty" gorm:"foreignKey:TargetUserID"`
 }
 
-func (x *Register_Request) Reset() {
-	*x = Register_Request{}
+func (x *User) Reset() {
+	*x = User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[15]
+		mi := &file_sgtm_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Register_Request) String() string {
+func (x *User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Register_Request) ProtoMessage() {}
+func (*User) ProtoMessage() {}
 
-func (x *Register_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[15]
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1675,234 +1724,312 @@ func (x *Register_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Register_Request.ProtoReflect.Descriptor instead.
-func (*Register_Request) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *Register_Request) GetEmail() string {
+func (x *User) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *User) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *User) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *User) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *User) GetEmail() string {
 	if x != nil {
 		return x.Email
 	}
 	return ""
 }
 
-func (x *Register_Request) GetSlug() string {
+func (x *User) GetSlug() string {
 	if x != nil {
 		return x.Slug
 	}
 	return ""
 }
 
-func (x *Register_Request) GetFirstname() string {
+func (x *User) GetFirstname() string {
 	if x != nil {
 		return x.Firstname
 	}
 	return ""
 }
 
-func (x *Register_Request) GetLastname() string {
+func (x *User) GetLastname() string {
 	if x != nil {
 		return x.Lastname
 	}
 	return ""
 }
 
-type Register_Response struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+func (x *User) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
 }
 
-func (x *Register_Response) Reset() {
-	*x = Register_Response{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *User) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
 	}
+	return ""
 }
 
-func (x *Register_Response) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *User) GetDiscordID() string {
+	if x != nil {
+		return x.DiscordID
+	}
+	return ""
 }
 
-func (*Register_Response) ProtoMessage() {}
-
-func (x *Register_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *User) GetDiscordUsername() string {
+	if x != nil {
+		return x.DiscordUsername
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Register_Response.ProtoReflect.Descriptor instead.
-func (*Register_Response) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{2, 1}
+func (x *User) GetHeadline() string {
+	if x != nil {
+		return x.Headline
+	}
+	return ""
 }
 
-func (x *Register_Response) GetUser() *User {
+func (x *User) GetBio() string {
 	if x != nil {
-		return x.User
+		return x.Bio
 	}
-	return nil
+	return ""
 }
 
-type UserList_Request struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *User) GetInspirations() string {
+	if x != nil {
+		return x.Inspirations
+	}
+	return ""
 }
 
-func (x *UserList_Request) Reset() {
-	*x = UserList_Request{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *User) GetGears() string {
+	if x != nil {
+		return x.Gears
 	}
+	return ""
 }
 
-func (x *UserList_Request) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *User) GetGenres() string {
+	if x != nil {
+		return x.Genres
+	}
+	return ""
 }
 
-func (*UserList_Request) ProtoMessage() {}
-
-func (x *UserList_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *User) GetLocation() string {
+	if x != nil {
+		return x.Location
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UserList_Request.ProtoReflect.Descriptor instead.
-func (*UserList_Request) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{3, 0}
+func (x *User) GetTwitterUsername() string {
+	if x != nil {
+		return x.TwitterUsername
+	}
+	return ""
 }
 
-type UserList_Response struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+func (x *User) GetHomepage() string {
+	if x != nil {
+		return x.Homepage
+	}
+	return ""
 }
 
-func (x *UserList_Response) Reset() {
-	*x = UserList_Response{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *User) GetOtherLinks() string {
+	if x != nil {
+		return x.OtherLinks
 	}
+	return ""
 }
 
-func (x *UserList_Response) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UserList_Response) ProtoMessage() {}
-
-func (x *UserList_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *User) GetGoals() string {
+	if x != nil {
+		return x.Goals
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UserList_Response.ProtoReflect.Descriptor instead.
-func (*UserList_Response) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{3, 1}
+func (x *User) GetSoundcloudUsername() string {
+	if x != nil {
+		return x.SoundcloudUsername
+	}
+	return ""
 }
 
-func (x *UserList_Response) GetUsers() []*User {
+func (x *User) GetRole() string {
 	if x != nil {
-		return x.Users
+		return x.Role
 	}
-	return nil
+	return ""
 }
 
-type PostList_Request struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *User) GetProcessingVersion() int64 {
+	if x != nil {
+		return x.ProcessingVersion
+	}
+	return 0
 }
 
-func (x *PostList_Request) Reset() {
-	*x = PostList_Request{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *User) GetProcessingError() string {
+	if x != nil {
+		return x.ProcessingError
 	}
+	return ""
 }
 
-func (x *PostList_Request) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *User) GetRecentPosts() []*Post {
+	if x != nil {
+		return x.RecentPosts
+	}
+	return nil
 }
 
-func (*PostList_Request) ProtoMessage() {}
-
-func (x *PostList_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *User) GetRelationshipsAsSource() []*Relationship {
+	if x != nil {
+		return x.RelationshipsAsSource
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use PostList_Request.ProtoReflect.Descriptor instead.
-func (*PostList_Request) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{4, 0}
+func (x *User) GetRelationshipsAsTarget() []*Relationship {
+	if x != nil {
+		return x.RelationshipsAsTarget
+	}
+	return nil
 }
 
-type PostList_Response struct {
+type Post struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	ID                int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	CreatedAt         int64      `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"autocreatetime:nano"`
+	UpdatedAt         int64      `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"autoupdatetime:nano"`
+	DeletedAt         int64      `protobuf:"varint,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Author            *User      `protobuf:"bytes,10,opt,name=author,proto3" json:"author,omitempty"`
+	AuthorID          int64      `protobuf:"varint,11,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Title             string     `protobuf:"bytes,12,opt,name=title,proto3" json:"title,omitempty"`
+	Slug              string     `protobuf:"bytes,13,opt,name=slug,proto3" json:"slug,omitempty"`
+	Kind              Post_Kind  `protobuf:"varint,14,opt,name=kind,proto3,enum=sgtm.Post_Kind" json:"kind,omitempty"`
+	Visibility        Visibility `protobuf:"varint,15,opt,name=visibility,proto3,enum=sgtm.Visibility" json:"visibility,omitempty"`
+	URL               string     `protobuf:"bytes,16,opt,name=url,proto3" json:"url,omitempty"`
+	Provider          Provider   `protobuf:"varint,17,opt,name=provider,proto3,enum=sgtm.Provider" json:"provider,omitempty"`
+	Body              string     `protobuf:"bytes,18,opt,name=body,proto3" json:"body,omitempty"`
+	SortDate          int64      `protobuf:"varint,19,opt,name=sort_date,json=sortDate,proto3" json:"sort_date,omitempty"`
+	ProcessingVersion int64      `protobuf:"varint,20,opt,name=processing_version,json=processingVersion,proto3" json:"processing_version,omitempty"`
+	ProcessingError   string     `protobuf:"bytes,21,opt,name=processing_error,json=processingError,proto3" json:"processing_error,omitempty"`
+	EditedFields      string     `protobuf:"bytes,22,opt,name=edited_fields,json=editedFields,proto3" json:"edited_fields,omitempty"`    // comma separated list of the fields edited by the author
+	ThreadPostID      int64      `protobuf:"varint,30,opt,name=thread_post_id,json=threadPostId,proto3" json:"thread_post_id,omitempty"` // top-level comment of the thread, empty for top-level comments
+	ThreadPost        *Post      `protobuf:"bytes,31,opt,name=thread_post,json=threadPost,proto3" json:"thread_post,omitempty"`
+	ReplyToID         int64      `protobuf:"varint,32,opt,name=reply_to_id,json=replyToId,proto3" json:"reply_to_id,omitempty"`
+	ReplyTo           *Post      `protobuf:"bytes,33,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	EditedAt          int64      `protobuf:"varint,34,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	Replies           []*Post    `protobuf:"bytes,35,rep,name=replies,proto3" json:"replies,omitempty" gorm:"-"` // only filled when loading a comment tree
+	// Deprecated: Do not use.
+	Genre                 string                    `protobuf:"bytes,40,opt,name=genre,proto3" json:"genre,omitempty"` // replaced by 'tags'
+	Duration              uint64                    `protobuf:"varint,41,opt,name=duration,proto3" json:"duration,omitempty"`
+	ArtworkURL            string                    `protobuf:"bytes,42,opt,name=artwork_url,json=artworkUrl,proto3" json:"artwork_url,omitempty"`
+	BPM                   float64                   `protobuf:"fixed64,43,opt,name=bpm,proto3" json:"bpm,omitempty"`
+	KeySignature          string                    `protobuf:"bytes,44,opt,name=key_signature,json=keySignature,proto3" json:"key_signature,omitempty"`
+	ISRC                  string                    `protobuf:"bytes,45,opt,name=isrc,proto3" json:"isrc,omitempty"`
+	ProviderTitle         string                    `protobuf:"bytes,53,opt,name=provider_title,json=providerTitle,proto3" json:"provider_title,omitempty"`
+	ProviderDescription   string                    `protobuf:"bytes,46,opt,name=provider_description,json=providerDescription,proto3" json:"provider_description,omitempty"`
+	DownloadURL           string                    `protobuf:"bytes,47,opt,name=provider_download_url,json=providerDownloadUrl,proto3" json:"provider_download_url,omitempty"`
+	ProviderCreatedAt     int64                     `protobuf:"varint,48,opt,name=provider_created_at,json=providerCreatedAt,proto3" json:"provider_created_at,omitempty"`
+	ProviderUpdatedAt     int64                     `protobuf:"varint,49,opt,name=provider_updated_at,json=providerUpdatedAt,proto3" json:"provider_updated_at,omitempty"`
+	ProviderMetadata      string                    `protobuf:"bytes,50,opt,name=provider_metadata,json=providerMetadata,proto3" json:"provider_metadata,omitempty"`
+	Tags                  string                    `protobuf:"bytes,51,opt,name=tags,proto3" json:"tags,omitempty"` // comma separated list of tags
+	Lyrics                string                    `protobuf:"bytes,52,opt,name=lyrics,proto3" json:"lyrics,omitempty"`
+	WaveformVersion       int64                     `protobuf:"varint,54,opt,name=waveform_version,json=waveformVersion,proto3" json:"waveform_version,omitempty"` // version of the waveform algorithm, 0 if not computed
+	Artist                string                    `protobuf:"bytes,55,opt,name=artist,proto3" json:"artist,omitempty"`
+	ProviderAvailability  Post_ProviderAvailability `protobuf:"varint,56,opt,name=provider_availability,json=providerAvailability,proto3,enum=sgtm.Post_ProviderAvailability" json:"provider_availability,omitempty" gorm:"not null;default:0"`
+	ProviderCheckedAt     int64                     `protobuf:"varint,57,opt,name=provider_checked_at,json=providerCheckedAt,proto3" json:"provider_checked_at,omitempty" gorm:"not null;default:0"` // last availability check, unix nano
+	AnalysisVersion       int64                     `protobuf:"varint,58,opt,name=analysis_version,json=analysisVersion,proto3" json:"analysis_version,omitempty"`                                   // version of the audio analysis, 0 if not computed
+	Loudness              float64                   `protobuf:"fixed64,59,opt,name=loudness,proto3" json:"loudness,omitempty"`                                                                       // integrated loudness (EBU R128), in LUFS
+	TruePeak              float64                   `protobuf:"fixed64,60,opt,name=true_peak,json=truePeak,proto3" json:"true_peak,omitempty"`                                                       // in dBTP
+	LoudnessRange         float64                   `protobuf:"fixed64,61,opt,name=loudness_range,json=loudnessRange,proto3" json:"loudness_range,omitempty"`                                        // dynamic range (EBU Tech 3342), in LU
+	ReplayGain            float64                   `protobuf:"fixed64,62,opt,name=replay_gain,json=replayGain,proto3" json:"replay_gain,omitempty"`                                                 // ReplayGain 2.0 track gain, in dB
+	DetectedKey           string                    `protobuf:"bytes,63,opt,name=detected_key,json=detectedKey,proto3" json:"detected_key,omitempty"`                                                // i.e., "A minor"
+	FingerprintVersion    int64                     `protobuf:"varint,64,opt,name=fingerprint_version,json=fingerprintVersion,proto3" json:"fingerprint_version,omitempty"`                          // version of the acoustic fingerprint algorithm, 0 if not computed
+	PossibleDuplicates    string                    `protobuf:"bytes,65,opt,name=possible_duplicates,json=possibleDuplicates,proto3" json:"possible_duplicates,omitempty"`                           // comma separated list of the IDs of the tracks with a similar fingerprint
+	AlbumID               int64                     `protobuf:"varint,66,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`                                                           // album of the track, if any
+	Album                 *Post                     `protobuf:"bytes,67,opt,name=album,proto3" json:"album,omitempty"`
+	AlbumPosition         int64                     `protobuf:"varint,68,opt,name=album_position,json=albumPosition,proto3" json:"album_position,omitempty"`   // position of the track in its album, from 1
+	AlbumTracks           []*Post                   `protobuf:"bytes,69,rep,name=album_tracks,json=albumTracks,proto3" json:"album_tracks,omitempty" gorm:"-"` // only filled when loading or importing an album
+	SoundCloudSecretToken string                    `protobuf:"bytes,80,opt,name=soundcloud_secret_token,json=soundcloudSecretToken,proto3" json:"soundcloud_secret_token,omitempty"`
+	SoundCloudID          uint64                    `protobuf:"varint,81,opt,name=soundcloud_id,json=soundcloudId,proto3" json:"soundcloud_id,omitempty"`
+	SoundCloudKind        Post_SoundCloudKind       `protobuf:"varint,83,opt,name=soundcloud_kind,json=soundcloudKind,proto3,enum=sgtm.Post_SoundCloudKind" json:"soundcloud_kind,omitempty"`
+	IPFSCID               string                    `protobuf:"bytes,90,opt,name=ipfs_cid,json=ipfsCid,proto3" json:"ipfs_cid,omitempty"`
+	MIMEType              string                    `protobuf:"bytes,91,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	SizeBytes             int64                     `protobuf:"varint,92,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	FileExtension         string                    `protobuf:"bytes,93,opt,name=file_extension,json=fileExtension,proto3" json:"file_extension,omitempty"`
+	AttachmentFilename    string                    `protobuf:"bytes,94,opt,name=attachment_filename,json=attachmentFilename,proto3" json:"attachment_filename,omitempty"`
+	Renditions            []*Rendition              `protobuf:"bytes,95,rep,name=renditions,proto3" json:"renditions,omitempty"`                // compressed versions of the lossless uploads
+	SourceURL             string                    `protobuf:"bytes,96,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"` // original link of the files imported from a URL, for attribution
+	BandcampID            uint64                    `protobuf:"varint,120,opt,name=bandcamp_id,json=bandcampId,proto3" json:"bandcamp_id,omitempty"`
+	YoutubeDLExtractor    string                    `protobuf:"bytes,130,opt,name=youtubedl_extractor,json=youtubedlExtractor,proto3" json:"youtubedl_extractor,omitempty"` // i.e., "youtube", "vimeo"
+	YoutubeDLID           string                    `protobuf:"bytes,131,opt,name=youtubedl_id,json=youtubedlId,proto3" json:"youtubedl_id,omitempty"`                      // ID of the media on its site
+	TargetUserID          int64                     `protobuf:"varint,101,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	TargetUser            *User                     `protobuf:"bytes,102,opt,name=target_user,json=targetUser,proto3" json:"target_user,omitempty"`
+	TargetPostID          int64                     `protobuf:"varint,103,opt,name=target_post_id,json=targetPostId,proto3" json:"target_post_id,omitempty"`
+	TargetPost            *Post                     `protobuf:"bytes,104,opt,name=target_post,json=targetPost,proto3" json:"target_post,omitempty"`
+	TargetMetadata        string                    `protobuf:"bytes,105,opt,name=target_metadata,json=targetMetadata,proto3" json:"target_metadata,omitempty"`
+	RelationshipsAsSource []*Relationship           `protobuf:"bytes,110,rep,name=relationships_as_source,json=relationshipsAsSource,proto3" json:"relationships_as_source,omitempty" gorm:"foreignKey:SourcePostID"`
+	RelationshipsAsTarget []*Relationship           `protobuf:"bytes,111,rep,name=relationships_as_target,json=relationshipsAsTarget,proto3" json:"relationships_as_target,omitempty" gorm:"foreignKey:TargetPostID"`
 }
 
-func (x *PostList_Response) Reset() {
-	*x = PostList_Response{}
+func (x *Post) Reset() {
+	*x = Post{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[20]
+		mi := &file_sgtm_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PostList_Response) String() string {
+func (x *Post) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostList_Response) ProtoMessage() {}
+func (*Post) ProtoMessage() {}
 
-func (x *PostList_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[20]
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1913,671 +2040,7163 @@ func (x *PostList_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostList_Response.ProtoReflect.Descriptor instead.
-func (*PostList_Response) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{4, 1}
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *PostList_Response) GetPosts() []*Post {
+func (x *Post) GetID() int64 {
 	if x != nil {
-		return x.Posts
+		return x.ID
 	}
-	return nil
-}
-
-type PostSync_Request struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ID int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	return 0
 }
 
-func (x *PostSync_Request) Reset() {
-	*x = PostSync_Request{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Post) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
 	}
+	return 0
 }
 
-func (x *PostSync_Request) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Post) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
 }
 
-func (*PostSync_Request) ProtoMessage() {}
-
-func (x *PostSync_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Post) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use PostSync_Request.ProtoReflect.Descriptor instead.
-func (*PostSync_Request) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{5, 0}
+func (x *Post) GetAuthor() *User {
+	if x != nil {
+		return x.Author
+	}
+	return nil
 }
 
-func (x *PostSync_Request) GetID() int64 {
+func (x *Post) GetAuthorID() int64 {
 	if x != nil {
-		return x.ID
+		return x.AuthorID
 	}
 	return 0
 }
 
-type PostSync_Response struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Post) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (x *PostSync_Response) Reset() {
-	*x = PostSync_Response{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Post) GetSlug() string {
+	if x != nil {
+		return x.Slug
 	}
+	return ""
 }
 
-func (x *PostSync_Response) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Post) GetKind() Post_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Post_UnknownKind
 }
 
-func (*PostSync_Response) ProtoMessage() {}
+func (x *Post) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_UnknownVisibility
+}
 
-func (x *PostSync_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Post) GetURL() string {
+	if x != nil {
+		return x.URL
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use PostSync_Response.ProtoReflect.Descriptor instead.
-func (*PostSync_Response) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{5, 1}
+func (x *Post) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_UnknownProvider
 }
 
-type Me_Request struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Post) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
 }
 
-func (x *Me_Request) Reset() {
-	*x = Me_Request{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Post) GetSortDate() int64 {
+	if x != nil {
+		return x.SortDate
 	}
+	return 0
 }
 
-func (x *Me_Request) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Post) GetProcessingVersion() int64 {
+	if x != nil {
+		return x.ProcessingVersion
+	}
+	return 0
 }
 
-func (*Me_Request) ProtoMessage() {}
+func (x *Post) GetProcessingError() string {
+	if x != nil {
+		return x.ProcessingError
+	}
+	return ""
+}
 
-func (x *Me_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+func (x *Post) GetEditedFields() string {
+	if x != nil {
+		return x.EditedFields
+	}
+	return ""
+}
+
+func (x *Post) GetThreadPostID() int64 {
+	if x != nil {
+		return x.ThreadPostID
+	}
+	return 0
+}
+
+func (x *Post) GetThreadPost() *Post {
+	if x != nil {
+		return x.ThreadPost
+	}
+	return nil
+}
+
+func (x *Post) GetReplyToID() int64 {
+	if x != nil {
+		return x.ReplyToID
+	}
+	return 0
+}
+
+func (x *Post) GetReplyTo() *Post {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return nil
+}
+
+func (x *Post) GetEditedAt() int64 {
+	if x != nil {
+		return x.EditedAt
+	}
+	return 0
+}
+
+func (x *Post) GetReplies() []*Post {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *Post) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *Post) GetDuration() uint64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Post) GetArtworkURL() string {
+	if x != nil {
+		return x.ArtworkURL
+	}
+	return ""
+}
+
+func (x *Post) GetBPM() float64 {
+	if x != nil {
+		return x.BPM
+	}
+	return 0
+}
+
+func (x *Post) GetKeySignature() string {
+	if x != nil {
+		return x.KeySignature
+	}
+	return ""
+}
+
+func (x *Post) GetISRC() string {
+	if x != nil {
+		return x.ISRC
+	}
+	return ""
+}
+
+func (x *Post) GetProviderTitle() string {
+	if x != nil {
+		return x.ProviderTitle
+	}
+	return ""
+}
+
+func (x *Post) GetProviderDescription() string {
+	if x != nil {
+		return x.ProviderDescription
+	}
+	return ""
+}
+
+func (x *Post) GetDownloadURL() string {
+	if x != nil {
+		return x.DownloadURL
+	}
+	return ""
+}
+
+func (x *Post) GetProviderCreatedAt() int64 {
+	if x != nil {
+		return x.ProviderCreatedAt
+	}
+	return 0
+}
+
+func (x *Post) GetProviderUpdatedAt() int64 {
+	if x != nil {
+		return x.ProviderUpdatedAt
+	}
+	return 0
+}
+
+func (x *Post) GetProviderMetadata() string {
+	if x != nil {
+		return x.ProviderMetadata
+	}
+	return ""
+}
+
+func (x *Post) GetTags() string {
+	if x != nil {
+		return x.Tags
+	}
+	return ""
+}
+
+func (x *Post) GetLyrics() string {
+	if x != nil {
+		return x.Lyrics
+	}
+	return ""
+}
+
+func (x *Post) GetWaveformVersion() int64 {
+	if x != nil {
+		return x.WaveformVersion
+	}
+	return 0
+}
+
+func (x *Post) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *Post) GetProviderAvailability() Post_ProviderAvailability {
+	if x != nil {
+		return x.ProviderAvailability
+	}
+	return Post_UnknownProviderAvailability
+}
+
+func (x *Post) GetProviderCheckedAt() int64 {
+	if x != nil {
+		return x.ProviderCheckedAt
+	}
+	return 0
+}
+
+func (x *Post) GetAnalysisVersion() int64 {
+	if x != nil {
+		return x.AnalysisVersion
+	}
+	return 0
+}
+
+func (x *Post) GetLoudness() float64 {
+	if x != nil {
+		return x.Loudness
+	}
+	return 0
+}
+
+func (x *Post) GetTruePeak() float64 {
+	if x != nil {
+		return x.TruePeak
+	}
+	return 0
+}
+
+func (x *Post) GetLoudnessRange() float64 {
+	if x != nil {
+		return x.LoudnessRange
+	}
+	return 0
+}
+
+func (x *Post) GetReplayGain() float64 {
+	if x != nil {
+		return x.ReplayGain
+	}
+	return 0
+}
+
+func (x *Post) GetDetectedKey() string {
+	if x != nil {
+		return x.DetectedKey
+	}
+	return ""
+}
+
+func (x *Post) GetFingerprintVersion() int64 {
+	if x != nil {
+		return x.FingerprintVersion
+	}
+	return 0
+}
+
+func (x *Post) GetPossibleDuplicates() string {
+	if x != nil {
+		return x.PossibleDuplicates
+	}
+	return ""
+}
+
+func (x *Post) GetAlbumID() int64 {
+	if x != nil {
+		return x.AlbumID
+	}
+	return 0
+}
+
+func (x *Post) GetAlbum() *Post {
+	if x != nil {
+		return x.Album
+	}
+	return nil
+}
+
+func (x *Post) GetAlbumPosition() int64 {
+	if x != nil {
+		return x.AlbumPosition
+	}
+	return 0
+}
+
+func (x *Post) GetAlbumTracks() []*Post {
+	if x != nil {
+		return x.AlbumTracks
+	}
+	return nil
+}
+
+func (x *Post) GetSoundCloudSecretToken() string {
+	if x != nil {
+		return x.SoundCloudSecretToken
+	}
+	return ""
+}
+
+func (x *Post) GetSoundCloudID() uint64 {
+	if x != nil {
+		return x.SoundCloudID
+	}
+	return 0
+}
+
+func (x *Post) GetSoundCloudKind() Post_SoundCloudKind {
+	if x != nil {
+		return x.SoundCloudKind
+	}
+	return Post_UnknownSoundCloudKind
+}
+
+func (x *Post) GetIPFSCID() string {
+	if x != nil {
+		return x.IPFSCID
+	}
+	return ""
+}
+
+func (x *Post) GetMIMEType() string {
+	if x != nil {
+		return x.MIMEType
+	}
+	return ""
+}
+
+func (x *Post) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Post) GetFileExtension() string {
+	if x != nil {
+		return x.FileExtension
+	}
+	return ""
+}
+
+func (x *Post) GetAttachmentFilename() string {
+	if x != nil {
+		return x.AttachmentFilename
+	}
+	return ""
+}
+
+func (x *Post) GetRenditions() []*Rendition {
+	if x != nil {
+		return x.Renditions
+	}
+	return nil
+}
+
+func (x *Post) GetSourceURL() string {
+	if x != nil {
+		return x.SourceURL
+	}
+	return ""
+}
+
+func (x *Post) GetBandcampID() uint64 {
+	if x != nil {
+		return x.BandcampID
+	}
+	return 0
+}
+
+func (x *Post) GetYoutubeDLExtractor() string {
+	if x != nil {
+		return x.YoutubeDLExtractor
+	}
+	return ""
+}
+
+func (x *Post) GetYoutubeDLID() string {
+	if x != nil {
+		return x.YoutubeDLID
+	}
+	return ""
+}
+
+func (x *Post) GetTargetUserID() int64 {
+	if x != nil {
+		return x.TargetUserID
+	}
+	return 0
+}
+
+func (x *Post) GetTargetUser() *User {
+	if x != nil {
+		return x.TargetUser
+	}
+	return nil
+}
+
+func (x *Post) GetTargetPostID() int64 {
+	if x != nil {
+		return x.TargetPostID
+	}
+	return 0
+}
+
+func (x *Post) GetTargetPost() *Post {
+	if x != nil {
+		return x.TargetPost
+	}
+	return nil
+}
+
+func (x *Post) GetTargetMetadata() string {
+	if x != nil {
+		return x.TargetMetadata
+	}
+	return ""
+}
+
+func (x *Post) GetRelationshipsAsSource() []*Relationship {
+	if x != nil {
+		return x.RelationshipsAsSource
+	}
+	return nil
+}
+
+func (x *Post) GetRelationshipsAsTarget() []*Relationship {
+	if x != nil {
+		return x.RelationshipsAsTarget
+	}
+	return nil
+}
+
+type Relationship struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           int64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	CreatedAt    int64             `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"autocreatetime:nano"`
+	UpdatedAt    int64             `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"autoupdatetime:nano"`
+	DeletedAt    int64             `protobuf:"varint,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Kind         Relationship_Kind `protobuf:"varint,10,opt,name=kind,proto3,enum=sgtm.Relationship_Kind" json:"kind,omitempty"`
+	SourcePostID int64             `protobuf:"varint,11,opt,name=source_post_id,json=sourcePostId,proto3" json:"source_post_id,omitempty"`
+	SourcePost   *Post             `protobuf:"bytes,12,opt,name=source_post,json=sourcePost,proto3" json:"source_post,omitempty"`
+	TargetPostID int64             `protobuf:"varint,13,opt,name=target_post_id,json=targetPostId,proto3" json:"target_post_id,omitempty"`
+	TargetPost   *Post             `protobuf:"bytes,14,opt,name=target_post,json=targetPost,proto3" json:"target_post,omitempty"`
+	SourceUserID int64             `protobuf:"varint,15,opt,name=source_user_id,json=sourceUserId,proto3" json:"source_user_id,omitempty"`
+	SourceUser   *User             `protobuf:"bytes,16,opt,name=source_user,json=sourceUser,proto3" json:"source_user,omitempty"`
+	TargetUserID int64             `protobuf:"varint,17,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	TargetUser   *User             `protobuf:"bytes,18,opt,name=target_user,json=targetUser,proto3" json:"target_user,omitempty"`
+	SourceRaw    string            `protobuf:"bytes,19,opt,name=source_raw,json=sourceRaw,proto3" json:"source_raw,omitempty"`
+	TargetRaw    string            `protobuf:"bytes,20,opt,name=target_raw,json=targetRaw,proto3" json:"target_raw,omitempty"`
+	Metadata     string            `protobuf:"bytes,21,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Suggested    bool              `protobuf:"varint,22,opt,name=suggested,proto3" json:"suggested,omitempty" gorm:"not null;default:false"` // detected automatically, until confirmed by the author
+}
+
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Relationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relationship) ProtoMessage() {}
+
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Relationship) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Relationship) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Relationship) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Relationship) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *Relationship) GetKind() Relationship_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Relationship_UnknownKind
+}
+
+func (x *Relationship) GetSourcePostID() int64 {
+	if x != nil {
+		return x.SourcePostID
+	}
+	return 0
+}
+
+func (x *Relationship) GetSourcePost() *Post {
+	if x != nil {
+		return x.SourcePost
+	}
+	return nil
+}
+
+func (x *Relationship) GetTargetPostID() int64 {
+	if x != nil {
+		return x.TargetPostID
+	}
+	return 0
+}
+
+func (x *Relationship) GetTargetPost() *Post {
+	if x != nil {
+		return x.TargetPost
+	}
+	return nil
+}
+
+func (x *Relationship) GetSourceUserID() int64 {
+	if x != nil {
+		return x.SourceUserID
+	}
+	return 0
+}
+
+func (x *Relationship) GetSourceUser() *User {
+	if x != nil {
+		return x.SourceUser
+	}
+	return nil
+}
+
+func (x *Relationship) GetTargetUserID() int64 {
+	if x != nil {
+		return x.TargetUserID
+	}
+	return 0
+}
+
+func (x *Relationship) GetTargetUser() *User {
+	if x != nil {
+		return x.TargetUser
+	}
+	return nil
+}
+
+func (x *Relationship) GetSourceRaw() string {
+	if x != nil {
+		return x.SourceRaw
+	}
+	return ""
+}
+
+func (x *Relationship) GetTargetRaw() string {
+	if x != nil {
+		return x.TargetRaw
+	}
+	return ""
+}
+
+func (x *Relationship) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *Relationship) GetSuggested() bool {
+	if x != nil {
+		return x.Suggested
+	}
+	return false
+}
+
+type APIToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	CreatedAt   int64  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"autocreatetime:nano"`
+	UpdatedAt   int64  `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"autoupdatetime:nano"`
+	DeletedAt   int64  `protobuf:"varint,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	UserID      int64  `protobuf:"varint,10,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" gorm:"index"`
+	User        *User  `protobuf:"bytes,11,opt,name=user,proto3" json:"user,omitempty"`
+	Name        string `protobuf:"bytes,12,opt,name=name,proto3" json:"name,omitempty" gorm:"size:255;not null;default:''"`
+	TokenHash   string `protobuf:"bytes,13,opt,name=token_hash,json=tokenHash,proto3" json:"token_hash,omitempty" gorm:"size:64;not null;index:,unique"`    // sha256 of the secret
+	TokenPrefix string `protobuf:"bytes,14,opt,name=token_prefix,json=tokenPrefix,proto3" json:"token_prefix,omitempty" gorm:"size:16;not null;default:''"` // first characters of the secret, to recognize it
+	Scopes      string `protobuf:"bytes,15,opt,name=scopes,proto3" json:"scopes,omitempty"`                                                                 // comma separated list of scopes
+	LastUsedAt  int64  `protobuf:"varint,16,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+}
+
+func (x *APIToken) Reset() {
+	*x = APIToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIToken) ProtoMessage() {}
+
+func (x *APIToken) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIToken.ProtoReflect.Descriptor instead.
+func (*APIToken) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *APIToken) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *APIToken) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *APIToken) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *APIToken) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *APIToken) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *APIToken) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *APIToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIToken) GetTokenHash() string {
+	if x != nil {
+		return x.TokenHash
+	}
+	return ""
+}
+
+func (x *APIToken) GetTokenPrefix() string {
+	if x != nil {
+		return x.TokenPrefix
+	}
+	return ""
+}
+
+func (x *APIToken) GetScopes() string {
+	if x != nil {
+		return x.Scopes
+	}
+	return ""
+}
+
+func (x *APIToken) GetLastUsedAt() int64 {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return 0
+}
+
+type Job struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          int64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	CreatedAt   int64     `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"autocreatetime:nano"`
+	UpdatedAt   int64     `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"autoupdatetime:nano"`
+	DeletedAt   int64     `protobuf:"varint,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Kind        Job_Kind  `protobuf:"varint,10,opt,name=kind,proto3,enum=sgtm.Job_Kind" json:"kind,omitempty"`
+	State       Job_State `protobuf:"varint,11,opt,name=state,proto3,enum=sgtm.Job_State" json:"state,omitempty" gorm:"index:idx_job_state_next_run_at"`
+	PostID      int64     `protobuf:"varint,12,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty" gorm:"index"`
+	Post        *Post     `protobuf:"bytes,13,opt,name=post,proto3" json:"post,omitempty"`
+	Payload     string    `protobuf:"bytes,14,opt,name=payload,proto3" json:"payload,omitempty"` // JSON, depends on the kind
+	Attempts    int64     `protobuf:"varint,15,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	MaxAttempts int64     `protobuf:"varint,16,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	NextRunAt   int64     `protobuf:"varint,17,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty" gorm:"index:idx_job_state_next_run_at"` // unix nano
+	LastError   string    `protobuf:"bytes,18,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	FinishedAt  int64     `protobuf:"varint,19,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	UserID      int64     `protobuf:"varint,20,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" gorm:"index"` // user targeted by the jobs that are not about a post
+	User        *User     `protobuf:"bytes,21,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Job) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Job) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Job) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Job) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *Job) GetKind() Job_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Job_UnknownKind
+}
+
+func (x *Job) GetState() Job_State {
+	if x != nil {
+		return x.State
+	}
+	return Job_UnknownState
+}
+
+func (x *Job) GetPostID() int64 {
+	if x != nil {
+		return x.PostID
+	}
+	return 0
+}
+
+func (x *Job) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *Job) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *Job) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Job) GetMaxAttempts() int64 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Job) GetNextRunAt() int64 {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return 0
+}
+
+func (x *Job) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Job) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *Job) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Job) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ProcessingFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Migration string  `protobuf:"bytes,1,opt,name=migration,proto3" json:"migration,omitempty"` // empty for the users
+	Error     string  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Posts     []*Post `protobuf:"bytes,3,rep,name=posts,proto3" json:"posts,omitempty"`
+	Users     []*User `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *ProcessingFailure) Reset() {
+	*x = ProcessingFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessingFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessingFailure) ProtoMessage() {}
+
+func (x *ProcessingFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessingFailure.ProtoReflect.Descriptor instead.
+func (*ProcessingFailure) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ProcessingFailure) GetMigration() string {
+	if x != nil {
+		return x.Migration
+	}
+	return ""
+}
+
+func (x *ProcessingFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ProcessingFailure) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ProcessingFailure) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type MigrationRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          int64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	CreatedAt   int64              `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"autocreatetime:nano"`
+	UpdatedAt   int64              `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"autoupdatetime:nano"`
+	DeletedAt   int64              `protobuf:"varint,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PostID      int64              `protobuf:"varint,10,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty" gorm:"index:idx_migration_run_post_name,unique"`
+	Post        *Post              `protobuf:"bytes,11,opt,name=post,proto3" json:"post,omitempty"`
+	Name        string             `protobuf:"bytes,12,opt,name=name,proto3" json:"name,omitempty" gorm:"size:64;not null;index:idx_migration_run_post_name,unique"`
+	State       MigrationRun_State `protobuf:"varint,13,opt,name=state,proto3,enum=sgtm.MigrationRun_State" json:"state,omitempty"`
+	Attempts    int64              `protobuf:"varint,14,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError   string             `protobuf:"bytes,15,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	RanAt       int64              `protobuf:"varint,16,opt,name=ran_at,json=ranAt,proto3" json:"ran_at,omitempty"`
+	DurationMs  int64              `protobuf:"varint,17,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	NextRetryAt int64              `protobuf:"varint,18,opt,name=next_retry_at,json=nextRetryAt,proto3" json:"next_retry_at,omitempty"` // unix nano, for the failed runs
+}
+
+func (x *MigrationRun) Reset() {
+	*x = MigrationRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrationRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrationRun) ProtoMessage() {}
+
+func (x *MigrationRun) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrationRun.ProtoReflect.Descriptor instead.
+func (*MigrationRun) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MigrationRun) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *MigrationRun) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *MigrationRun) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *MigrationRun) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *MigrationRun) GetPostID() int64 {
+	if x != nil {
+		return x.PostID
+	}
+	return 0
+}
+
+func (x *MigrationRun) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *MigrationRun) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MigrationRun) GetState() MigrationRun_State {
+	if x != nil {
+		return x.State
+	}
+	return MigrationRun_UnknownState
+}
+
+func (x *MigrationRun) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *MigrationRun) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *MigrationRun) GetRanAt() int64 {
+	if x != nil {
+		return x.RanAt
+	}
+	return 0
+}
+
+func (x *MigrationRun) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *MigrationRun) GetNextRetryAt() int64 {
+	if x != nil {
+		return x.NextRetryAt
+	}
+	return 0
+}
+
+type Rendition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID            int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	CreatedAt     int64  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"autocreatetime:nano"`
+	UpdatedAt     int64  `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"autoupdatetime:nano"`
+	DeletedAt     int64  `protobuf:"varint,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PostID        int64  `protobuf:"varint,10,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty" gorm:"index"`
+	Post          *Post  `protobuf:"bytes,11,opt,name=post,proto3" json:"post,omitempty"`
+	Codec         string `protobuf:"bytes,12,opt,name=codec,proto3" json:"codec,omitempty"` // mp3, opus
+	BitrateKbps   int64  `protobuf:"varint,13,opt,name=bitrate_kbps,json=bitrateKbps,proto3" json:"bitrate_kbps,omitempty"`
+	IPFSCID       string `protobuf:"bytes,14,opt,name=ipfs_cid,json=ipfsCid,proto3" json:"ipfs_cid,omitempty"`
+	MIMEType      string `protobuf:"bytes,15,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	SizeBytes     int64  `protobuf:"varint,16,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	FileExtension string `protobuf:"bytes,17,opt,name=file_extension,json=fileExtension,proto3" json:"file_extension,omitempty"`
+}
+
+func (x *Rendition) Reset() {
+	*x = Rendition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rendition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rendition) ProtoMessage() {}
+
+func (x *Rendition) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rendition.ProtoReflect.Descriptor instead.
+func (*Rendition) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Rendition) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Rendition) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Rendition) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Rendition) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *Rendition) GetPostID() int64 {
+	if x != nil {
+		return x.PostID
+	}
+	return 0
+}
+
+func (x *Rendition) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *Rendition) GetCodec() string {
+	if x != nil {
+		return x.Codec
+	}
+	return ""
+}
+
+func (x *Rendition) GetBitrateKbps() int64 {
+	if x != nil {
+		return x.BitrateKbps
+	}
+	return 0
+}
+
+func (x *Rendition) GetIPFSCID() string {
+	if x != nil {
+		return x.IPFSCID
+	}
+	return ""
+}
+
+func (x *Rendition) GetMIMEType() string {
+	if x != nil {
+		return x.MIMEType
+	}
+	return ""
+}
+
+func (x *Rendition) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Rendition) GetFileExtension() string {
+	if x != nil {
+		return x.FileExtension
+	}
+	return ""
+}
+
+type Waveform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID              int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	CreatedAt       int64  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"autocreatetime:nano"`
+	UpdatedAt       int64  `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"autoupdatetime:nano"`
+	DeletedAt       int64  `protobuf:"varint,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PostID          int64  `protobuf:"varint,10,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty" gorm:"uniqueIndex"`
+	Post            *Post  `protobuf:"bytes,11,opt,name=post,proto3" json:"post,omitempty"`
+	Version         int64  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	SampleRate      int64  `protobuf:"varint,13,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	SamplesPerPixel int64  `protobuf:"varint,14,opt,name=samples_per_pixel,json=samplesPerPixel,proto3" json:"samples_per_pixel,omitempty"`
+	Length          int64  `protobuf:"varint,15,opt,name=length,proto3" json:"length,omitempty"` // number of min/max pairs
+	Peaks           []byte `protobuf:"bytes,16,opt,name=peaks,proto3" json:"peaks,omitempty"`    // min/max pairs of signed 8-bit values
+}
+
+func (x *Waveform) Reset() {
+	*x = Waveform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Waveform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Waveform) ProtoMessage() {}
+
+func (x *Waveform) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Waveform.ProtoReflect.Descriptor instead.
+func (*Waveform) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Waveform) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Waveform) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Waveform) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Waveform) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *Waveform) GetPostID() int64 {
+	if x != nil {
+		return x.PostID
+	}
+	return 0
+}
+
+func (x *Waveform) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *Waveform) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Waveform) GetSampleRate() int64 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *Waveform) GetSamplesPerPixel() int64 {
+	if x != nil {
+		return x.SamplesPerPixel
+	}
+	return 0
+}
+
+func (x *Waveform) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Waveform) GetPeaks() []byte {
+	if x != nil {
+		return x.Peaks
+	}
+	return nil
+}
+
+type Fingerprint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	CreatedAt int64  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"autocreatetime:nano"`
+	UpdatedAt int64  `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"autoupdatetime:nano"`
+	DeletedAt int64  `protobuf:"varint,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PostID    int64  `protobuf:"varint,10,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty" gorm:"uniqueIndex"`
+	Post      *Post  `protobuf:"bytes,11,opt,name=post,proto3" json:"post,omitempty"`
+	Version   int64  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	Length    int64  `protobuf:"varint,13,opt,name=length,proto3" json:"length,omitempty"` // number of sub-fingerprints
+	Hashes    []byte `protobuf:"bytes,14,opt,name=hashes,proto3" json:"hashes,omitempty"`  // 32-bit little-endian sub-fingerprints
+}
+
+func (x *Fingerprint) Reset() {
+	*x = Fingerprint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fingerprint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fingerprint) ProtoMessage() {}
+
+func (x *Fingerprint) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fingerprint.ProtoReflect.Descriptor instead.
+func (*Fingerprint) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Fingerprint) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Fingerprint) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Fingerprint) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Fingerprint) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *Fingerprint) GetPostID() int64 {
+	if x != nil {
+		return x.PostID
+	}
+	return 0
+}
+
+func (x *Fingerprint) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *Fingerprint) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Fingerprint) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Fingerprint) GetHashes() []byte {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+type FingerprintHash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primary_key"`
+	CreatedAt int64  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"autocreatetime:nano"`
+	UpdatedAt int64  `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"autoupdatetime:nano"`
+	DeletedAt int64  `protobuf:"varint,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PostID    int64  `protobuf:"varint,10,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty" gorm:"index"`
+	Post      *Post  `protobuf:"bytes,11,opt,name=post,proto3" json:"post,omitempty"`
+	Version   int64  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	Hash      uint32 `protobuf:"varint,13,opt,name=hash,proto3" json:"hash,omitempty" gorm:"index"` // sampled sub-fingerprint, to find the candidate tracks
+}
+
+func (x *FingerprintHash) Reset() {
+	*x = FingerprintHash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FingerprintHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FingerprintHash) ProtoMessage() {}
+
+func (x *FingerprintHash) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FingerprintHash.ProtoReflect.Descriptor instead.
+func (*FingerprintHash) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FingerprintHash) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *FingerprintHash) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *FingerprintHash) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *FingerprintHash) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *FingerprintHash) GetPostID() int64 {
+	if x != nil {
+		return x.PostID
+	}
+	return 0
+}
+
+func (x *FingerprintHash) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *FingerprintHash) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *FingerprintHash) GetHash() uint32 {
+	if x != nil {
+		return x.Hash
+	}
+	return 0
+}
+
+type Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID             int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DiscordAccessToken string `protobuf:"bytes,2,opt,name=discord_access_token,json=discordAccessToken,proto3" json:"discord_access_token,omitempty"`
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Session) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Session) GetDiscordAccessToken() string {
+	if x != nil {
+		return x.DiscordAccessToken
+	}
+	return ""
+}
+
+type Ping_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Ping_Request) Reset() {
+	*x = Ping_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ping_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ping_Request) ProtoMessage() {}
+
+func (x *Ping_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ping_Request.ProtoReflect.Descriptor instead.
+func (*Ping_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Ping_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Ping_Response) Reset() {
+	*x = Ping_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ping_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ping_Response) ProtoMessage() {}
+
+func (x *Ping_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ping_Response.ProtoReflect.Descriptor instead.
+func (*Ping_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{0, 1}
+}
+
+type Status_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Status_Request) Reset() {
+	*x = Status_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status_Request) ProtoMessage() {}
+
+func (x *Status_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status_Request.ProtoReflect.Descriptor instead.
+func (*Status_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type Status_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uptime         int32               `protobuf:"varint,1,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	Hostname       string              `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	EverythingIsOk bool                `protobuf:"varint,3,opt,name=everything_is_ok,json=everythingIsOk,proto3" json:"everything_is_ok,omitempty"` // all the enabled components are OK
+	Ready          bool                `protobuf:"varint,4,opt,name=ready,proto3" json:"ready,omitempty"`                                           // none of the required components is down
+	Components     []*Status_Component `protobuf:"bytes,5,rep,name=components,proto3" json:"components,omitempty"`
+}
+
+func (x *Status_Response) Reset() {
+	*x = Status_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status_Response) ProtoMessage() {}
+
+func (x *Status_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status_Response.ProtoReflect.Descriptor instead.
+func (*Status_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Status_Response) GetUptime() int32 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *Status_Response) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *Status_Response) GetEverythingIsOk() bool {
+	if x != nil {
+		return x.EverythingIsOk
+	}
+	return false
+}
+
+func (x *Status_Response) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Status_Response) GetComponents() []*Status_Component {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type Status_Component struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	State     Status_State `protobuf:"varint,2,opt,name=state,proto3,enum=sgtm.Status_State" json:"state,omitempty"`
+	Message   string       `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	LatencyMs int64        `protobuf:"varint,4,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	Required  bool         `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"` // the service is not ready when a required component is down
+}
+
+func (x *Status_Component) Reset() {
+	*x = Status_Component{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status_Component) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status_Component) ProtoMessage() {}
+
+func (x *Status_Component) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status_Component.ProtoReflect.Descriptor instead.
+func (*Status_Component) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *Status_Component) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Status_Component) GetState() Status_State {
+	if x != nil {
+		return x.State
+	}
+	return Status_UnknownState
+}
+
+func (x *Status_Component) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Status_Component) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *Status_Component) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type Register_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email     string `protobuf:"bytes,10,opt,name=email,proto3" json:"email,omitempty"`
+	Slug      string `protobuf:"bytes,11,opt,name=slug,proto3" json:"slug,omitempty"`
+	Firstname string `protobuf:"bytes,12,opt,name=firstname,proto3" json:"firstname,omitempty"`
+	Lastname  string `protobuf:"bytes,13,opt,name=lastname,proto3" json:"lastname,omitempty"`
+}
+
+func (x *Register_Request) Reset() {
+	*x = Register_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Register_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Register_Request) ProtoMessage() {}
+
+func (x *Register_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Register_Request.ProtoReflect.Descriptor instead.
+func (*Register_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Register_Request) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Register_Request) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Register_Request) GetFirstname() string {
+	if x != nil {
+		return x.Firstname
+	}
+	return ""
+}
+
+func (x *Register_Request) GetLastname() string {
+	if x != nil {
+		return x.Lastname
+	}
+	return ""
+}
+
+type Register_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *Register_Response) Reset() {
+	*x = Register_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Register_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Register_Response) ProtoMessage() {}
+
+func (x *Register_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Register_Response.ProtoReflect.Descriptor instead.
+func (*Register_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Register_Response) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UserList_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit  int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`  // page size, defaults to 50, max 100
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // opaque value returned as next_cursor by the previous page
+}
+
+func (x *UserList_Request) Reset() {
+	*x = UserList_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserList_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserList_Request) ProtoMessage() {}
+
+func (x *UserList_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserList_Request.ProtoReflect.Descriptor instead.
+func (*UserList_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *UserList_Request) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *UserList_Request) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type UserList_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users      []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextCursor string  `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty on the last page
+}
+
+func (x *UserList_Response) Reset() {
+	*x = UserList_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserList_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserList_Response) ProtoMessage() {}
+
+func (x *UserList_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserList_Response.ProtoReflect.Descriptor instead.
+func (*UserList_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *UserList_Response) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *UserList_Response) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type PostList_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit              int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`  // page size, defaults to 50, max 100
+	Cursor             string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // opaque value returned as next_cursor by the previous page
+	AuthorID           int64    `protobuf:"varint,10,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorSlug         string   `protobuf:"bytes,11,opt,name=author_slug,json=authorSlug,proto3" json:"author_slug,omitempty"`
+	Tags               []string `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"` // tracks must have all the tags
+	Provider           Provider `protobuf:"varint,13,opt,name=provider,proto3,enum=sgtm.Provider" json:"provider,omitempty"`
+	BPMMin             float64  `protobuf:"fixed64,14,opt,name=bpm_min,json=bpmMin,proto3" json:"bpm_min,omitempty"`
+	BPMMax             float64  `protobuf:"fixed64,15,opt,name=bpm_max,json=bpmMax,proto3" json:"bpm_max,omitempty"`
+	KeySignature       string   `protobuf:"bytes,16,opt,name=key_signature,json=keySignature,proto3" json:"key_signature,omitempty"`
+	SortDateAfter      int64    `protobuf:"varint,17,opt,name=sort_date_after,json=sortDateAfter,proto3" json:"sort_date_after,omitempty"`              // unix nano, inclusive
+	SortDateBefore     int64    `protobuf:"varint,18,opt,name=sort_date_before,json=sortDateBefore,proto3" json:"sort_date_before,omitempty"`           // unix nano, exclusive
+	IncludeUnavailable bool     `protobuf:"varint,19,opt,name=include_unavailable,json=includeUnavailable,proto3" json:"include_unavailable,omitempty"` // include the tracks deleted or made private on their provider
+	LoudnessMin        float64  `protobuf:"fixed64,20,opt,name=loudness_min,json=loudnessMin,proto3" json:"loudness_min,omitempty"`                     // LUFS
+	LoudnessMax        float64  `protobuf:"fixed64,21,opt,name=loudness_max,json=loudnessMax,proto3" json:"loudness_max,omitempty"`                     // LUFS
+	LoudnessRangeMin   float64  `protobuf:"fixed64,22,opt,name=loudness_range_min,json=loudnessRangeMin,proto3" json:"loudness_range_min,omitempty"`    // LU
+	LoudnessRangeMax   float64  `protobuf:"fixed64,23,opt,name=loudness_range_max,json=loudnessRangeMax,proto3" json:"loudness_range_max,omitempty"`    // LU
+}
+
+func (x *PostList_Request) Reset() {
+	*x = PostList_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostList_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostList_Request) ProtoMessage() {}
+
+func (x *PostList_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostList_Request.ProtoReflect.Descriptor instead.
+func (*PostList_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *PostList_Request) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PostList_Request) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *PostList_Request) GetAuthorID() int64 {
+	if x != nil {
+		return x.AuthorID
+	}
+	return 0
+}
+
+func (x *PostList_Request) GetAuthorSlug() string {
+	if x != nil {
+		return x.AuthorSlug
+	}
+	return ""
+}
+
+func (x *PostList_Request) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PostList_Request) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_UnknownProvider
+}
+
+func (x *PostList_Request) GetBPMMin() float64 {
+	if x != nil {
+		return x.BPMMin
+	}
+	return 0
+}
+
+func (x *PostList_Request) GetBPMMax() float64 {
+	if x != nil {
+		return x.BPMMax
+	}
+	return 0
+}
+
+func (x *PostList_Request) GetKeySignature() string {
+	if x != nil {
+		return x.KeySignature
+	}
+	return ""
+}
+
+func (x *PostList_Request) GetSortDateAfter() int64 {
+	if x != nil {
+		return x.SortDateAfter
+	}
+	return 0
+}
+
+func (x *PostList_Request) GetSortDateBefore() int64 {
+	if x != nil {
+		return x.SortDateBefore
+	}
+	return 0
+}
+
+func (x *PostList_Request) GetIncludeUnavailable() bool {
+	if x != nil {
+		return x.IncludeUnavailable
+	}
+	return false
+}
+
+func (x *PostList_Request) GetLoudnessMin() float64 {
+	if x != nil {
+		return x.LoudnessMin
+	}
+	return 0
+}
+
+func (x *PostList_Request) GetLoudnessMax() float64 {
+	if x != nil {
+		return x.LoudnessMax
+	}
+	return 0
+}
+
+func (x *PostList_Request) GetLoudnessRangeMin() float64 {
+	if x != nil {
+		return x.LoudnessRangeMin
+	}
+	return 0
+}
+
+func (x *PostList_Request) GetLoudnessRangeMax() float64 {
+	if x != nil {
+		return x.LoudnessRangeMax
+	}
+	return 0
+}
+
+type PostList_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts      []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextCursor string  `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty on the last page
+}
+
+func (x *PostList_Response) Reset() {
+	*x = PostList_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostList_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostList_Response) ProtoMessage() {}
+
+func (x *PostList_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostList_Response.ProtoReflect.Descriptor instead.
+func (*PostList_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *PostList_Response) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *PostList_Response) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type PostGet_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"` // used if id is empty
+}
+
+func (x *PostGet_Request) Reset() {
+	*x = PostGet_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostGet_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostGet_Request) ProtoMessage() {}
+
+func (x *PostGet_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostGet_Request.ProtoReflect.Descriptor instead.
+func (*PostGet_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *PostGet_Request) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PostGet_Request) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type PostGet_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *PostGet_Response) Reset() {
+	*x = PostGet_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostGet_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostGet_Response) ProtoMessage() {}
+
+func (x *PostGet_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostGet_Response.ProtoReflect.Descriptor instead.
+func (*PostGet_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *PostGet_Response) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type PostCreate_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	URL            string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // link to a supported provider, i.e., SoundCloud
+	Draft          bool   `protobuf:"varint,2,opt,name=draft,proto3" json:"draft,omitempty"`
+	Upload         []byte `protobuf:"bytes,10,opt,name=upload,proto3" json:"upload,omitempty"`
+	UploadFilename string `protobuf:"bytes,11,opt,name=upload_filename,json=uploadFilename,proto3" json:"upload_filename,omitempty"`
+	UploadMIMEType string `protobuf:"bytes,12,opt,name=upload_mime_type,json=uploadMimeType,proto3" json:"upload_mime_type,omitempty"`
+}
+
+func (x *PostCreate_Request) Reset() {
+	*x = PostCreate_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostCreate_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostCreate_Request) ProtoMessage() {}
+
+func (x *PostCreate_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostCreate_Request.ProtoReflect.Descriptor instead.
+func (*PostCreate_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *PostCreate_Request) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *PostCreate_Request) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *PostCreate_Request) GetUpload() []byte {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+func (x *PostCreate_Request) GetUploadFilename() string {
+	if x != nil {
+		return x.UploadFilename
+	}
+	return ""
+}
+
+func (x *PostCreate_Request) GetUploadMIMEType() string {
+	if x != nil {
+		return x.UploadMIMEType
+	}
+	return ""
+}
+
+type PostCreate_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *PostCreate_Response) Reset() {
+	*x = PostCreate_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostCreate_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostCreate_Response) ProtoMessage() {}
+
+func (x *PostCreate_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostCreate_Response.ProtoReflect.Descriptor instead.
+func (*PostCreate_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *PostCreate_Response) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type PostUpdate_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Post       *Post                  `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // supported paths: title, body, lyrics, visibility
+}
+
+func (x *PostUpdate_Request) Reset() {
+	*x = PostUpdate_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostUpdate_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostUpdate_Request) ProtoMessage() {}
+
+func (x *PostUpdate_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostUpdate_Request.ProtoReflect.Descriptor instead.
+func (*PostUpdate_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *PostUpdate_Request) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PostUpdate_Request) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *PostUpdate_Request) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type PostUpdate_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *PostUpdate_Response) Reset() {
+	*x = PostUpdate_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostUpdate_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostUpdate_Response) ProtoMessage() {}
+
+func (x *PostUpdate_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostUpdate_Response.ProtoReflect.Descriptor instead.
+func (*PostUpdate_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *PostUpdate_Response) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type PostDelete_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PostDelete_Request) Reset() {
+	*x = PostDelete_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostDelete_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostDelete_Request) ProtoMessage() {}
+
+func (x *PostDelete_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostDelete_Request.ProtoReflect.Descriptor instead.
+func (*PostDelete_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *PostDelete_Request) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type PostDelete_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PostDelete_Response) Reset() {
+	*x = PostDelete_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostDelete_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostDelete_Response) ProtoMessage() {}
+
+func (x *PostDelete_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostDelete_Response.ProtoReflect.Descriptor instead.
+func (*PostDelete_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{8, 1}
+}
+
+type PostSync_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PostSync_Request) Reset() {
+	*x = PostSync_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostSync_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostSync_Request) ProtoMessage() {}
+
+func (x *PostSync_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostSync_Request.ProtoReflect.Descriptor instead.
+func (*PostSync_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *PostSync_Request) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type PostSync_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post          *Post    `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	UpdatedFields []string `protobuf:"bytes,2,rep,name=updated_fields,json=updatedFields,proto3" json:"updated_fields,omitempty"` // DB column names of the fields that changed
+}
+
+func (x *PostSync_Response) Reset() {
+	*x = PostSync_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostSync_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostSync_Response) ProtoMessage() {}
+
+func (x *PostSync_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostSync_Response.ProtoReflect.Descriptor instead.
+func (*PostSync_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *PostSync_Response) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *PostSync_Response) GetUpdatedFields() []string {
+	if x != nil {
+		return x.UpdatedFields
+	}
+	return nil
+}
+
+type CommentList_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *CommentList_Request) Reset() {
+	*x = CommentList_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentList_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentList_Request) ProtoMessage() {}
+
+func (x *CommentList_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentList_Request.ProtoReflect.Descriptor instead.
+func (*CommentList_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *CommentList_Request) GetPostID() int64 {
+	if x != nil {
+		return x.PostID
+	}
+	return 0
+}
+
+type CommentList_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments []*Post `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"` // top-level comments, with their replies
+}
+
+func (x *CommentList_Response) Reset() {
+	*x = CommentList_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentList_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentList_Response) ProtoMessage() {}
+
+func (x *CommentList_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentList_Response.ProtoReflect.Descriptor instead.
+func (*CommentList_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *CommentList_Response) GetComments() []*Post {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type CommentCreate_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID    int64  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ReplyToID int64  `protobuf:"varint,2,opt,name=reply_to_id,json=replyToId,proto3" json:"reply_to_id,omitempty"`
+	Body      string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *CommentCreate_Request) Reset() {
+	*x = CommentCreate_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentCreate_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentCreate_Request) ProtoMessage() {}
+
+func (x *CommentCreate_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentCreate_Request.ProtoReflect.Descriptor instead.
+func (*CommentCreate_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *CommentCreate_Request) GetPostID() int64 {
+	if x != nil {
+		return x.PostID
+	}
+	return 0
+}
+
+func (x *CommentCreate_Request) GetReplyToID() int64 {
+	if x != nil {
+		return x.ReplyToID
+	}
+	return 0
+}
+
+func (x *CommentCreate_Request) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type CommentCreate_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *Post `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *CommentCreate_Response) Reset() {
+	*x = CommentCreate_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentCreate_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentCreate_Response) ProtoMessage() {}
+
+func (x *CommentCreate_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentCreate_Response.ProtoReflect.Descriptor instead.
+func (*CommentCreate_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *CommentCreate_Response) GetComment() *Post {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type CommentUpdate_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *CommentUpdate_Request) Reset() {
+	*x = CommentUpdate_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentUpdate_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentUpdate_Request) ProtoMessage() {}
+
+func (x *CommentUpdate_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentUpdate_Request.ProtoReflect.Descriptor instead.
+func (*CommentUpdate_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *CommentUpdate_Request) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *CommentUpdate_Request) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type CommentUpdate_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *Post `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *CommentUpdate_Response) Reset() {
+	*x = CommentUpdate_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentUpdate_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentUpdate_Response) ProtoMessage() {}
+
+func (x *CommentUpdate_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentUpdate_Response.ProtoReflect.Descriptor instead.
+func (*CommentUpdate_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *CommentUpdate_Response) GetComment() *Post {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type CommentDelete_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CommentDelete_Request) Reset() {
+	*x = CommentDelete_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentDelete_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentDelete_Request) ProtoMessage() {}
+
+func (x *CommentDelete_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentDelete_Request.ProtoReflect.Descriptor instead.
+func (*CommentDelete_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *CommentDelete_Request) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type CommentDelete_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CommentDelete_Response) Reset() {
+	*x = CommentDelete_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentDelete_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentDelete_Response) ProtoMessage() {}
+
+func (x *CommentDelete_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentDelete_Response.ProtoReflect.Descriptor instead.
+func (*CommentDelete_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{13, 1}
+}
+
+type ActivityStream_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kinds        []Post_Kind `protobuf:"varint,1,rep,packed,name=kinds,proto3,enum=sgtm.Post_Kind" json:"kinds,omitempty"`
+	AuthorID     int64       `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	TargetPostID int64       `protobuf:"varint,3,opt,name=target_post_id,json=targetPostId,proto3" json:"target_post_id,omitempty"`
+}
+
+func (x *ActivityStream_Request) Reset() {
+	*x = ActivityStream_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityStream_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityStream_Request) ProtoMessage() {}
+
+func (x *ActivityStream_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityStream_Request.ProtoReflect.Descriptor instead.
+func (*ActivityStream_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *ActivityStream_Request) GetKinds() []Post_Kind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *ActivityStream_Request) GetAuthorID() int64 {
+	if x != nil {
+		return x.AuthorID
+	}
+	return 0
+}
+
+func (x *ActivityStream_Request) GetTargetPostID() int64 {
+	if x != nil {
+		return x.TargetPostID
+	}
+	return 0
+}
+
+type ActivityStream_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Activity *Post `protobuf:"bytes,1,opt,name=activity,proto3" json:"activity,omitempty"`
+}
+
+func (x *ActivityStream_Response) Reset() {
+	*x = ActivityStream_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityStream_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityStream_Response) ProtoMessage() {}
+
+func (x *ActivityStream_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityStream_Response.ProtoReflect.Descriptor instead.
+func (*ActivityStream_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{14, 1}
+}
+
+func (x *ActivityStream_Response) GetActivity() *Post {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+type RelationshipList_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID int64               `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"` // relationships from or to this track
+	UserID int64               `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // relationships to this user, or from one of their tracks
+	Kinds  []Relationship_Kind `protobuf:"varint,3,rep,packed,name=kinds,proto3,enum=sgtm.Relationship_Kind" json:"kinds,omitempty"`
+}
+
+func (x *RelationshipList_Request) Reset() {
+	*x = RelationshipList_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationshipList_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipList_Request) ProtoMessage() {}
+
+func (x *RelationshipList_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipList_Request.ProtoReflect.Descriptor instead.
+func (*RelationshipList_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *RelationshipList_Request) GetPostID() int64 {
+	if x != nil {
+		return x.PostID
+	}
+	return 0
+}
+
+func (x *RelationshipList_Request) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RelationshipList_Request) GetKinds() []Relationship_Kind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type RelationshipList_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Relationships []*Relationship `protobuf:"bytes,1,rep,name=relationships,proto3" json:"relationships,omitempty"`
+}
+
+func (x *RelationshipList_Response) Reset() {
+	*x = RelationshipList_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationshipList_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipList_Response) ProtoMessage() {}
+
+func (x *RelationshipList_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipList_Response.ProtoReflect.Descriptor instead.
+func (*RelationshipList_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{15, 1}
+}
+
+func (x *RelationshipList_Response) GetRelationships() []*Relationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+type RelationshipCreate_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind         Relationship_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=sgtm.Relationship_Kind" json:"kind,omitempty"`
+	SourcePostID int64             `protobuf:"varint,2,opt,name=source_post_id,json=sourcePostId,proto3" json:"source_post_id,omitempty"`
+	TargetPostID int64             `protobuf:"varint,3,opt,name=target_post_id,json=targetPostId,proto3" json:"target_post_id,omitempty"` // for the track to track kinds
+	TargetUserID int64             `protobuf:"varint,4,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"` // for the track to user kinds
+}
+
+func (x *RelationshipCreate_Request) Reset() {
+	*x = RelationshipCreate_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationshipCreate_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipCreate_Request) ProtoMessage() {}
+
+func (x *RelationshipCreate_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipCreate_Request.ProtoReflect.Descriptor instead.
+func (*RelationshipCreate_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *RelationshipCreate_Request) GetKind() Relationship_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Relationship_UnknownKind
+}
+
+func (x *RelationshipCreate_Request) GetSourcePostID() int64 {
+	if x != nil {
+		return x.SourcePostID
+	}
+	return 0
+}
+
+func (x *RelationshipCreate_Request) GetTargetPostID() int64 {
+	if x != nil {
+		return x.TargetPostID
+	}
+	return 0
+}
+
+func (x *RelationshipCreate_Request) GetTargetUserID() int64 {
+	if x != nil {
+		return x.TargetUserID
+	}
+	return 0
+}
+
+type RelationshipCreate_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Relationship *Relationship `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+}
+
+func (x *RelationshipCreate_Response) Reset() {
+	*x = RelationshipCreate_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationshipCreate_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipCreate_Response) ProtoMessage() {}
+
+func (x *RelationshipCreate_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipCreate_Response.ProtoReflect.Descriptor instead.
+func (*RelationshipCreate_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{16, 1}
+}
+
+func (x *RelationshipCreate_Response) GetRelationship() *Relationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+type RelationshipDelete_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RelationshipDelete_Request) Reset() {
+	*x = RelationshipDelete_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationshipDelete_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipDelete_Request) ProtoMessage() {}
+
+func (x *RelationshipDelete_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipDelete_Request.ProtoReflect.Descriptor instead.
+func (*RelationshipDelete_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *RelationshipDelete_Request) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type RelationshipDelete_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RelationshipDelete_Response) Reset() {
+	*x = RelationshipDelete_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationshipDelete_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipDelete_Response) ProtoMessage() {}
+
+func (x *RelationshipDelete_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipDelete_Response.ProtoReflect.Descriptor instead.
+func (*RelationshipDelete_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{17, 1}
+}
+
+type RelationshipGraph_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID   int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`       // remixes, versions and inspirations of a track, in both directions
+	UserID   int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // collaborators of a user, used if post_id is empty
+	MaxDepth int32 `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"` // defaults to 10 for a track and 1 for a user
+}
+
+func (x *RelationshipGraph_Request) Reset() {
+	*x = RelationshipGraph_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationshipGraph_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipGraph_Request) ProtoMessage() {}
+
+func (x *RelationshipGraph_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipGraph_Request.ProtoReflect.Descriptor instead.
+func (*RelationshipGraph_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *RelationshipGraph_Request) GetPostID() int64 {
+	if x != nil {
+		return x.PostID
+	}
+	return 0
+}
+
+func (x *RelationshipGraph_Request) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RelationshipGraph_Request) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+type RelationshipGraph_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts         []*Post         `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Users         []*User         `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	Relationships []*Relationship `protobuf:"bytes,3,rep,name=relationships,proto3" json:"relationships,omitempty"`
+}
+
+func (x *RelationshipGraph_Response) Reset() {
+	*x = RelationshipGraph_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationshipGraph_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipGraph_Response) ProtoMessage() {}
+
+func (x *RelationshipGraph_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipGraph_Response.ProtoReflect.Descriptor instead.
+func (*RelationshipGraph_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{18, 1}
+}
+
+func (x *RelationshipGraph_Response) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *RelationshipGraph_Response) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *RelationshipGraph_Response) GetRelationships() []*Relationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+type APITokenList_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *APITokenList_Request) Reset() {
+	*x = APITokenList_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APITokenList_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APITokenList_Request) ProtoMessage() {}
+
+func (x *APITokenList_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APITokenList_Request.ProtoReflect.Descriptor instead.
+func (*APITokenList_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type APITokenList_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokens []*APIToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *APITokenList_Response) Reset() {
+	*x = APITokenList_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APITokenList_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APITokenList_Response) ProtoMessage() {}
+
+func (x *APITokenList_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APITokenList_Response.ProtoReflect.Descriptor instead.
+func (*APITokenList_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *APITokenList_Response) GetTokens() []*APIToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type APITokenCreate_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"` // read, post:write, comment:write
+}
+
+func (x *APITokenCreate_Request) Reset() {
+	*x = APITokenCreate_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APITokenCreate_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APITokenCreate_Request) ProtoMessage() {}
+
+func (x *APITokenCreate_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APITokenCreate_Request.ProtoReflect.Descriptor instead.
+func (*APITokenCreate_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *APITokenCreate_Request) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APITokenCreate_Request) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type APITokenCreate_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token  *APIToken `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Secret string    `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // only returned once, to be used as "Authorization: Bearer <secret>"
+}
+
+func (x *APITokenCreate_Response) Reset() {
+	*x = APITokenCreate_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APITokenCreate_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APITokenCreate_Response) ProtoMessage() {}
+
+func (x *APITokenCreate_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APITokenCreate_Response.ProtoReflect.Descriptor instead.
+func (*APITokenCreate_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{20, 1}
+}
+
+func (x *APITokenCreate_Response) GetToken() *APIToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *APITokenCreate_Response) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type APITokenRevoke_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *APITokenRevoke_Request) Reset() {
+	*x = APITokenRevoke_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APITokenRevoke_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APITokenRevoke_Request) ProtoMessage() {}
+
+func (x *APITokenRevoke_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APITokenRevoke_Request.ProtoReflect.Descriptor instead.
+func (*APITokenRevoke_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *APITokenRevoke_Request) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type APITokenRevoke_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *APITokenRevoke_Response) Reset() {
+	*x = APITokenRevoke_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APITokenRevoke_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APITokenRevoke_Response) ProtoMessage() {}
+
+func (x *APITokenRevoke_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APITokenRevoke_Response.ProtoReflect.Descriptor instead.
+func (*APITokenRevoke_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{21, 1}
+}
+
+type MigrationList_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *MigrationList_Request) Reset() {
+	*x = MigrationList_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrationList_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrationList_Request) ProtoMessage() {}
+
+func (x *MigrationList_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrationList_Request.ProtoReflect.Descriptor instead.
+func (*MigrationList_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *MigrationList_Request) GetPostID() int64 {
+	if x != nil {
+		return x.PostID
+	}
+	return 0
+}
+
+type MigrationList_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string        `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // registered track migrations, in execution order
+	Runs  []*MigrationRun `protobuf:"bytes,2,rep,name=runs,proto3" json:"runs,omitempty"`
+}
+
+func (x *MigrationList_Response) Reset() {
+	*x = MigrationList_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrationList_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrationList_Response) ProtoMessage() {}
+
+func (x *MigrationList_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrationList_Response.ProtoReflect.Descriptor instead.
+func (*MigrationList_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{22, 1}
+}
+
+func (x *MigrationList_Response) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *MigrationList_Response) GetRuns() []*MigrationRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type MigrationReplay_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// exactly one of the targets below
+	PostID    int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserID    int64 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AllTracks bool  `protobuf:"varint,4,opt,name=all_tracks,json=allTracks,proto3" json:"all_tracks,omitempty"`
+}
+
+func (x *MigrationReplay_Request) Reset() {
+	*x = MigrationReplay_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrationReplay_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrationReplay_Request) ProtoMessage() {}
+
+func (x *MigrationReplay_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrationReplay_Request.ProtoReflect.Descriptor instead.
+func (*MigrationReplay_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *MigrationReplay_Request) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MigrationReplay_Request) GetPostID() int64 {
+	if x != nil {
+		return x.PostID
+	}
+	return 0
+}
+
+func (x *MigrationReplay_Request) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *MigrationReplay_Request) GetAllTracks() bool {
+	if x != nil {
+		return x.AllTracks
+	}
+	return false
+}
+
+type MigrationReplay_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueuedTracks int64 `protobuf:"varint,1,opt,name=queued_tracks,json=queuedTracks,proto3" json:"queued_tracks,omitempty"`
+}
+
+func (x *MigrationReplay_Response) Reset() {
+	*x = MigrationReplay_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrationReplay_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrationReplay_Response) ProtoMessage() {}
+
+func (x *MigrationReplay_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrationReplay_Response.ProtoReflect.Descriptor instead.
+func (*MigrationReplay_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{23, 1}
+}
+
+func (x *MigrationReplay_Response) GetQueuedTracks() int64 {
+	if x != nil {
+		return x.QueuedTracks
+	}
+	return 0
+}
+
+type ProcessingFailureList_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProcessingFailureList_Request) Reset() {
+	*x = ProcessingFailureList_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessingFailureList_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessingFailureList_Request) ProtoMessage() {}
+
+func (x *ProcessingFailureList_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessingFailureList_Request.ProtoReflect.Descriptor instead.
+func (*ProcessingFailureList_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{24, 0}
+}
+
+type ProcessingFailureList_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Failures []*ProcessingFailure `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures,omitempty"` // grouped by migration and error, the most frequent first
+}
+
+func (x *ProcessingFailureList_Response) Reset() {
+	*x = ProcessingFailureList_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessingFailureList_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessingFailureList_Response) ProtoMessage() {}
+
+func (x *ProcessingFailureList_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessingFailureList_Response.ProtoReflect.Descriptor instead.
+func (*ProcessingFailureList_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{24, 1}
+}
+
+func (x *ProcessingFailureList_Response) GetFailures() []*ProcessingFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type ProcessingFailureRetry_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the filters are combined; all is required when no filter is set
+	Migration string `protobuf:"bytes,1,opt,name=migration,proto3" json:"migration,omitempty"`
+	Error     string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	PostID    int64  `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserID    int64  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	All       bool   `protobuf:"varint,5,opt,name=all,proto3" json:"all,omitempty"`
+}
+
+func (x *ProcessingFailureRetry_Request) Reset() {
+	*x = ProcessingFailureRetry_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessingFailureRetry_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessingFailureRetry_Request) ProtoMessage() {}
+
+func (x *ProcessingFailureRetry_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessingFailureRetry_Request.ProtoReflect.Descriptor instead.
+func (*ProcessingFailureRetry_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *ProcessingFailureRetry_Request) GetMigration() string {
+	if x != nil {
+		return x.Migration
+	}
+	return ""
+}
+
+func (x *ProcessingFailureRetry_Request) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ProcessingFailureRetry_Request) GetPostID() int64 {
+	if x != nil {
+		return x.PostID
+	}
+	return 0
+}
+
+func (x *ProcessingFailureRetry_Request) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ProcessingFailureRetry_Request) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type ProcessingFailureRetry_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueuedTracks int64 `protobuf:"varint,1,opt,name=queued_tracks,json=queuedTracks,proto3" json:"queued_tracks,omitempty"`
+}
+
+func (x *ProcessingFailureRetry_Response) Reset() {
+	*x = ProcessingFailureRetry_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessingFailureRetry_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessingFailureRetry_Response) ProtoMessage() {}
+
+func (x *ProcessingFailureRetry_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessingFailureRetry_Response.ProtoReflect.Descriptor instead.
+func (*ProcessingFailureRetry_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{25, 1}
+}
+
+func (x *ProcessingFailureRetry_Response) GetQueuedTracks() int64 {
+	if x != nil {
+		return x.QueuedTracks
+	}
+	return 0
+}
+
+type ProcessingFailureClear_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the filters are combined; all is required when no filter is set
+	Migration string `protobuf:"bytes,1,opt,name=migration,proto3" json:"migration,omitempty"`
+	Error     string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	PostID    int64  `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserID    int64  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	All       bool   `protobuf:"varint,5,opt,name=all,proto3" json:"all,omitempty"`
+}
+
+func (x *ProcessingFailureClear_Request) Reset() {
+	*x = ProcessingFailureClear_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessingFailureClear_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessingFailureClear_Request) ProtoMessage() {}
+
+func (x *ProcessingFailureClear_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessingFailureClear_Request.ProtoReflect.Descriptor instead.
+func (*ProcessingFailureClear_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *ProcessingFailureClear_Request) GetMigration() string {
+	if x != nil {
+		return x.Migration
+	}
+	return ""
+}
+
+func (x *ProcessingFailureClear_Request) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ProcessingFailureClear_Request) GetPostID() int64 {
+	if x != nil {
+		return x.PostID
+	}
+	return 0
+}
+
+func (x *ProcessingFailureClear_Request) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ProcessingFailureClear_Request) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type ProcessingFailureClear_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClearedTracks int64 `protobuf:"varint,1,opt,name=cleared_tracks,json=clearedTracks,proto3" json:"cleared_tracks,omitempty"`
+	ClearedUsers  int64 `protobuf:"varint,2,opt,name=cleared_users,json=clearedUsers,proto3" json:"cleared_users,omitempty"`
+}
+
+func (x *ProcessingFailureClear_Response) Reset() {
+	*x = ProcessingFailureClear_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessingFailureClear_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessingFailureClear_Response) ProtoMessage() {}
+
+func (x *ProcessingFailureClear_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessingFailureClear_Response.ProtoReflect.Descriptor instead.
+func (*ProcessingFailureClear_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{26, 1}
+}
+
+func (x *ProcessingFailureClear_Response) GetClearedTracks() int64 {
+	if x != nil {
+		return x.ClearedTracks
+	}
+	return 0
+}
+
+func (x *ProcessingFailureClear_Response) GetClearedUsers() int64 {
+	if x != nil {
+		return x.ClearedUsers
+	}
+	return 0
+}
+
+type Me_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Me_Request) Reset() {
+	*x = Me_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Me_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Me_Request) ProtoMessage() {}
+
+func (x *Me_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Me_Request.ProtoReflect.Descriptor instead.
+func (*Me_Request) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{27, 0}
+}
+
+type Me_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *Me_Response) Reset() {
+	*x = Me_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sgtm_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Me_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Me_Response) ProtoMessage() {}
+
+func (x *Me_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sgtm_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Me_Response.ProtoReflect.Descriptor instead.
+func (*Me_Response) Descriptor() ([]byte, []int) {
+	return file_sgtm_proto_rawDescGZIP(), []int{27, 1}
+}
+
+func (x *Me_Response) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+var File_sgtm_proto protoreflect.FileDescriptor
+
+var file_sgtm_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x73, 0x67,
+	0x74, 0x6d, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x1d, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xca, 0x03, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x09, 0x0a, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0xb6, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x76, 0x65, 0x72,
+	0x79, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x73, 0x5f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x65, 0x76, 0x65, 0x72, 0x79, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x49, 0x73,
+	0x4f, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
+	0x67, 0x74, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73,
+	0x1a, 0x9e, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x22, 0x5b, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x4f, 0x4b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x65, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
+	0x44, 0x6f, 0x77, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x10, 0x04, 0x22, 0xa5,
+	0x01, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x1a, 0x6d, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x6c, 0x75, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x2a, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x1a, 0x37, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0x4d, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xb9, 0x05, 0x0a, 0x08,
+	0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0xdd, 0x04, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x49, 0x44, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x6c, 0x75, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x25, 0x0a, 0x07, 0x62, 0x70, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x42, 0x50, 0x4d, 0x4d, 0x69, 0x6e, 0x52,
+	0x06, 0x62, 0x70, 0x6d, 0x4d, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x07, 0x62, 0x70, 0x6d, 0x5f, 0x6d,
+	0x61, 0x78, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06,
+	0x42, 0x50, 0x4d, 0x4d, 0x61, 0x78, 0x52, 0x06, 0x62, 0x70, 0x6d, 0x4d, 0x61, 0x78, 0x12, 0x23,
+	0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x6f,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x73,
+	0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x55, 0x6e, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x75, 0x64, 0x6e, 0x65,
+	0x73, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6c, 0x6f,
+	0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x4d, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x75,
+	0x64, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x6c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x78, 0x12, 0x2c, 0x0a, 0x12,
+	0x6c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d,
+	0x69, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6c, 0x6f, 0x75, 0x64, 0x6e, 0x65,
+	0x73, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x69, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f,
+	0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x78,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x78, 0x1a, 0x4d, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x6e, 0x0a, 0x07, 0x50, 0x6f, 0x73, 0x74, 0x47,
+	0x65, 0x74, 0x1a, 0x37, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a,
+	0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x1a, 0x2a, 0x0a, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0xf8, 0x01, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x1a, 0xbd, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x64, 0x72, 0x61, 0x66, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x10, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x49,
+	0x4d, 0x45, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x69,
+	0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x2a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x1a, 0x80, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a,
+	0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x1a, 0x2a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1e, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74,
+	0x22, 0x3d, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x1a, 0x23,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52,
+	0x02, 0x69, 0x64, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x82, 0x01, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x1a, 0x23, 0x0a, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x1a, 0x51, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a,
+	0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67,
+	0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x22, 0x73, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x1a, 0x30, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x1a, 0x32, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x1a, 0x75, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x50,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2f, 0x0a,
+	0x0b, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54,
+	0x6f, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x1a, 0x30, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x7a, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x37, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xca, 0xb5,
+	0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x30,
+	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67,
+	0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x40, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x1a, 0x23, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x1a, 0x97, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x2e, 0x4b, 0x69, 0x6e,
+	0x64, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0e, 0xca, 0xb5, 0x03,
+	0x0a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x52, 0x08, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12, 0xca,
+	0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x1a,
+	0x32, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x08, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x22, 0xe1, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x68, 0x69, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x86, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x50, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0xca, 0xb5,
+	0x03, 0x08, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64,
+	0x73, 0x1a, 0x44, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x22, 0xbf, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x1a, 0xe4,
+	0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x4b, 0x69, 0x6e,
+	0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x38, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a,
+	0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x0c, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x0e, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x42, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0c, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x22, 0x45, 0x0a, 0x12, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x1a,
+	0x23, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x94, 0x02, 0x0a, 0x11, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x1a, 0x74, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x1a, 0x88, 0x01, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x70, 0x6f, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74,
+	0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a,
+	0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x22, 0x4d, 0x0a, 0x0c, 0x41, 0x50, 0x49, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x32, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x0e, 0x41, 0x50, 0x49, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x1a, 0x35, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
+	0x1a, 0x48, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x67,
+	0x74, 0x6d, 0x2e, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x41, 0x0a, 0x0e, 0x41, 0x50,
+	0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x1a, 0x23, 0x0a, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b, 0x01,
+	0x0a, 0x0d, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x1a,
+	0x30, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0xca, 0xb5, 0x03,
+	0x08, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x1a, 0x48, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x0f,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x1a,
+	0x8a, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x1a, 0x2f, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x63, 0x0a,
+	0x15, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x3f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x74, 0x72, 0x79, 0x1a, 0x9d, 0x01,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25, 0x0a,
+	0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c,
+	0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61,
+	0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x1a, 0x2f, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x90,
+	0x02, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x1a, 0x9d, 0x01, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08,
+	0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x1a, 0x56, 0x0a, 0x08, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x65, 0x64,
+	0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63,
+	0x6c, 0x65, 0x61, 0x72, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6c, 0x65, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x22, 0x3b, 0x0a, 0x02, 0x4d, 0x65, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73,
+	0x67, 0x74, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xa1,
+	0x0c, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0x0a, 0x02, 0x49, 0x44, 0xa2, 0x01, 0x12,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65,
+	0x79, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d,
+	0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5,
+	0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xca, 0xb5, 0x03, 0x29, 0xa2, 0x01,
+	0x26, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x32, 0x35, 0x35, 0x3b,
+	0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x2c,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x22, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x3d,
+	0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xca, 0xb5,
+	0x03, 0x25, 0xa2, 0x01, 0x22, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x3a,
+	0x33, 0x32, 0x3b, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x3a, 0x27, 0x27, 0x22, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x48, 0x0a,
+	0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73,
+	0x69, 0x7a, 0x65, 0x3a, 0x32, 0x35, 0x35, 0x3b, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c,
+	0x3b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x27, 0x27, 0x22, 0x52, 0x09, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2,
+	0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x32, 0x35, 0x35,
+	0x3b, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x3a, 0x27, 0x27, 0x22, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x41, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x29, 0xca, 0xb5, 0x03, 0x25, 0xa2, 0x01, 0x22, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x69,
+	0x7a, 0x65, 0x3a, 0x33, 0x32, 0x3b, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x27, 0x27, 0x22, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x32, 0x35, 0x35, 0x3b, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75,
+	0x6c, 0x6c, 0x3b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x27, 0x27, 0x22, 0x52, 0x06,
+	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x54, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0xca, 0xb5, 0x03, 0x31,
+	0x0a, 0x09, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0xa2, 0x01, 0x23, 0x67, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x32, 0x35, 0x35, 0x3b, 0x6e, 0x6f, 0x74,
+	0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x27, 0x27,
+	0x22, 0x52, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x55, 0x0a, 0x10,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x32, 0x35, 0x35, 0x3b, 0x6e, 0x6f,
+	0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x27,
+	0x27, 0x22, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69,
+	0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x65, 0x61, 0x72, 0x73, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x65, 0x61, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67,
+	0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e,
+	0x72, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x29, 0x0a, 0x10, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f,
+	0x6d, 0x65, 0x70, 0x61, 0x67, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f,
+	0x6d, 0x65, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f,
+	0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x74, 0x68,
+	0x65, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x12, 0x2f, 0x0a,
+	0x13, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x6f, 0x75, 0x6e,
+	0x64, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x5e, 0x0a, 0x0c,
+	0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x32, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x2f,
+	0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x3a, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44,
+	0x3b, 0x50, 0x52, 0x45, 0x4c, 0x4f, 0x41, 0x44, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x22, 0x52,
+	0x0b, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x71, 0x0a, 0x17,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x5f, 0x61, 0x73,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x33, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x42, 0x25, 0xca, 0xb5, 0x03, 0x21, 0xa2, 0x01, 0x1e, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x52, 0x15, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x41, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x71, 0x0a, 0x17, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73,
+	0x5f, 0x61, 0x73, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x34, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x68, 0x69, 0x70, 0x42, 0x25, 0xca, 0xb5, 0x03, 0x21, 0xa2, 0x01, 0x1e, 0x67, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x52, 0x15, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x41, 0x73, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x22, 0xb6, 0x1e, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0x0a, 0x02, 0x49,
+	0x44, 0xa2, 0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21,
+	0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74,
+	0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f,
+	0x22, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61,
+	0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61,
+	0x6e, 0x6f, 0x22, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a,
+	0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x73, 0x67, 0x74, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x12, 0x2b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x49, 0x44, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x23, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x30, 0x0a,
+	0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5,
+	0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x38, 0x0a, 0x0e, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x52, 0x0c, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12,
+	0x2f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x20,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x54, 0x6f, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x18, 0x21, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18,
+	0x23, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x2d, 0x22, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x05, 0x67,
+	0x65, 0x6e, 0x72, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05,
+	0x67, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x29, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x31, 0x0a, 0x0b, 0x61, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x41, 0x72,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x55, 0x52, 0x4c, 0x52, 0x0a, 0x61, 0x72, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x03, 0x62, 0x70, 0x6d, 0x18, 0x2b, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x42, 0x50, 0x4d, 0x52, 0x03, 0x62, 0x70,
+	0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x69, 0x73, 0x72, 0x63, 0x18, 0x2d,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xb5, 0x03, 0x06, 0x0a, 0x04, 0x49, 0x53, 0x52, 0x43,
+	0x52, 0x04, 0x69, 0x73, 0x72, 0x63, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x35, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x31, 0x0a,
+	0x14, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x45, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55,
+	0x52, 0x4c, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x30,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x31,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x32, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x33, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x79, 0x72, 0x69,
+	0x63, 0x73, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x77, 0x61, 0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x36, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x77, 0x61, 0x76, 0x65,
+	0x66, 0x6f, 0x72, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x37, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x12, 0x76, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x38, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x42, 0x20, 0xca, 0xb5, 0x03, 0x1c, 0xa2, 0x01, 0x19, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x3a, 0x30, 0x22, 0x52, 0x14, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x13, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x39, 0x20, 0x01, 0x28, 0x03, 0x42, 0x20, 0xca, 0xb5, 0x03, 0x1c, 0xa2, 0x01,
+	0x19, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x30, 0x22, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x75, 0x64,
+	0x6e, 0x65, 0x73, 0x73, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x75, 0x64,
+	0x6e, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x65, 0x5f, 0x70, 0x65, 0x61,
+	0x6b, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x72, 0x75, 0x65, 0x50, 0x65, 0x61,
+	0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6c, 0x6f, 0x75, 0x64, 0x6e,
+	0x65, 0x73, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x5f, 0x67, 0x61, 0x69, 0x6e, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x47, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x13,
+	0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x40, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x66, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a,
+	0x13, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x41, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x6f, 0x73, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x28,
+	0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x42, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x0d, 0xca, 0xb5, 0x03, 0x09, 0x0a, 0x07, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52,
+	0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x05, 0x61, 0x6c, 0x62, 0x75,
+	0x6d, 0x18, 0x43, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c,
+	0x62, 0x75, 0x6d, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x44, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3e, 0x0a, 0x0c, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x18, 0x45, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x2d, 0x22, 0x52, 0x0b, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x12, 0x53, 0x0a, 0x17, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x50, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1b, 0xca, 0xb5, 0x03, 0x17, 0x0a, 0x15, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x43,
+	0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x15, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x37, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x51, 0x20, 0x01, 0x28, 0x04, 0x42, 0x12, 0xca,
+	0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49,
+	0x44, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x64, 0x12,
+	0x58, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x53, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4b,
+	0x69, 0x6e, 0x64, 0x42, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x53, 0x6f, 0x75, 0x6e, 0x64,
+	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x6e, 0x64,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x08, 0x69, 0x70, 0x66,
+	0x73, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xb5, 0x03,
+	0x09, 0x0a, 0x07, 0x49, 0x50, 0x46, 0x53, 0x43, 0x49, 0x44, 0x52, 0x07, 0x69, 0x70, 0x66, 0x73,
+	0x43, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x5b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x4d, 0x49,
+	0x4d, 0x45, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x5c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x5d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x5e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x65, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x5f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x67,
+	0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x60, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xb5,
+	0x03, 0x0b, 0x0a, 0x09, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x09, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x31, 0x0a, 0x0b, 0x62, 0x61, 0x6e, 0x64,
+	0x63, 0x61, 0x6d, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x78, 0x20, 0x01, 0x28, 0x04, 0x42, 0x10, 0xca,
+	0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x42, 0x61, 0x6e, 0x64, 0x63, 0x61, 0x6d, 0x70, 0x49, 0x44, 0x52,
+	0x0a, 0x62, 0x61, 0x6e, 0x64, 0x63, 0x61, 0x6d, 0x70, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x13, 0x79,
+	0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x64, 0x6c, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xca, 0xb5, 0x03, 0x14, 0x0a,
+	0x12, 0x59, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x44, 0x4c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x12, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x64, 0x6c, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x0c, 0x79, 0x6f, 0x75, 0x74, 0x75,
+	0x62, 0x65, 0x64, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x83, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
+	0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x59, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x44, 0x4c, 0x49,
+	0x44, 0x52, 0x0b, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x64, 0x6c, 0x49, 0x64, 0x12, 0x38,
+	0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x65, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x73, 0x67, 0x74, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x67, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12, 0xca,
+	0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x2b, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x68,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x69, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x71, 0x0a, 0x17, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x5f, 0x61, 0x73, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x6e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x42, 0x25, 0xca, 0xb5, 0x03, 0x21,
+	0xa2, 0x01, 0x1e, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x4b, 0x65, 0x79, 0x3a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x22, 0x52, 0x15, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73,
+	0x41, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x71, 0x0a, 0x17, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x5f, 0x61, 0x73, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x6f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x67, 0x74, 0x6d,
+	0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x42, 0x25, 0xca,
+	0xb5, 0x03, 0x21, 0xa2, 0x01, 0x1e, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x22, 0x52, 0x15, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68,
+	0x69, 0x70, 0x73, 0x41, 0x73, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x14,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x1b, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10,
+	0x02, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x10, 0x04,
+	0x22, 0x58, 0x0a, 0x0e, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4b, 0x69,
+	0x6e, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x6f, 0x75,
+	0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x00, 0x12, 0x13, 0x0a,
+	0x0f, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x10, 0x02, 0x22, 0xce, 0x01, 0x0a, 0x04, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4b, 0x69,
+	0x6e, 0x64, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4b, 0x69, 0x6e,
+	0x64, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4b, 0x69, 0x6e, 0x64,
+	0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x69,
+	0x6e, 0x64, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x72, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x04,
+	0x12, 0x13, 0x0a, 0x0f, 0x56, 0x69, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4b,
+	0x69, 0x6e, 0x64, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x69, 0x65, 0x77, 0x50, 0x6f, 0x73,
+	0x74, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x69, 0x65, 0x77, 0x4f,
+	0x70, 0x65, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x69, 0x65,
+	0x77, 0x48, 0x6f, 0x6d, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x09, 0x12, 0x0d, 0x0a, 0x09,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x0a, 0x22, 0xd7, 0x07, 0x0a, 0x0c,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x2d, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0x0a, 0x02,
+	0x49, 0x44, 0xa2, 0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75,
+	0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e,
+	0x6f, 0x22, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x61, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e,
+	0x61, 0x6e, 0x6f, 0x22, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73,
+	0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x0e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74,
+	0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x38, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e,
+	0x0a, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x0c,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0b,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0a, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0e, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x38, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x0c, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x72, 0x61, 0x77, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x61, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x72, 0x61, 0x77, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x52, 0x61, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x42, 0x0a, 0x09, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x22, 0x52, 0x09, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0f,
+	0x0a, 0x0b, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x00, 0x12,
+	0x15, 0x0a, 0x11, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72,
+	0x4b, 0x69, 0x6e, 0x64, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x69, 0x78, 0x4f,
+	0x66, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15,
+	0x4e, 0x65, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x42, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x04,
+	0x12, 0x13, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x69, 0x78, 0x4f, 0x66, 0x55, 0x73, 0x65, 0x72, 0x4b,
+	0x69, 0x6e, 0x64, 0x10, 0x05, 0x22, 0xc7, 0x04, 0x0a, 0x08, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x2d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d,
+	0xca, 0xb5, 0x03, 0x19, 0x0a, 0x02, 0x49, 0x44, 0xa2, 0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1b, 0xca, 0xb5, 0x03, 0x17, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01,
+	0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x32, 0x35, 0x35, 0x3b,
+	0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x3a, 0x27, 0x27, 0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c,
+	0xca, 0xb5, 0x03, 0x28, 0xa2, 0x01, 0x25, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x69, 0x7a,
+	0x65, 0x3a, 0x36, 0x34, 0x3b, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x3a, 0x2c, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x22, 0x52, 0x09, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x4c, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xca,
+	0xb5, 0x03, 0x25, 0xa2, 0x01, 0x22, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x69, 0x7a, 0x65,
+	0x3a, 0x31, 0x36, 0x3b, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x3a, 0x27, 0x27, 0x22, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x20, 0x0a,
+	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0xb3, 0x08, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x2d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0x0a, 0x02, 0x49, 0x44, 0xa2, 0x01, 0x12,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65,
+	0x79, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d,
+	0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5,
+	0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x4a,
+	0x6f, 0x62, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x54, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73,
+	0x67, 0x74, 0x6d, 0x2e, 0x4a, 0x6f, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x2d, 0xca,
+	0xb5, 0x03, 0x29, 0xa2, 0x01, 0x26, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x22, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x1b, 0xca, 0xb5, 0x03, 0x17, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74,
+	0x49, 0x44, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x70, 0x6f, 0x73,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x12, 0x4d, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61,
+	0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x42, 0x2d, 0xca, 0xb5, 0x03, 0x29, 0xa2, 0x01, 0x26,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f,
+	0x6a, 0x6f, 0x62, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72,
+	0x75, 0x6e, 0x5f, 0x61, 0x74, 0x22, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x41,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x1b, 0xca, 0xb5, 0x03, 0x17, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xec, 0x01, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x10,
+	0x00, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x79, 0x6e, 0x63, 0x53,
+	0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x02, 0x12,
+	0x12, 0x0a, 0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x50, 0x4d, 0x4b, 0x69, 0x6e,
+	0x64, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x04,
+	0x12, 0x19, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x55, 0x52, 0x4c, 0x4b, 0x69, 0x6e,
+	0x64, 0x10, 0x06, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6f, 0x75,
+	0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4b, 0x69,
+	0x6e, 0x64, 0x10, 0x07, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x50, 0x6f,
+	0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x4b, 0x69, 0x6e, 0x64, 0x10, 0x08, 0x22, 0x5d, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x10,
+	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x10, 0x04, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x20, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x12, 0x20, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x22, 0xb8, 0x05, 0x0a, 0x0c, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x75, 0x6e, 0x12, 0x2d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0x0a, 0x02, 0x49, 0x44, 0xa2, 0x01, 0x12, 0x67, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2,
+	0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x57, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x3e, 0xca, 0xb5, 0x03, 0x3a, 0x0a, 0x06, 0x50,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0xa2, 0x01, 0x2f, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x22, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12,
+	0x5b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x47, 0xca,
+	0xb5, 0x03, 0x43, 0xa2, 0x01, 0x40, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x69, 0x7a, 0x65,
+	0x3a, 0x36, 0x34, 0x3b, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x73, 0x67,
+	0x74, 0x6d, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x5f, 0x61,
+	0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x41, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12,
+	0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x74,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x41, 0x74, 0x22, 0x39, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c,
+	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x44, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12, 0x0f, 0x0a,
+	0x0b, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x10, 0x02, 0x22, 0x89,
+	0x04, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0x0a, 0x02,
+	0x49, 0x44, 0xa2, 0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75,
+	0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e,
+	0x6f, 0x22, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x61, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e,
+	0x61, 0x6e, 0x6f, 0x22, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x34,
+	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x1b, 0xca, 0xb5, 0x03, 0x17, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0xa2, 0x01, 0x0c,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04,
+	0x70, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x62, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x62, 0x70, 0x73, 0x12, 0x28, 0x0a,
+	0x08, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0xca, 0xb5, 0x03, 0x09, 0x0a, 0x07, 0x49, 0x50, 0x46, 0x53, 0x43, 0x49, 0x44, 0x52, 0x07,
+	0x69, 0x70, 0x66, 0x73, 0x43, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a,
+	0x0a, 0x08, 0x4d, 0x49, 0x4d, 0x45, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x69, 0x6c,
+	0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcd, 0x03, 0x0a, 0x08, 0x57,
+	0x61, 0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x2d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0x0a, 0x02, 0x49, 0x44, 0xa2, 0x01, 0x12,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65,
+	0x79, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d,
+	0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5,
+	0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d,
+	0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0xa2, 0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x2a, 0x0a, 0x11, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x70, 0x69, 0x78, 0x65, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x50, 0x65, 0x72, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x61, 0x6b, 0x73, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x65, 0x61, 0x6b, 0x73, 0x22, 0x85, 0x03, 0x0a, 0x0b, 0x46,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0x0a, 0x02, 0x49, 0x44,
+	0xa2, 0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca,
+	0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75,
+	0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e,
+	0x6f, 0x22, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x07,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca,
+	0xb5, 0x03, 0x1d, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0xa2, 0x01, 0x12, 0x67, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68,
+	0x65, 0x73, 0x22, 0xfc, 0x02, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0x0a, 0x02, 0x49, 0x44, 0xa2, 0x01, 0x12, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2,
+	0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5, 0x03,
+	0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1b, 0xca, 0xb5, 0x03, 0x17, 0x0a,
+	0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73,
+	0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x22, 0x62, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0xca,
+	0xb5, 0x03, 0x08, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2a, 0x3a, 0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x56, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x72, 0x61, 0x66, 0x74, 0x10,
+	0x02, 0x2a, 0x63, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x13, 0x0a,
+	0x0f, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64,
+	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x46, 0x53, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08,
+	0x42, 0x61, 0x6e, 0x64, 0x63, 0x61, 0x6d, 0x70, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x59, 0x6f,
+	0x75, 0x54, 0x75, 0x62, 0x65, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x59, 0x6f, 0x75, 0x74, 0x75,
+	0x62, 0x65, 0x44, 0x4c, 0x10, 0x05, 0x32, 0xcf, 0x16, 0x0a, 0x06, 0x57, 0x65, 0x62, 0x41, 0x50,
+	0x49, 0x12, 0x55, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e,
+	0x73, 0x67, 0x74, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73,
+	0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x51, 0x0a, 0x07, 0x50, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x73, 0x67, 0x74,
+	0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x74,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x47,
+	0x65, 0x74, 0x12, 0x60, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x67, 0x74,
+	0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a,
+	0x22, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x60, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73,
+	0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a,
+	0x01, 0x2a, 0x22, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x6f, 0x73, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x60, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x6f,
+	0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x61, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x6c, 0x0a, 0x0d, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x73,
+	0x67, 0x74, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x67, 0x74, 0x6d,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a,
+	0x01, 0x2a, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x6c, 0x0a, 0x0d, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x67, 0x74,
+	0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a,
+	0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x6c, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x79, 0x6e,
+	0x63, 0x12, 0x16, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x79, 0x6e,
+	0x63, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x67, 0x74, 0x6d,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x6f, 0x0a, 0x0e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c,
+	0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73,
+	0x67, 0x74, 0x6d, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0x75, 0x0a,
+	0x10, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1e, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x67,
+	0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x20,
+	0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68,
+	0x69, 0x70, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x68, 0x69, 0x70, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x68, 0x69, 0x70, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x79, 0x0a, 0x11, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12,
+	0x1f, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x68, 0x69, 0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x68, 0x69, 0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x65, 0x0a, 0x0c, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x41, 0x50, 0x49,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x70, 0x0a, 0x0e,
+	0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1c,
+	0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73,
+	0x67, 0x74, 0x6d, 0x2e, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x70,
+	0x0a, 0x0e, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x12, 0x1c, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x12, 0x69, 0x0a, 0x0d, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1b, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x74, 0x0a, 0x0f, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x1d,
+	0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x73, 0x67, 0x74, 0x6d, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x12, 0x89, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x73, 0x67,
+	0x74, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x90, 0x01,
+	0x0a, 0x16, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a,
+	0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x12, 0x90, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x24, 0x2e, 0x73, 0x67,
+	0x74, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
+	0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x12, 0x3d, 0x0a, 0x02, 0x4d, 0x65, 0x12, 0x10, 0x2e, 0x73, 0x67, 0x74, 0x6d,
+	0x2e, 0x4d, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x67,
+	0x74, 0x6d, 0x2e, 0x4d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x4d, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x73, 0x67, 0x74,
+	0x6d, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x4d, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x67, 0x74, 0x6d,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x19, 0x5a, 0x17, 0x6d, 0x6f, 0x75, 0x6c,
+	0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x67, 0x74, 0x6d, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x67, 0x74,
+	0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sgtm_proto_rawDescOnce sync.Once
+	file_sgtm_proto_rawDescData = file_sgtm_proto_rawDesc
+)
+
+func file_sgtm_proto_rawDescGZIP() []byte {
+	file_sgtm_proto_rawDescOnce.Do(func() {
+		file_sgtm_proto_rawDescData = protoimpl.X.CompressGZIP(file_sgtm_proto_rawDescData)
+	})
+	return file_sgtm_proto_rawDescData
+}
+
+var file_sgtm_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_sgtm_proto_msgTypes = make([]protoimpl.MessageInfo, 97)
+var file_sgtm_proto_goTypes = []interface{}{
+	(Visibility)(0),                         // 0: sgtm.Visibility
+	(Provider)(0),                           // 1: sgtm.Provider
+	(Status_State)(0),                       // 2: sgtm.Status.State
+	(Post_ProviderAvailability)(0),          // 3: sgtm.Post.ProviderAvailability
+	(Post_SoundCloudKind)(0),                // 4: sgtm.Post.SoundCloudKind
+	(Post_Kind)(0),                          // 5: sgtm.Post.Kind
+	(Relationship_Kind)(0),                  // 6: sgtm.Relationship.Kind
+	(Job_Kind)(0),                           // 7: sgtm.Job.Kind
+	(Job_State)(0),                          // 8: sgtm.Job.State
+	(MigrationRun_State)(0),                 // 9: sgtm.MigrationRun.State
+	(*Ping)(nil),                            // 10: sgtm.Ping
+	(*Status)(nil),                          // 11: sgtm.Status
+	(*Register)(nil),                        // 12: sgtm.Register
+	(*UserList)(nil),                        // 13: sgtm.UserList
+	(*PostList)(nil),                        // 14: sgtm.PostList
+	(*PostGet)(nil),                         // 15: sgtm.PostGet
+	(*PostCreate)(nil),                      // 16: sgtm.PostCreate
+	(*PostUpdate)(nil),                      // 17: sgtm.PostUpdate
+	(*PostDelete)(nil),                      // 18: sgtm.PostDelete
+	(*PostSync)(nil),                        // 19: sgtm.PostSync
+	(*CommentList)(nil),                     // 20: sgtm.CommentList
+	(*CommentCreate)(nil),                   // 21: sgtm.CommentCreate
+	(*CommentUpdate)(nil),                   // 22: sgtm.CommentUpdate
+	(*CommentDelete)(nil),                   // 23: sgtm.CommentDelete
+	(*ActivityStream)(nil),                  // 24: sgtm.ActivityStream
+	(*RelationshipList)(nil),                // 25: sgtm.RelationshipList
+	(*RelationshipCreate)(nil),              // 26: sgtm.RelationshipCreate
+	(*RelationshipDelete)(nil),              // 27: sgtm.RelationshipDelete
+	(*RelationshipGraph)(nil),               // 28: sgtm.RelationshipGraph
+	(*APITokenList)(nil),                    // 29: sgtm.APITokenList
+	(*APITokenCreate)(nil),                  // 30: sgtm.APITokenCreate
+	(*APITokenRevoke)(nil),                  // 31: sgtm.APITokenRevoke
+	(*MigrationList)(nil),                   // 32: sgtm.MigrationList
+	(*MigrationReplay)(nil),                 // 33: sgtm.MigrationReplay
+	(*ProcessingFailureList)(nil),           // 34: sgtm.ProcessingFailureList
+	(*ProcessingFailureRetry)(nil),          // 35: sgtm.ProcessingFailureRetry
+	(*ProcessingFailureClear)(nil),          // 36: sgtm.ProcessingFailureClear
+	(*Me)(nil),                              // 37: sgtm.Me
+	(*User)(nil),                            // 38: sgtm.User
+	(*Post)(nil),                            // 39: sgtm.Post
+	(*Relationship)(nil),                    // 40: sgtm.Relationship
+	(*APIToken)(nil),                        // 41: sgtm.APIToken
+	(*Job)(nil),                             // 42: sgtm.Job
+	(*ProcessingFailure)(nil),               // 43: sgtm.ProcessingFailure
+	(*MigrationRun)(nil),                    // 44: sgtm.MigrationRun
+	(*Rendition)(nil),                       // 45: sgtm.Rendition
+	(*Waveform)(nil),                        // 46: sgtm.Waveform
+	(*Fingerprint)(nil),                     // 47: sgtm.Fingerprint
+	(*FingerprintHash)(nil),                 // 48: sgtm.FingerprintHash
+	(*Session)(nil),                         // 49: sgtm.Session
+	(*Ping_Request)(nil),                    // 50: sgtm.Ping.Request
+	(*Ping_Response)(nil),                   // 51: sgtm.Ping.Response
+	(*Status_Request)(nil),                  // 52: sgtm.Status.Request
+	(*Status_Response)(nil),                 // 53: sgtm.Status.Response
+	(*Status_Component)(nil),                // 54: sgtm.Status.Component
+	(*Register_Request)(nil),                // 55: sgtm.Register.Request
+	(*Register_Response)(nil),               // 56: sgtm.Register.Response
+	(*UserList_Request)(nil),                // 57: sgtm.UserList.Request
+	(*UserList_Response)(nil),               // 58: sgtm.UserList.Response
+	(*PostList_Request)(nil),                // 59: sgtm.PostList.Request
+	(*PostList_Response)(nil),               // 60: sgtm.PostList.Response
+	(*PostGet_Request)(nil),                 // 61: sgtm.PostGet.Request
+	(*PostGet_Response)(nil),                // 62: sgtm.PostGet.Response
+	(*PostCreate_Request)(nil),              // 63: sgtm.PostCreate.Request
+	(*PostCreate_Response)(nil),             // 64: sgtm.PostCreate.Response
+	(*PostUpdate_Request)(nil),              // 65: sgtm.PostUpdate.Request
+	(*PostUpdate_Response)(nil),             // 66: sgtm.PostUpdate.Response
+	(*PostDelete_Request)(nil),              // 67: sgtm.PostDelete.Request
+	(*PostDelete_Response)(nil),             // 68: sgtm.PostDelete.Response
+	(*PostSync_Request)(nil),                // 69: sgtm.PostSync.Request
+	(*PostSync_Response)(nil),               // 70: sgtm.PostSync.Response
+	(*CommentList_Request)(nil),             // 71: sgtm.CommentList.Request
+	(*CommentList_Response)(nil),            // 72: sgtm.CommentList.Response
+	(*CommentCreate_Request)(nil),           // 73: sgtm.CommentCreate.Request
+	(*CommentCreate_Response)(nil),          // 74: sgtm.CommentCreate.Response
+	(*CommentUpdate_Request)(nil),           // 75: sgtm.CommentUpdate.Request
+	(*CommentUpdate_Response)(nil),          // 76: sgtm.CommentUpdate.Response
+	(*CommentDelete_Request)(nil),           // 77: sgtm.CommentDelete.Request
+	(*CommentDelete_Response)(nil),          // 78: sgtm.CommentDelete.Response
+	(*ActivityStream_Request)(nil),          // 79: sgtm.ActivityStream.Request
+	(*ActivityStream_Response)(nil),         // 80: sgtm.ActivityStream.Response
+	(*RelationshipList_Request)(nil),        // 81: sgtm.RelationshipList.Request
+	(*RelationshipList_Response)(nil),       // 82: sgtm.RelationshipList.Response
+	(*RelationshipCreate_Request)(nil),      // 83: sgtm.RelationshipCreate.Request
+	(*RelationshipCreate_Response)(nil),     // 84: sgtm.RelationshipCreate.Response
+	(*RelationshipDelete_Request)(nil),      // 85: sgtm.RelationshipDelete.Request
+	(*RelationshipDelete_Response)(nil),     // 86: sgtm.RelationshipDelete.Response
+	(*RelationshipGraph_Request)(nil),       // 87: sgtm.RelationshipGraph.Request
+	(*RelationshipGraph_Response)(nil),      // 88: sgtm.RelationshipGraph.Response
+	(*APITokenList_Request)(nil),            // 89: sgtm.APITokenList.Request
+	(*APITokenList_Response)(nil),           // 90: sgtm.APITokenList.Response
+	(*APITokenCreate_Request)(nil),          // 91: sgtm.APITokenCreate.Request
+	(*APITokenCreate_Response)(nil),         // 92: sgtm.APITokenCreate.Response
+	(*APITokenRevoke_Request)(nil),          // 93: sgtm.APITokenRevoke.Request
+	(*APITokenRevoke_Response)(nil),         // 94: sgtm.APITokenRevoke.Response
+	(*MigrationList_Request)(nil),           // 95: sgtm.MigrationList.Request
+	(*MigrationList_Response)(nil),          // 96: sgtm.MigrationList.Response
+	(*MigrationReplay_Request)(nil),         // 97: sgtm.MigrationReplay.Request
+	(*MigrationReplay_Response)(nil),        // 98: sgtm.MigrationReplay.Response
+	(*ProcessingFailureList_Request)(nil),   // 99: sgtm.ProcessingFailureList.Request
+	(*ProcessingFailureList_Response)(nil),  // 100: sgtm.ProcessingFailureList.Response
+	(*ProcessingFailureRetry_Request)(nil),  // 101: sgtm.ProcessingFailureRetry.Request
+	(*ProcessingFailureRetry_Response)(nil), // 102: sgtm.ProcessingFailureRetry.Response
+	(*ProcessingFailureClear_Request)(nil),  // 103: sgtm.ProcessingFailureClear.Request
+	(*ProcessingFailureClear_Response)(nil), // 104: sgtm.ProcessingFailureClear.Response
+	(*Me_Request)(nil),                      // 105: sgtm.Me.Request
+	(*Me_Response)(nil),                     // 106: sgtm.Me.Response
+	(*fieldmaskpb.FieldMask)(nil),           // 107: google.protobuf.FieldMask
+}
+var file_sgtm_proto_depIdxs = []int32{
+	39,  // 0: sgtm.User.recent_posts:type_name -> sgtm.Post
+	40,  // 1: sgtm.User.relationships_as_source:type_name -> sgtm.Relationship
+	40,  // 2: sgtm.User.relationships_as_target:type_name -> sgtm.Relationship
+	38,  // 3: sgtm.Post.author:type_name -> sgtm.User
+	5,   // 4: sgtm.Post.kind:type_name -> sgtm.Post.Kind
+	0,   // 5: sgtm.Post.visibility:type_name -> sgtm.Visibility
+	1,   // 6: sgtm.Post.provider:type_name -> sgtm.Provider
+	39,  // 7: sgtm.Post.thread_post:type_name -> sgtm.Post
+	39,  // 8: sgtm.Post.reply_to:type_name -> sgtm.Post
+	39,  // 9: sgtm.Post.replies:type_name -> sgtm.Post
+	3,   // 10: sgtm.Post.provider_availability:type_name -> sgtm.Post.ProviderAvailability
+	39,  // 11: sgtm.Post.album:type_name -> sgtm.Post
+	39,  // 12: sgtm.Post.album_tracks:type_name -> sgtm.Post
+	4,   // 13: sgtm.Post.soundcloud_kind:type_name -> sgtm.Post.SoundCloudKind
+	45,  // 14: sgtm.Post.renditions:type_name -> sgtm.Rendition
+	38,  // 15: sgtm.Post.target_user:type_name -> sgtm.User
+	39,  // 16: sgtm.Post.target_post:type_name -> sgtm.Post
+	40,  // 17: sgtm.Post.relationships_as_source:type_name -> sgtm.Relationship
+	40,  // 18: sgtm.Post.relationships_as_target:type_name -> sgtm.Relationship
+	6,   // 19: sgtm.Relationship.kind:type_name -> sgtm.Relationship.Kind
+	39,  // 20: sgtm.Relationship.source_post:type_name -> sgtm.Post
+	39,  // 21: sgtm.Relationship.target_post:type_name -> sgtm.Post
+	38,  // 22: sgtm.Relationship.source_user:type_name -> sgtm.User
+	38,  // 23: sgtm.Relationship.target_user:type_name -> sgtm.User
+	38,  // 24: sgtm.APIToken.user:type_name -> sgtm.User
+	7,   // 25: sgtm.Job.kind:type_name -> sgtm.Job.Kind
+	8,   // 26: sgtm.Job.state:type_name -> sgtm.Job.State
+	39,  // 27: sgtm.Job.post:type_name -> sgtm.Post
+	38,  // 28: sgtm.Job.user:type_name -> sgtm.User
+	39,  // 29: sgtm.ProcessingFailure.posts:type_name -> sgtm.Post
+	38,  // 30: sgtm.ProcessingFailure.users:type_name -> sgtm.User
+	39,  // 31: sgtm.MigrationRun.post:type_name -> sgtm.Post
+	9,   // 32: sgtm.MigrationRun.state:type_name -> sgtm.MigrationRun.State
+	39,  // 33: sgtm.Rendition.post:type_name -> sgtm.Post
+	39,  // 34: sgtm.Waveform.post:type_name -> sgtm.Post
+	39,  // 35: sgtm.Fingerprint.post:type_name -> sgtm.Post
+	39,  // 36: sgtm.FingerprintHash.post:type_name -> sgtm.Post
+	54,  // 37: sgtm.Status.Response.components:type_name -> sgtm.Status.Component
+	2,   // 38: sgtm.Status.Component.state:type_name -> sgtm.Status.State
+	38,  // 39: sgtm.Register.Response.user:type_name -> sgtm.User
+	38,  // 40: sgtm.UserList.Response.users:type_name -> sgtm.User
+	1,   // 41: sgtm.PostList.Request.provider:type_name -> sgtm.Provider
+	39,  // 42: sgtm.PostList.Response.posts:type_name -> sgtm.Post
+	39,  // 43: sgtm.PostGet.Response.post:type_name -> sgtm.Post
+	39,  // 44: sgtm.PostCreate.Response.post:type_name -> sgtm.Post
+	39,  // 45: sgtm.PostUpdate.Request.post:type_name -> sgtm.Post
+	107, // 46: sgtm.PostUpdate.Request.update_mask:type_name -> google.protobuf.FieldMask
+	39,  // 47: sgtm.PostUpdate.Response.post:type_name -> sgtm.Post
+	39,  // 48: sgtm.PostSync.Response.post:type_name -> sgtm.Post
+	39,  // 49: sgtm.CommentList.Response.comments:type_name -> sgtm.Post
+	39,  // 50: sgtm.CommentCreate.Response.comment:type_name -> sgtm.Post
+	39,  // 51: sgtm.CommentUpdate.Response.comment:type_name -> sgtm.Post
+	5,   // 52: sgtm.ActivityStream.Request.kinds:type_name -> sgtm.Post.Kind
+	39,  // 53: sgtm.ActivityStream.Response.activity:type_name -> sgtm.Post
+	6,   // 54: sgtm.RelationshipList.Request.kinds:type_name -> sgtm.Relationship.Kind
+	40,  // 55: sgtm.RelationshipList.Response.relationships:type_name -> sgtm.Relationship
+	6,   // 56: sgtm.RelationshipCreate.Request.kind:type_name -> sgtm.Relationship.Kind
+	40,  // 57: sgtm.RelationshipCreate.Response.relationship:type_name -> sgtm.Relationship
+	39,  // 58: sgtm.RelationshipGraph.Response.posts:type_name -> sgtm.Post
+	38,  // 59: sgtm.RelationshipGraph.Response.users:type_name -> sgtm.User
+	40,  // 60: sgtm.RelationshipGraph.Response.relationships:type_name -> sgtm.Relationship
+	41,  // 61: sgtm.APITokenList.Response.tokens:type_name -> sgtm.APIToken
+	41,  // 62: sgtm.APITokenCreate.Response.token:type_name -> sgtm.APIToken
+	44,  // 63: sgtm.MigrationList.Response.runs:type_name -> sgtm.MigrationRun
+	43,  // 64: sgtm.ProcessingFailureList.Response.failures:type_name -> sgtm.ProcessingFailure
+	38,  // 65: sgtm.Me.Response.user:type_name -> sgtm.User
+	57,  // 66: sgtm.WebAPI.UserList:input_type -> sgtm.UserList.Request
+	59,  // 67: sgtm.WebAPI.PostList:input_type -> sgtm.PostList.Request
+	61,  // 68: sgtm.WebAPI.PostGet:input_type -> sgtm.PostGet.Request
+	63,  // 69: sgtm.WebAPI.PostCreate:input_type -> sgtm.PostCreate.Request
+	65,  // 70: sgtm.WebAPI.PostUpdate:input_type -> sgtm.PostUpdate.Request
+	67,  // 71: sgtm.WebAPI.PostDelete:input_type -> sgtm.PostDelete.Request
+	71,  // 72: sgtm.WebAPI.CommentList:input_type -> sgtm.CommentList.Request
+	73,  // 73: sgtm.WebAPI.CommentCreate:input_type -> sgtm.CommentCreate.Request
+	75,  // 74: sgtm.WebAPI.CommentUpdate:input_type -> sgtm.CommentUpdate.Request
+	77,  // 75: sgtm.WebAPI.CommentDelete:input_type -> sgtm.CommentDelete.Request
+	69,  // 76: sgtm.WebAPI.PostSync:input_type -> sgtm.PostSync.Request
+	79,  // 77: sgtm.WebAPI.ActivityStream:input_type -> sgtm.ActivityStream.Request
+	81,  // 78: sgtm.WebAPI.RelationshipList:input_type -> sgtm.RelationshipList.Request
+	83,  // 79: sgtm.WebAPI.RelationshipCreate:input_type -> sgtm.RelationshipCreate.Request
+	85,  // 80: sgtm.WebAPI.RelationshipDelete:input_type -> sgtm.RelationshipDelete.Request
+	87,  // 81: sgtm.WebAPI.RelationshipGraph:input_type -> sgtm.RelationshipGraph.Request
+	89,  // 82: sgtm.WebAPI.APITokenList:input_type -> sgtm.APITokenList.Request
+	91,  // 83: sgtm.WebAPI.APITokenCreate:input_type -> sgtm.APITokenCreate.Request
+	93,  // 84: sgtm.WebAPI.APITokenRevoke:input_type -> sgtm.APITokenRevoke.Request
+	95,  // 85: sgtm.WebAPI.MigrationList:input_type -> sgtm.MigrationList.Request
+	97,  // 86: sgtm.WebAPI.MigrationReplay:input_type -> sgtm.MigrationReplay.Request
+	99,  // 87: sgtm.WebAPI.ProcessingFailureList:input_type -> sgtm.ProcessingFailureList.Request
+	101, // 88: sgtm.WebAPI.ProcessingFailureRetry:input_type -> sgtm.ProcessingFailureRetry.Request
+	103, // 89: sgtm.WebAPI.ProcessingFailureClear:input_type -> sgtm.ProcessingFailureClear.Request
+	105, // 90: sgtm.WebAPI.Me:input_type -> sgtm.Me.Request
+	50,  // 91: sgtm.WebAPI.Ping:input_type -> sgtm.Ping.Request
+	52,  // 92: sgtm.WebAPI.Status:input_type -> sgtm.Status.Request
+	58,  // 93: sgtm.WebAPI.UserList:output_type -> sgtm.UserList.Response
+	60,  // 94: sgtm.WebAPI.PostList:output_type -> sgtm.PostList.Response
+	62,  // 95: sgtm.WebAPI.PostGet:output_type -> sgtm.PostGet.Response
+	64,  // 96: sgtm.WebAPI.PostCreate:output_type -> sgtm.PostCreate.Response
+	66,  // 97: sgtm.WebAPI.PostUpdate:output_type -> sgtm.PostUpdate.Response
+	68,  // 98: sgtm.WebAPI.PostDelete:output_type -> sgtm.PostDelete.Response
+	72,  // 99: sgtm.WebAPI.CommentList:output_type -> sgtm.CommentList.Response
+	74,  // 100: sgtm.WebAPI.CommentCreate:output_type -> sgtm.CommentCreate.Response
+	76,  // 101: sgtm.WebAPI.CommentUpdate:output_type -> sgtm.CommentUpdate.Response
+	78,  // 102: sgtm.WebAPI.CommentDelete:output_type -> sgtm.CommentDelete.Response
+	70,  // 103: sgtm.WebAPI.PostSync:output_type -> sgtm.PostSync.Response
+	80,  // 104: sgtm.WebAPI.ActivityStream:output_type -> sgtm.ActivityStream.Response
+	82,  // 105: sgtm.WebAPI.RelationshipList:output_type -> sgtm.RelationshipList.Response
+	84,  // 106: sgtm.WebAPI.RelationshipCreate:output_type -> sgtm.RelationshipCreate.Response
+	86,  // 107: sgtm.WebAPI.RelationshipDelete:output_type -> sgtm.RelationshipDelete.Response
+	88,  // 108: sgtm.WebAPI.RelationshipGraph:output_type -> sgtm.RelationshipGraph.Response
+	90,  // 109: sgtm.WebAPI.APITokenList:output_type -> sgtm.APITokenList.Response
+	92,  // 110: sgtm.WebAPI.APITokenCreate:output_type -> sgtm.APITokenCreate.Response
+	94,  // 111: sgtm.WebAPI.APITokenRevoke:output_type -> sgtm.APITokenRevoke.Response
+	96,  // 112: sgtm.WebAPI.MigrationList:output_type -> sgtm.MigrationList.Response
+	98,  // 113: sgtm.WebAPI.MigrationReplay:output_type -> sgtm.MigrationReplay.Response
+	100, // 114: sgtm.WebAPI.ProcessingFailureList:output_type -> sgtm.ProcessingFailureList.Response
+	102, // 115: sgtm.WebAPI.ProcessingFailureRetry:output_type -> sgtm.ProcessingFailureRetry.Response
+	104, // 116: sgtm.WebAPI.ProcessingFailureClear:output_type -> sgtm.ProcessingFailureClear.Response
+	106, // 117: sgtm.WebAPI.Me:output_type -> sgtm.Me.Response
+	51,  // 118: sgtm.WebAPI.Ping:output_type -> sgtm.Ping.Response
+	53,  // 119: sgtm.WebAPI.Status:output_type -> sgtm.Status.Response
+	93,  // [93:120] is the sub-list for method output_type
+	66,  // [66:93] is the sub-list for method input_type
+	66,  // [66:66] is the sub-list for extension type_name
+	66,  // [66:66] is the sub-list for extension extendee
+	0,   // [0:66] is the sub-list for field type_name
+}
+
+func init() { file_sgtm_proto_init() }
+func file_sgtm_proto_init() {
+	if File_sgtm_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sgtm_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Register); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostCreate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostDelete); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostSync); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentCreate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentDelete); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationshipList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationshipCreate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationshipDelete); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationshipGraph); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APITokenList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APITokenCreate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APITokenRevoke); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrationList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrationReplay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessingFailureList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessingFailureRetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessingFailureClear); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Me); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Post); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Relationship); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessingFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrationRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rendition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Waveform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fingerprint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FingerprintHash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Session); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ping_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ping_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status_Component); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Register_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Register_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserList_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserList_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostList_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostList_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostGet_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostGet_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostCreate_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostCreate_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostUpdate_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostUpdate_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostDelete_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostDelete_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostSync_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostSync_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentList_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentList_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentCreate_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentCreate_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentUpdate_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentUpdate_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentDelete_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentDelete_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Me_Request.ProtoReflect.Descriptor instead.
-func (*Me_Request) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{6, 0}
-}
-
-type Me_Response struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-}
-
-func (x *Me_Response) Reset() {
-	*x = Me_Response{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sgtm_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Me_Response) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Me_Response) ProtoMessage() {}
-
-func (x *Me_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_sgtm_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_sgtm_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityStream_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Me_Response.ProtoReflect.Descriptor instead.
-func (*Me_Response) Descriptor() ([]byte, []int) {
-	return file_sgtm_proto_rawDescGZIP(), []int{6, 1}
-}
-
-func (x *Me_Response) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
-}
-
-var File_sgtm_proto protoreflect.FileDescriptor
-
-var file_sgtm_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x73, 0x67,
-	0x74, 0x6d, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x1d, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x7d, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x68, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x76, 0x65, 0x72, 0x79, 0x74, 0x68, 0x69,
-	0x6e, 0x67, 0x5f, 0x69, 0x73, 0x5f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
-	0x65, 0x76, 0x65, 0x72, 0x79, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x49, 0x73, 0x4f, 0x6b, 0x22, 0xa5,
-	0x01, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x1a, 0x6d, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x6c, 0x75, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
-	0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x2a, 0x0a, 0x08, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x0a,
-	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x43, 0x0a, 0x08, 0x50,
-	0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
-	0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
-	0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73,
-	0x22, 0x3b, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x1a, 0x23, 0x0a, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69,
-	0x64, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a,
-	0x02, 0x4d, 0x65, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
-	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xa1, 0x0c, 0x0a, 0x04, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x1d, 0xca, 0xb5, 0x03, 0x19, 0x0a, 0x02, 0x49, 0x44, 0xa2, 0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d,
-	0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67,
-	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x74,
-	0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01,
-	0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xca, 0xb5, 0x03, 0x29, 0xa2, 0x01, 0x26, 0x67, 0x6f, 0x72,
-	0x6d, 0x3a, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x32, 0x35, 0x35, 0x3b, 0x6e, 0x6f, 0x74, 0x20,
-	0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x2c, 0x75, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x22, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x3d, 0x0a, 0x04, 0x73, 0x6c,
-	0x75, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xca, 0xb5, 0x03, 0x25, 0xa2, 0x01,
-	0x22, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x33, 0x32, 0x3b, 0x6e,
-	0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a,
-	0x27, 0x27, 0x22, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x48, 0x0a, 0x09, 0x66, 0x69, 0x72,
-	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xca, 0xb5,
-	0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x3a,
-	0x32, 0x35, 0x35, 0x3b, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x3a, 0x27, 0x27, 0x22, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f,
-	0x72, 0x6d, 0x3a, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x32, 0x35, 0x35, 0x3b, 0x6e, 0x6f, 0x74,
-	0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x27, 0x27,
-	0x22, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xca, 0xb5, 0x03,
-	0x25, 0xa2, 0x01, 0x22, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x33,
-	0x32, 0x3b, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x3a, 0x27, 0x27, 0x22, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x42,
-	0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a,
-	0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x69, 0x7a,
-	0x65, 0x3a, 0x32, 0x35, 0x35, 0x3b, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x27, 0x27, 0x22, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74,
-	0x61, 0x72, 0x12, 0x54, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0xca, 0xb5, 0x03, 0x31, 0x0a, 0x09, 0x44, 0x69,
-	0x73, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
-	0x73, 0x69, 0x7a, 0x65, 0x3a, 0x32, 0x35, 0x35, 0x3b, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c,
-	0x6c, 0x3b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x27, 0x27, 0x22, 0x52, 0x09, 0x64,
-	0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x55, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x63,
-	0x6f, 0x72, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
-	0x22, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x32, 0x35, 0x35, 0x3b, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75,
-	0x6c, 0x6c, 0x3b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x27, 0x27, 0x22, 0x52, 0x0f,
-	0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62,
-	0x69, 0x6f, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x22, 0x0a,
-	0x0c, 0x69, 0x6e, 0x73, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x65, 0x61, 0x72, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x67, 0x65, 0x61, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65,
-	0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x74,
-	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x55, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x6d, 0x65, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x6d, 0x65, 0x70, 0x61,
-	0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x6b,
-	0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x4c, 0x69,
-	0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x18, 0x1b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x6f, 0x75,
-	0x6e, 0x64, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x63, 0x6c, 0x6f,
-	0x75, 0x64, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
-	0x6c, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x2d,
-	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
-	0x10, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x5e, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65,
-	0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x32, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
-	0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b,
-	0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
-	0x6b, 0x65, 0x79, 0x3a, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x3b, 0x50, 0x52, 0x45,
-	0x4c, 0x4f, 0x41, 0x44, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x22, 0x52, 0x0b, 0x72, 0x65, 0x63,
-	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x71, 0x0a, 0x17, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x5f, 0x61, 0x73, 0x5f, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x18, 0x33, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x67, 0x74, 0x6d,
-	0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x42, 0x25, 0xca,
-	0xb5, 0x03, 0x21, 0xa2, 0x01, 0x1e, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65,
-	0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x22, 0x52, 0x15, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68,
-	0x69, 0x70, 0x73, 0x41, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x71, 0x0a, 0x17, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x5f, 0x61, 0x73, 0x5f,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x34, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
-	0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
-	0x42, 0x25, 0xca, 0xb5, 0x03, 0x21, 0xa2, 0x01, 0x1e, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66,
-	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x52, 0x15, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x41, 0x73, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0xba,
-	0x12, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0x0a, 0x02, 0x49, 0x44, 0xa2, 0x01, 0x12,
-	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65,
-	0x79, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d,
-	0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x21, 0xca, 0xb5,
-	0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e, 0x6f, 0x22, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x2b, 0x0a,
-	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
-	0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44,
-	0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x73, 0x6c, 0x75, 0x67, 0x12, 0x23, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x2e, 0x4b,
-	0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x0a, 0x76, 0x69, 0x73,
-	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
-	0x73, 0x67, 0x74, 0x6d, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
-	0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x03, 0x75,
-	0x72, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03,
-	0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x73, 0x67, 0x74,
-	0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x12, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74,
-	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x6f, 0x72,
-	0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69,
-	0x6e, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x18, 0x0a, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02,
-	0x18, 0x01, 0x52, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x29, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x0b, 0x61, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xca, 0xb5, 0x03, 0x0c,
-	0x0a, 0x0a, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x55, 0x52, 0x4c, 0x52, 0x0a, 0x61, 0x72,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x03, 0x62, 0x70, 0x6d, 0x18,
-	0x2b, 0x20, 0x01, 0x28, 0x01, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x42, 0x50, 0x4d,
-	0x52, 0x03, 0x62, 0x70, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6b, 0x65,
-	0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x69, 0x73,
-	0x72, 0x63, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xb5, 0x03, 0x06, 0x0a, 0x04,
-	0x49, 0x53, 0x52, 0x43, 0x52, 0x04, 0x69, 0x73, 0x72, 0x63, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x35, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x13, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x2f, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x30, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x31, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x18, 0x33, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x6c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x79,
-	0x72, 0x69, 0x63, 0x73, 0x12, 0x53, 0x0a, 0x17, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x63, 0x6c, 0x6f,
-	0x75, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x50, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xca, 0xb5, 0x03, 0x17, 0x0a, 0x15, 0x53, 0x6f, 0x75,
-	0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x15, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x37, 0x0a, 0x0d, 0x73, 0x6f, 0x75,
-	0x6e, 0x64, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x51, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x6f,
-	0x75, 0x64, 0x49, 0x44, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x49, 0x64, 0x12, 0x58, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x53, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x67,
-	0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x6f,
-	0x75, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x42, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x53, 0x6f,
-	0x75, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0e, 0x73, 0x6f,
-	0x75, 0x6e, 0x64, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x08,
-	0x69, 0x70, 0x66, 0x73, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
-	0xca, 0xb5, 0x03, 0x09, 0x0a, 0x07, 0x49, 0x50, 0x46, 0x53, 0x43, 0x49, 0x44, 0x52, 0x07, 0x69,
-	0x70, 0x66, 0x73, 0x43, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a,
-	0x08, 0x4d, 0x49, 0x4d, 0x45, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x18, 0x5c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x5d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x65,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x5e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x0e, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x65, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x38, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x67, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a,
-	0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x0c, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0b, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0a, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x69, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x71, 0x0a, 0x17, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
-	0x70, 0x73, 0x5f, 0x61, 0x73, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x6e, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x42, 0x25, 0xca, 0xb5, 0x03, 0x21, 0xa2, 0x01, 0x1e, 0x67,
-	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x52, 0x15, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x41, 0x73, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x71, 0x0a, 0x17, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x68, 0x69, 0x70, 0x73, 0x5f, 0x61, 0x73, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
-	0x6f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x42, 0x25, 0xca, 0xb5, 0x03, 0x21, 0xa2,
-	0x01, 0x1e, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b,
-	0x65, 0x79, 0x3a, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22,
-	0x52, 0x15, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x41,
-	0x73, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x40, 0x0a, 0x0e, 0x53, 0x6f, 0x75, 0x6e, 0x64,
-	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x6e, 0x6b,
-	0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4b, 0x69,
-	0x6e, 0x64, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x6f,
-	0x75, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x10, 0x01, 0x22, 0xbf, 0x01, 0x0a, 0x04, 0x4b, 0x69,
-	0x6e, 0x64, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4b, 0x69, 0x6e,
-	0x64, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4b, 0x69, 0x6e, 0x64,
-	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x10,
-	0x02, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x69, 0x6e,
-	0x64, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x69, 0x73, 0x63, 0x6f,
-	0x72, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x04, 0x12,
-	0x13, 0x0a, 0x0f, 0x56, 0x69, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4b, 0x69,
-	0x6e, 0x64, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x69, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74,
-	0x4b, 0x69, 0x6e, 0x64, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x69, 0x65, 0x77, 0x4f, 0x70,
-	0x65, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x69, 0x65, 0x77,
-	0x48, 0x6f, 0x6d, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x09, 0x22, 0x93, 0x07, 0x0a, 0x0c,
-	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x2d, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0x0a, 0x02,
-	0x49, 0x44, 0xa2, 0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61,
-	0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x61, 0x75,
-	0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e, 0x61, 0x6e,
-	0x6f, 0x22, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a,
-	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
-	0x61, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x6e,
-	0x61, 0x6e, 0x6f, 0x22, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b,
-	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73,
-	0x67, 0x74, 0x6d, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
-	0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x0e, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x70, 0x6f, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74,
-	0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x12, 0x38, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e,
-	0x0a, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x0c,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0b,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0a, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0e, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x38, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c,
-	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x0c, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0a, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x5f, 0x72, 0x61, 0x77, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x61, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x5f, 0x72, 0x61, 0x77, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x52, 0x61, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x8d, 0x01, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x6e,
-	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x46,
-	0x65, 0x61, 0x74, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64,
-	0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x69, 0x78, 0x4f, 0x66, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x65, 0x77, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4b, 0x69, 0x6e,
-	0x64, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42,
-	0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f,
-	0x52, 0x65, 0x6d, 0x69, 0x78, 0x4f, 0x66, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x10,
-	0x05, 0x22, 0x62, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0xca,
-	0xb5, 0x03, 0x08, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x12, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2a, 0x3a, 0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x56, 0x69,
-	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x72, 0x61, 0x66, 0x74, 0x10,
-	0x02, 0x2a, 0x39, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x13, 0x0a,
-	0x0f, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64,
-	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x46, 0x53, 0x10, 0x02, 0x32, 0x8b, 0x03, 0x0a,
-	0x06, 0x57, 0x65, 0x62, 0x41, 0x50, 0x49, 0x12, 0x55, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x67,
-	0x74, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x55,
-	0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x67, 0x74,
-	0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x6f, 0x73,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x02, 0x4d, 0x65, 0x12, 0x10, 0x2e, 0x73, 0x67,
-	0x74, 0x6d, 0x2e, 0x4d, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
-	0x73, 0x67, 0x74, 0x6d, 0x2e, 0x4d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x4d, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x73,
-	0x67, 0x74, 0x6d, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x13, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x4d, 0x0a, 0x06, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x67, 0x74, 0x6d, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x67,
-	0x74, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x19, 0x5a, 0x17, 0x6d, 0x6f,
-	0x75, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x67, 0x74, 0x6d, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x73,
-	0x67, 0x74, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_sgtm_proto_rawDescOnce sync.Once
-	file_sgtm_proto_rawDescData = file_sgtm_proto_rawDesc
-)
-
-func file_sgtm_proto_rawDescGZIP() []byte {
-	file_sgtm_proto_rawDescOnce.Do(func() {
-		file_sgtm_proto_rawDescData = protoimpl.X.CompressGZIP(file_sgtm_proto_rawDescData)
-	})
-	return file_sgtm_proto_rawDescData
-}
-
-var file_sgtm_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_sgtm_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
-var file_sgtm_proto_goTypes = []interface{}{
-	(Visibility)(0),           // 0: sgtm.Visibility
-	(Provider)(0),             // 1: sgtm.Provider
-	(Post_SoundCloudKind)(0),  // 2: sgtm.Post.SoundCloudKind
-	(Post_Kind)(0),            // 3: sgtm.Post.Kind
-	(Relationship_Kind)(0),    // 4: sgtm.Relationship.Kind
-	(*Ping)(nil),              // 5: sgtm.Ping
-	(*Status)(nil),            // 6: sgtm.Status
-	(*Register)(nil),          // 7: sgtm.Register
-	(*UserList)(nil),          // 8: sgtm.UserList
-	(*PostList)(nil),          // 9: sgtm.PostList
-	(*PostSync)(nil),          // 10: sgtm.PostSync
-	(*Me)(nil),                // 11: sgtm.Me
-	(*User)(nil),              // 12: sgtm.User
-	(*Post)(nil),              // 13: sgtm.Post
-	(*Relationship)(nil),      // 14: sgtm.Relationship
-	(*Session)(nil),           // 15: sgtm.Session
-	(*Ping_Request)(nil),      // 16: sgtm.Ping.Request
-	(*Ping_Response)(nil),     // 17: sgtm.Ping.Response
-	(*Status_Request)(nil),    // 18: sgtm.Status.Request
-	(*Status_Response)(nil),   // 19: sgtm.Status.Response
-	(*Register_Request)(nil),  // 20: sgtm.Register.Request
-	(*Register_Response)(nil), // 21: sgtm.Register.Response
-	(*UserList_Request)(nil),  // 22: sgtm.UserList.Request
-	(*UserList_Response)(nil), // 23: sgtm.UserList.Response
-	(*PostList_Request)(nil),  // 24: sgtm.PostList.Request
-	(*PostList_Response)(nil), // 25: sgtm.PostList.Response
-	(*PostSync_Request)(nil),  // 26: sgtm.PostSync.Request
-	(*PostSync_Response)(nil), // 27: sgtm.PostSync.Response
-	(*Me_Request)(nil),        // 28: sgtm.Me.Request
-	(*Me_Response)(nil),       // 29: sgtm.Me.Response
-}
-var file_sgtm_proto_depIdxs = []int32{
-	13, // 0: sgtm.User.recent_posts:type_name -> sgtm.Post
-	14, // 1: sgtm.User.relationships_as_source:type_name -> sgtm.Relationship
-	14, // 2: sgtm.User.relationships_as_target:type_name -> sgtm.Relationship
-	12, // 3: sgtm.Post.author:type_name -> sgtm.User
-	3,  // 4: sgtm.Post.kind:type_name -> sgtm.Post.Kind
-	0,  // 5: sgtm.Post.visibility:type_name -> sgtm.Visibility
-	1,  // 6: sgtm.Post.provider:type_name -> sgtm.Provider
-	2,  // 7: sgtm.Post.soundcloud_kind:type_name -> sgtm.Post.SoundCloudKind
-	12, // 8: sgtm.Post.target_user:type_name -> sgtm.User
-	13, // 9: sgtm.Post.target_post:type_name -> sgtm.Post
-	14, // 10: sgtm.Post.relationships_as_source:type_name -> sgtm.Relationship
-	14, // 11: sgtm.Post.relationships_as_target:type_name -> sgtm.Relationship
-	4,  // 12: sgtm.Relationship.kind:type_name -> sgtm.Relationship.Kind
-	13, // 13: sgtm.Relationship.source_post:type_name -> sgtm.Post
-	13, // 14: sgtm.Relationship.target_post:type_name -> sgtm.Post
-	12, // 15: sgtm.Relationship.source_user:type_name -> sgtm.User
-	12, // 16: sgtm.Relationship.target_user:type_name -> sgtm.User
-	12, // 17: sgtm.Register.Response.user:type_name -> sgtm.User
-	12, // 18: sgtm.UserList.Response.users:type_name -> sgtm.User
-	13, // 19: sgtm.PostList.Response.posts:type_name -> sgtm.Post
-	12, // 20: sgtm.Me.Response.user:type_name -> sgtm.User
-	22, // 21: sgtm.WebAPI.UserList:input_type -> sgtm.UserList.Request
-	24, // 22: sgtm.WebAPI.PostList:input_type -> sgtm.PostList.Request
-	28, // 23: sgtm.WebAPI.Me:input_type -> sgtm.Me.Request
-	16, // 24: sgtm.WebAPI.Ping:input_type -> sgtm.Ping.Request
-	18, // 25: sgtm.WebAPI.Status:input_type -> sgtm.Status.Request
-	23, // 26: sgtm.WebAPI.UserList:output_type -> sgtm.UserList.Response
-	25, // 27: sgtm.WebAPI.PostList:output_type -> sgtm.PostList.Response
-	29, // 28: sgtm.WebAPI.Me:output_type -> sgtm.Me.Response
-	17, // 29: sgtm.WebAPI.Ping:output_type -> sgtm.Ping.Response
-	19, // 30: sgtm.WebAPI.Status:output_type -> sgtm.Status.Response
-	26, // [26:31] is the sub-list for method output_type
-	21, // [21:26] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
-}
-
-func init() { file_sgtm_proto_init() }
-func file_sgtm_proto_init() {
-	if File_sgtm_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_sgtm_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ping); i {
+		file_sgtm_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityStream_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2588,8 +9207,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Status); i {
+		file_sgtm_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationshipList_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2600,8 +9219,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Register); i {
+		file_sgtm_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationshipList_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2612,8 +9231,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserList); i {
+		file_sgtm_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationshipCreate_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2624,8 +9243,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostList); i {
+		file_sgtm_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationshipCreate_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2636,8 +9255,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostSync); i {
+		file_sgtm_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationshipDelete_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2648,8 +9267,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Me); i {
+		file_sgtm_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationshipDelete_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2660,8 +9279,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_sgtm_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationshipGraph_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2672,8 +9291,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Post); i {
+		file_sgtm_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationshipGraph_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2684,8 +9303,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Relationship); i {
+		file_sgtm_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APITokenList_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2696,8 +9315,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Session); i {
+		file_sgtm_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APITokenList_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2708,8 +9327,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ping_Request); i {
+		file_sgtm_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APITokenCreate_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2720,8 +9339,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ping_Response); i {
+		file_sgtm_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APITokenCreate_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2732,8 +9351,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Status_Request); i {
+		file_sgtm_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APITokenRevoke_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2744,8 +9363,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Status_Response); i {
+		file_sgtm_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APITokenRevoke_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2756,8 +9375,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Register_Request); i {
+		file_sgtm_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrationList_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2768,8 +9387,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Register_Response); i {
+		file_sgtm_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrationList_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2780,8 +9399,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserList_Request); i {
+		file_sgtm_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrationReplay_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2792,8 +9411,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserList_Response); i {
+		file_sgtm_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrationReplay_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2804,8 +9423,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostList_Request); i {
+		file_sgtm_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessingFailureList_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2816,8 +9435,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostList_Response); i {
+		file_sgtm_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessingFailureList_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2828,8 +9447,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostSync_Request); i {
+		file_sgtm_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessingFailureRetry_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2840,8 +9459,8 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostSync_Response); i {
+		file_sgtm_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessingFailureRetry_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2852,7 +9471,31 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_sgtm_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessingFailureClear_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessingFailureClear_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sgtm_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Me_Request); i {
 			case 0:
 				return &v.state
@@ -2864,7 +9507,7 @@ func file_sgtm_proto_init() {
 				return nil
 			}
 		}
-		file_sgtm_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_sgtm_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Me_Response); i {
 			case 0:
 				return &v.state
@@ -2882,8 +9525,8 @@ func file_sgtm_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sgtm_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   25,
+			NumEnums:      10,
+			NumMessages:   97,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,7 +21,28 @@ type WebAPIClient interface {
 	//rpc Register(Register.Request) returns (Register.Response) { option (google.api.http) = {post: "/api/v1/Register", body: "*"}; }
 	UserList(ctx context.Context, in *UserList_Request, opts ...grpc.CallOption) (*UserList_Response, error)
 	PostList(ctx context.Context, in *PostList_Request, opts ...grpc.CallOption) (*PostList_Response, error)
-	// rpc PostSync(PostSync.Request) returns (PostSync.Response) { option (google.api.http) = {get: "/api/v1/PostSync"}; }
+	PostGet(ctx context.Context, in *PostGet_Request, opts ...grpc.CallOption) (*PostGet_Response, error)
+	PostCreate(ctx context.Context, in *PostCreate_Request, opts ...grpc.CallOption) (*PostCreate_Response, error)
+	PostUpdate(ctx context.Context, in *PostUpdate_Request, opts ...grpc.CallOption) (*PostUpdate_Response, error)
+	PostDelete(ctx context.Context, in *PostDelete_Request, opts ...grpc.CallOption) (*PostDelete_Response, error)
+	CommentList(ctx context.Context, in *CommentList_Request, opts ...grpc.CallOption) (*CommentList_Response, error)
+	CommentCreate(ctx context.Context, in *CommentCreate_Request, opts ...grpc.CallOption) (*CommentCreate_Response, error)
+	CommentUpdate(ctx context.Context, in *CommentUpdate_Request, opts ...grpc.CallOption) (*CommentUpdate_Response, error)
+	CommentDelete(ctx context.Context, in *CommentDelete_Request, opts ...grpc.CallOption) (*CommentDelete_Response, error)
+	PostSync(ctx context.Context, in *PostSync_Request, opts ...grpc.CallOption) (*PostSync_Response, error)
+	ActivityStream(ctx context.Context, in *ActivityStream_Request, opts ...grpc.CallOption) (WebAPI_ActivityStreamClient, error)
+	RelationshipList(ctx context.Context, in *RelationshipList_Request, opts ...grpc.CallOption) (*RelationshipList_Response, error)
+	RelationshipCreate(ctx context.Context, in *RelationshipCreate_Request, opts ...grpc.CallOption) (*RelationshipCreate_Response, error)
+	RelationshipDelete(ctx context.Context, in *RelationshipDelete_Request, opts ...grpc.CallOption) (*RelationshipDelete_Response, error)
+	RelationshipGraph(ctx context.Context, in *RelationshipGraph_Request, opts ...grpc.CallOption) (*RelationshipGraph_Response, error)
+	APITokenList(ctx context.Context, in *APITokenList_Request, opts ...grpc.CallOption) (*APITokenList_Response, error)
+	APITokenCreate(ctx context.Context, in *APITokenCreate_Request, opts ...grpc.CallOption) (*APITokenCreate_Response, error)
+	APITokenRevoke(ctx context.Context, in *APITokenRevoke_Request, opts ...grpc.CallOption) (*APITokenRevoke_Response, error)
+	MigrationList(ctx context.Context, in *MigrationList_Request, opts ...grpc.CallOption) (*MigrationList_Response, error)
+	MigrationReplay(ctx context.Context, in *MigrationReplay_Request, opts ...grpc.CallOption) (*MigrationReplay_Response, error)
+	ProcessingFailureList(ctx context.Context, in *ProcessingFailureList_Request, opts ...grpc.CallOption) (*ProcessingFailureList_Response, error)
+	ProcessingFailureRetry(ctx context.Context, in *ProcessingFailureRetry_Request, opts ...grpc.CallOption) (*ProcessingFailureRetry_Response, error)
+	ProcessingFailureClear(ctx context.Context, in *ProcessingFailureClear_Request, opts ...grpc.CallOption) (*ProcessingFailureClear_Response, error)
 	Me(ctx context.Context, in *Me_Request, opts ...grpc.CallOption) (*Me_Response, error)
 	Ping(ctx context.Context, in *Ping_Request, opts ...grpc.CallOption) (*Ping_Response, error)
 	Status(ctx context.Context, in *Status_Request, opts ...grpc.CallOption) (*Status_Response, error)
@@ -53,6 +74,227 @@ func (c *webAPIClient) PostList(ctx context.Context, in *PostList_Request, opts
 	return out, nil
 }
 
+func (c *webAPIClient) PostGet(ctx context.Context, in *PostGet_Request, opts ...grpc.CallOption) (*PostGet_Response, error) {
+	out := new(PostGet_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/PostGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) PostCreate(ctx context.Context, in *PostCreate_Request, opts ...grpc.CallOption) (*PostCreate_Response, error) {
+	out := new(PostCreate_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/PostCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) PostUpdate(ctx context.Context, in *PostUpdate_Request, opts ...grpc.CallOption) (*PostUpdate_Response, error) {
+	out := new(PostUpdate_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/PostUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) PostDelete(ctx context.Context, in *PostDelete_Request, opts ...grpc.CallOption) (*PostDelete_Response, error) {
+	out := new(PostDelete_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/PostDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) CommentList(ctx context.Context, in *CommentList_Request, opts ...grpc.CallOption) (*CommentList_Response, error) {
+	out := new(CommentList_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/CommentList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) CommentCreate(ctx context.Context, in *CommentCreate_Request, opts ...grpc.CallOption) (*CommentCreate_Response, error) {
+	out := new(CommentCreate_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/CommentCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) CommentUpdate(ctx context.Context, in *CommentUpdate_Request, opts ...grpc.CallOption) (*CommentUpdate_Response, error) {
+	out := new(CommentUpdate_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/CommentUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) CommentDelete(ctx context.Context, in *CommentDelete_Request, opts ...grpc.CallOption) (*CommentDelete_Response, error) {
+	out := new(CommentDelete_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/CommentDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) PostSync(ctx context.Context, in *PostSync_Request, opts ...grpc.CallOption) (*PostSync_Response, error) {
+	out := new(PostSync_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/PostSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) ActivityStream(ctx context.Context, in *ActivityStream_Request, opts ...grpc.CallOption) (WebAPI_ActivityStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WebAPI_serviceDesc.Streams[0], "/sgtm.WebAPI/ActivityStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &webAPIActivityStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WebAPI_ActivityStreamClient interface {
+	Recv() (*ActivityStream_Response, error)
+	grpc.ClientStream
+}
+
+type webAPIActivityStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *webAPIActivityStreamClient) Recv() (*ActivityStream_Response, error) {
+	m := new(ActivityStream_Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *webAPIClient) RelationshipList(ctx context.Context, in *RelationshipList_Request, opts ...grpc.CallOption) (*RelationshipList_Response, error) {
+	out := new(RelationshipList_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/RelationshipList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) RelationshipCreate(ctx context.Context, in *RelationshipCreate_Request, opts ...grpc.CallOption) (*RelationshipCreate_Response, error) {
+	out := new(RelationshipCreate_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/RelationshipCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) RelationshipDelete(ctx context.Context, in *RelationshipDelete_Request, opts ...grpc.CallOption) (*RelationshipDelete_Response, error) {
+	out := new(RelationshipDelete_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/RelationshipDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) RelationshipGraph(ctx context.Context, in *RelationshipGraph_Request, opts ...grpc.CallOption) (*RelationshipGraph_Response, error) {
+	out := new(RelationshipGraph_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/RelationshipGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) APITokenList(ctx context.Context, in *APITokenList_Request, opts ...grpc.CallOption) (*APITokenList_Response, error) {
+	out := new(APITokenList_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/APITokenList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) APITokenCreate(ctx context.Context, in *APITokenCreate_Request, opts ...grpc.CallOption) (*APITokenCreate_Response, error) {
+	out := new(APITokenCreate_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/APITokenCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) APITokenRevoke(ctx context.Context, in *APITokenRevoke_Request, opts ...grpc.CallOption) (*APITokenRevoke_Response, error) {
+	out := new(APITokenRevoke_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/APITokenRevoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) MigrationList(ctx context.Context, in *MigrationList_Request, opts ...grpc.CallOption) (*MigrationList_Response, error) {
+	out := new(MigrationList_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/MigrationList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) MigrationReplay(ctx context.Context, in *MigrationReplay_Request, opts ...grpc.CallOption) (*MigrationReplay_Response, error) {
+	out := new(MigrationReplay_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/MigrationReplay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) ProcessingFailureList(ctx context.Context, in *ProcessingFailureList_Request, opts ...grpc.CallOption) (*ProcessingFailureList_Response, error) {
+	out := new(ProcessingFailureList_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/ProcessingFailureList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) ProcessingFailureRetry(ctx context.Context, in *ProcessingFailureRetry_Request, opts ...grpc.CallOption) (*ProcessingFailureRetry_Response, error) {
+	out := new(ProcessingFailureRetry_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/ProcessingFailureRetry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webAPIClient) ProcessingFailureClear(ctx context.Context, in *ProcessingFailureClear_Request, opts ...grpc.CallOption) (*ProcessingFailureClear_Response, error) {
+	out := new(ProcessingFailureClear_Response)
+	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/ProcessingFailureClear", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webAPIClient) Me(ctx context.Context, in *Me_Request, opts ...grpc.CallOption) (*Me_Response, error) {
 	out := new(Me_Response)
 	err := c.cc.Invoke(ctx, "/sgtm.WebAPI/Me", in, out, opts...)
@@ -87,7 +329,28 @@ type WebAPIServer interface {
 	//rpc Register(Register.Request) returns (Register.Response) { option (google.api.http) = {post: "/api/v1/Register", body: "*"}; }
 	UserList(context.Context, *UserList_Request) (*UserList_Response, error)
 	PostList(context.Context, *PostList_Request) (*PostList_Response, error)
-	// rpc PostSync(PostSync.Request) returns (PostSync.Response) { option (google.api.http) = {get: "/api/v1/PostSync"}; }
+	PostGet(context.Context, *PostGet_Request) (*PostGet_Response, error)
+	PostCreate(context.Context, *PostCreate_Request) (*PostCreate_Response, error)
+	PostUpdate(context.Context, *PostUpdate_Request) (*PostUpdate_Response, error)
+	PostDelete(context.Context, *PostDelete_Request) (*PostDelete_Response, error)
+	CommentList(context.Context, *CommentList_Request) (*CommentList_Response, error)
+	CommentCreate(context.Context, *CommentCreate_Request) (*CommentCreate_Response, error)
+	CommentUpdate(context.Context, *CommentUpdate_Request) (*CommentUpdate_Response, error)
+	CommentDelete(context.Context, *CommentDelete_Request) (*CommentDelete_Response, error)
+	PostSync(context.Context, *PostSync_Request) (*PostSync_Response, error)
+	ActivityStream(*ActivityStream_Request, WebAPI_ActivityStreamServer) error
+	RelationshipList(context.Context, *RelationshipList_Request) (*RelationshipList_Response, error)
+	RelationshipCreate(context.Context, *RelationshipCreate_Request) (*RelationshipCreate_Response, error)
+	RelationshipDelete(context.Context, *RelationshipDelete_Request) (*RelationshipDelete_Response, error)
+	RelationshipGraph(context.Context, *RelationshipGraph_Request) (*RelationshipGraph_Response, error)
+	APITokenList(context.Context, *APITokenList_Request) (*APITokenList_Response, error)
+	APITokenCreate(context.Context, *APITokenCreate_Request) (*APITokenCreate_Response, error)
+	APITokenRevoke(context.Context, *APITokenRevoke_Request) (*APITokenRevoke_Response, error)
+	MigrationList(context.Context, *MigrationList_Request) (*MigrationList_Response, error)
+	MigrationReplay(context.Context, *MigrationReplay_Request) (*MigrationReplay_Response, error)
+	ProcessingFailureList(context.Context, *ProcessingFailureList_Request) (*ProcessingFailureList_Response, error)
+	ProcessingFailureRetry(context.Context, *ProcessingFailureRetry_Request) (*ProcessingFailureRetry_Response, error)
+	ProcessingFailureClear(context.Context, *ProcessingFailureClear_Request) (*ProcessingFailureClear_Response, error)
 	Me(context.Context, *Me_Request) (*Me_Response, error)
 	Ping(context.Context, *Ping_Request) (*Ping_Response, error)
 	Status(context.Context, *Status_Request) (*Status_Response, error)
@@ -104,6 +367,72 @@ func (UnimplementedWebAPIServer) UserList(context.Context, *UserList_Request) (*
 func (UnimplementedWebAPIServer) PostList(context.Context, *PostList_Request) (*PostList_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PostList not implemented")
 }
+func (UnimplementedWebAPIServer) PostGet(context.Context, *PostGet_Request) (*PostGet_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostGet not implemented")
+}
+func (UnimplementedWebAPIServer) PostCreate(context.Context, *PostCreate_Request) (*PostCreate_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostCreate not implemented")
+}
+func (UnimplementedWebAPIServer) PostUpdate(context.Context, *PostUpdate_Request) (*PostUpdate_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostUpdate not implemented")
+}
+func (UnimplementedWebAPIServer) PostDelete(context.Context, *PostDelete_Request) (*PostDelete_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostDelete not implemented")
+}
+func (UnimplementedWebAPIServer) CommentList(context.Context, *CommentList_Request) (*CommentList_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentList not implemented")
+}
+func (UnimplementedWebAPIServer) CommentCreate(context.Context, *CommentCreate_Request) (*CommentCreate_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentCreate not implemented")
+}
+func (UnimplementedWebAPIServer) CommentUpdate(context.Context, *CommentUpdate_Request) (*CommentUpdate_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentUpdate not implemented")
+}
+func (UnimplementedWebAPIServer) CommentDelete(context.Context, *CommentDelete_Request) (*CommentDelete_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentDelete not implemented")
+}
+func (UnimplementedWebAPIServer) PostSync(context.Context, *PostSync_Request) (*PostSync_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostSync not implemented")
+}
+func (UnimplementedWebAPIServer) ActivityStream(*ActivityStream_Request, WebAPI_ActivityStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ActivityStream not implemented")
+}
+func (UnimplementedWebAPIServer) RelationshipList(context.Context, *RelationshipList_Request) (*RelationshipList_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelationshipList not implemented")
+}
+func (UnimplementedWebAPIServer) RelationshipCreate(context.Context, *RelationshipCreate_Request) (*RelationshipCreate_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelationshipCreate not implemented")
+}
+func (UnimplementedWebAPIServer) RelationshipDelete(context.Context, *RelationshipDelete_Request) (*RelationshipDelete_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelationshipDelete not implemented")
+}
+func (UnimplementedWebAPIServer) RelationshipGraph(context.Context, *RelationshipGraph_Request) (*RelationshipGraph_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelationshipGraph not implemented")
+}
+func (UnimplementedWebAPIServer) APITokenList(context.Context, *APITokenList_Request) (*APITokenList_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method APITokenList not implemented")
+}
+func (UnimplementedWebAPIServer) APITokenCreate(context.Context, *APITokenCreate_Request) (*APITokenCreate_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method APITokenCreate not implemented")
+}
+func (UnimplementedWebAPIServer) APITokenRevoke(context.Context, *APITokenRevoke_Request) (*APITokenRevoke_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method APITokenRevoke not implemented")
+}
+func (UnimplementedWebAPIServer) MigrationList(context.Context, *MigrationList_Request) (*MigrationList_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationList not implemented")
+}
+func (UnimplementedWebAPIServer) MigrationReplay(context.Context, *MigrationReplay_Request) (*MigrationReplay_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationReplay not implemented")
+}
+func (UnimplementedWebAPIServer) ProcessingFailureList(context.Context, *ProcessingFailureList_Request) (*ProcessingFailureList_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessingFailureList not implemented")
+}
+func (UnimplementedWebAPIServer) ProcessingFailureRetry(context.Context, *ProcessingFailureRetry_Request) (*ProcessingFailureRetry_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessingFailureRetry not implemented")
+}
+func (UnimplementedWebAPIServer) ProcessingFailureClear(context.Context, *ProcessingFailureClear_Request) (*ProcessingFailureClear_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessingFailureClear not implemented")
+}
 func (UnimplementedWebAPIServer) Me(context.Context, *Me_Request) (*Me_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Me not implemented")
 }
@@ -162,6 +491,405 @@ func _WebAPI_PostList_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebAPI_PostGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostGet_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).PostGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/PostGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).PostGet(ctx, req.(*PostGet_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_PostCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostCreate_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).PostCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/PostCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).PostCreate(ctx, req.(*PostCreate_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_PostUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostUpdate_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).PostUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/PostUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).PostUpdate(ctx, req.(*PostUpdate_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_PostDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostDelete_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).PostDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/PostDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).PostDelete(ctx, req.(*PostDelete_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_CommentList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentList_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).CommentList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/CommentList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).CommentList(ctx, req.(*CommentList_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_CommentCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentCreate_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).CommentCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/CommentCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).CommentCreate(ctx, req.(*CommentCreate_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_CommentUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentUpdate_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).CommentUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/CommentUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).CommentUpdate(ctx, req.(*CommentUpdate_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_CommentDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentDelete_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).CommentDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/CommentDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).CommentDelete(ctx, req.(*CommentDelete_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_PostSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostSync_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).PostSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/PostSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).PostSync(ctx, req.(*PostSync_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_ActivityStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ActivityStream_Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WebAPIServer).ActivityStream(m, &webAPIActivityStreamServer{stream})
+}
+
+type WebAPI_ActivityStreamServer interface {
+	Send(*ActivityStream_Response) error
+	grpc.ServerStream
+}
+
+type webAPIActivityStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *webAPIActivityStreamServer) Send(m *ActivityStream_Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WebAPI_RelationshipList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationshipList_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).RelationshipList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/RelationshipList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).RelationshipList(ctx, req.(*RelationshipList_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_RelationshipCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationshipCreate_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).RelationshipCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/RelationshipCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).RelationshipCreate(ctx, req.(*RelationshipCreate_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_RelationshipDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationshipDelete_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).RelationshipDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/RelationshipDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).RelationshipDelete(ctx, req.(*RelationshipDelete_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_RelationshipGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationshipGraph_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).RelationshipGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/RelationshipGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).RelationshipGraph(ctx, req.(*RelationshipGraph_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_APITokenList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APITokenList_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).APITokenList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/APITokenList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).APITokenList(ctx, req.(*APITokenList_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_APITokenCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APITokenCreate_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).APITokenCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/APITokenCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).APITokenCreate(ctx, req.(*APITokenCreate_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_APITokenRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APITokenRevoke_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).APITokenRevoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/APITokenRevoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).APITokenRevoke(ctx, req.(*APITokenRevoke_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_MigrationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrationList_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).MigrationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/MigrationList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).MigrationList(ctx, req.(*MigrationList_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_MigrationReplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrationReplay_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).MigrationReplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/MigrationReplay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).MigrationReplay(ctx, req.(*MigrationReplay_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_ProcessingFailureList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessingFailureList_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).ProcessingFailureList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/ProcessingFailureList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).ProcessingFailureList(ctx, req.(*ProcessingFailureList_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_ProcessingFailureRetry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessingFailureRetry_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).ProcessingFailureRetry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/ProcessingFailureRetry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).ProcessingFailureRetry(ctx, req.(*ProcessingFailureRetry_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebAPI_ProcessingFailureClear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessingFailureClear_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebAPIServer).ProcessingFailureClear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgtm.WebAPI/ProcessingFailureClear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebAPIServer).ProcessingFailureClear(ctx, req.(*ProcessingFailureClear_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebAPI_Me_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Me_Request)
 	if err := dec(in); err != nil {
@@ -228,6 +956,90 @@ var _WebAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PostList",
 			Handler:    _WebAPI_PostList_Handler,
 		},
+		{
+			MethodName: "PostGet",
+			Handler:    _WebAPI_PostGet_Handler,
+		},
+		{
+			MethodName: "PostCreate",
+			Handler:    _WebAPI_PostCreate_Handler,
+		},
+		{
+			MethodName: "PostUpdate",
+			Handler:    _WebAPI_PostUpdate_Handler,
+		},
+		{
+			MethodName: "PostDelete",
+			Handler:    _WebAPI_PostDelete_Handler,
+		},
+		{
+			MethodName: "CommentList",
+			Handler:    _WebAPI_CommentList_Handler,
+		},
+		{
+			MethodName: "CommentCreate",
+			Handler:    _WebAPI_CommentCreate_Handler,
+		},
+		{
+			MethodName: "CommentUpdate",
+			Handler:    _WebAPI_CommentUpdate_Handler,
+		},
+		{
+			MethodName: "CommentDelete",
+			Handler:    _WebAPI_CommentDelete_Handler,
+		},
+		{
+			MethodName: "PostSync",
+			Handler:    _WebAPI_PostSync_Handler,
+		},
+		{
+			MethodName: "RelationshipList",
+			Handler:    _WebAPI_RelationshipList_Handler,
+		},
+		{
+			MethodName: "RelationshipCreate",
+			Handler:    _WebAPI_RelationshipCreate_Handler,
+		},
+		{
+			MethodName: "RelationshipDelete",
+			Handler:    _WebAPI_RelationshipDelete_Handler,
+		},
+		{
+			MethodName: "RelationshipGraph",
+			Handler:    _WebAPI_RelationshipGraph_Handler,
+		},
+		{
+			MethodName: "APITokenList",
+			Handler:    _WebAPI_APITokenList_Handler,
+		},
+		{
+			MethodName: "APITokenCreate",
+			Handler:    _WebAPI_APITokenCreate_Handler,
+		},
+		{
+			MethodName: "APITokenRevoke",
+			Handler:    _WebAPI_APITokenRevoke_Handler,
+		},
+		{
+			MethodName: "MigrationList",
+			Handler:    _WebAPI_MigrationList_Handler,
+		},
+		{
+			MethodName: "MigrationReplay",
+			Handler:    _WebAPI_MigrationReplay_Handler,
+		},
+		{
+			MethodName: "ProcessingFailureList",
+			Handler:    _WebAPI_ProcessingFailureList_Handler,
+		},
+		{
+			MethodName: "ProcessingFailureRetry",
+			Handler:    _WebAPI_ProcessingFailureRetry_Handler,
+		},
+		{
+			MethodName: "ProcessingFailureClear",
+			Handler:    _WebAPI_ProcessingFailureClear_Handler,
+		},
 		{
 			MethodName: "Me",
 			Handler:    _WebAPI_Me_Handler,
@@ -241,6 +1053,12 @@ var _WebAPI_serviceDesc = grpc.ServiceDesc{
 			Handler:    _WebAPI_Status_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ActivityStream",
+			Handler:       _WebAPI_ActivityStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "sgtm.proto",
 }